@@ -0,0 +1,36 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// AdInsightBody 表示请求体
+type AdInsightBody struct {
+	XMLName                                    xml.Name                                    `xml:"s:Body"`
+	GetKeywordIdeasRequest                     *GetKeywordIdeasRequest                     `xml:"GetKeywordIdeasRequest,omitempty"`
+	GetKeywordTrafficEstimatesRequest          *GetKeywordTrafficEstimatesRequest          `xml:"GetKeywordTrafficEstimatesRequest,omitempty"`
+	GetBidLandscapeByKeywordIdsRequest         *GetBidLandscapeByKeywordIdsRequest         `xml:"GetBidLandscapeByKeywordIdsRequest,omitempty"`
+	GetEstimatedBidByKeywordsRequest           *GetEstimatedBidByKeywordsRequest           `xml:"GetEstimatedBidByKeywordsRequest,omitempty"`
+	SuggestKeywordsFromExistingKeywordsRequest *SuggestKeywordsFromExistingKeywordsRequest `xml:"SuggestKeywordsFromExistingKeywordsRequest,omitempty"`
+}
+
+// AdInsightResponseBody 表示响应体
+type AdInsightResponseBody struct {
+	XMLName                                     xml.Name                                     `xml:"Body"`
+	Fault                                       *base.Fault                                  `xml:"Fault,omitempty"`
+	GetKeywordIdeasResponse                     *GetKeywordIdeasResponse                     `xml:"GetKeywordIdeasResponse,omitempty"`
+	GetKeywordTrafficEstimatesResponse          *GetKeywordTrafficEstimatesResponse          `xml:"GetKeywordTrafficEstimatesResponse,omitempty"`
+	GetBidLandscapeByKeywordIdsResponse         *GetBidLandscapeByKeywordIdsResponse         `xml:"GetBidLandscapeByKeywordIdsResponse,omitempty"`
+	GetEstimatedBidByKeywordsResponse           *GetEstimatedBidByKeywordsResponse           `xml:"GetEstimatedBidByKeywordsResponse,omitempty"`
+	SuggestKeywordsFromExistingKeywordsResponse *SuggestKeywordsFromExistingKeywordsResponse `xml:"SuggestKeywordsFromExistingKeywordsResponse,omitempty"`
+}
+
+// AdInsightResponseEnvelope 表示完整的 SOAP 响应
+type AdInsightResponseEnvelope struct {
+	XMLName xml.Name              `xml:"Envelope"`
+	XmlnsS  string                `xml:"xmlns:s,attr,omitempty"`
+	Header  base.ResponseHeader   `xml:"Header"`
+	Body    AdInsightResponseBody `xml:"Body"`
+}
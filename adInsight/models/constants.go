@@ -0,0 +1,62 @@
+package models
+
+type SOAPAction string
+
+const (
+	SOAPActionGetKeywordIdeas                     SOAPAction = "GetKeywordIdeas"
+	SOAPActionGetKeywordTrafficEstimates          SOAPAction = "GetKeywordTrafficEstimates"
+	SOAPActionGetBidLandscapeByKeywordIds         SOAPAction = "GetBidLandscapeByKeywordIds"
+	SOAPActionGetEstimatedBidByKeywords           SOAPAction = "GetEstimatedBidByKeywords"
+	SOAPActionSuggestKeywordsFromExistingKeywords SOAPAction = "SuggestKeywordsFromExistingKeywords"
+)
+
+// KeywordIdeaAttribute 表示关键词建议需要返回的属性
+type KeywordIdeaAttribute string
+
+const (
+	KeywordIdeaAttributeAdGroupId           KeywordIdeaAttribute = "AdGroupId"
+	KeywordIdeaAttributeAdGroupName         KeywordIdeaAttribute = "AdGroupName"
+	KeywordIdeaAttributeAdImpressionShare   KeywordIdeaAttribute = "AdImpressionShare"
+	KeywordIdeaAttributeCompetition         KeywordIdeaAttribute = "Competition"
+	KeywordIdeaAttributeKeyword             KeywordIdeaAttribute = "Keyword"
+	KeywordIdeaAttributeMonthlySearchCounts KeywordIdeaAttribute = "MonthlySearchCounts"
+	KeywordIdeaAttributeRelevance           KeywordIdeaAttribute = "Relevance"
+	KeywordIdeaAttributeSource              KeywordIdeaAttribute = "Source"
+	KeywordIdeaAttributeSuggestedBid        KeywordIdeaAttribute = "SuggestedBid"
+)
+
+// CompetitionLevel 表示关键词的竞争程度
+type CompetitionLevel string
+
+const (
+	CompetitionLevelLow    CompetitionLevel = "Low"
+	CompetitionLevelMedium CompetitionLevel = "Medium"
+	CompetitionLevelHigh   CompetitionLevel = "High"
+)
+
+// NetworkType 表示投放网络
+type NetworkType string
+
+const (
+	NetworkOwnedAndOperatedAndSyndicatedSearch NetworkType = "OwnedAndOperatedAndSyndicatedSearch"
+	NetworkOwnedAndOperatedOnly                NetworkType = "OwnedAndOperatedOnly"
+	NetworkSyndicatedSearchOnly                NetworkType = "SyndicatedSearchOnly"
+)
+
+// MatchType 表示关键词匹配方式
+type MatchType string
+
+const (
+	MatchTypeExact  MatchType = "Exact"
+	MatchTypePhrase MatchType = "Phrase"
+	MatchTypeBroad  MatchType = "Broad"
+)
+
+// TargetAdPosition 表示期望的广告位置
+type TargetAdPosition string
+
+const (
+	TargetAdPositionMainLine1 TargetAdPosition = "MainLine1"
+	TargetAdPositionMainLine  TargetAdPosition = "MainLine"
+	TargetAdPositionSideBar   TargetAdPosition = "SideBar"
+)
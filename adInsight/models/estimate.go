@@ -0,0 +1,229 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// Keyword 表示需要估算的关键词
+type Keyword struct {
+	Id        base.Nillable[int64] `xml:"Id"`
+	MatchType MatchType            `xml:"MatchType"`
+	Text      string               `xml:"Text"`
+}
+
+// NegativeKeyword 表示估算时使用的否定关键词
+type NegativeKeyword struct {
+	MatchType MatchType `xml:"MatchType"`
+	Text      string    `xml:"Text"`
+}
+
+// NegativeKeywords 表示否定关键词列表，为空时不输出元素
+type NegativeKeywords []NegativeKeyword
+
+// MarshalXML 自定义 NegativeKeywords 的 XML 序列化
+func (k NegativeKeywords) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(k) == 0 {
+		return nil
+	}
+	return e.EncodeElement(struct {
+		Items []NegativeKeyword `xml:"NegativeKeyword"`
+	}{k}, start)
+}
+
+// KeywordEstimator 表示关键词及其出价
+type KeywordEstimator struct {
+	Keyword Keyword                `xml:"Keyword"`
+	MaxCpc  base.Nillable[float64] `xml:"MaxCpc"`
+}
+
+// AdGroupEstimator 表示广告组及其关键词
+type AdGroupEstimator struct {
+	AdGroupId         base.Nillable[int64]   `xml:"AdGroupId"`
+	KeywordEstimators []KeywordEstimator     `xml:"KeywordEstimators>KeywordEstimator"`
+	MaxCpc            base.Nillable[float64] `xml:"MaxCpc"`
+}
+
+// CampaignEstimator 表示活动级别的估算条件
+type CampaignEstimator struct {
+	AdGroupEstimators []AdGroupEstimator     `xml:"AdGroupEstimators>AdGroupEstimator"`
+	CampaignId        base.Nillable[int64]   `xml:"CampaignId"`
+	Criteria          Criteria               `xml:"Criteria"`
+	DailyBudget       base.Nillable[float64] `xml:"DailyBudget"`
+	NegativeKeywords  NegativeKeywords       `xml:"NegativeKeywords"`
+}
+
+// Criterion 表示流量估算的条件，具体类型通过 i:type 区分
+type Criterion interface {
+	// CriterionType 返回条件的 i:type 名称
+	CriterionType() string
+
+	isCriterion()
+}
+
+// CriterionType 实现 Criterion 接口
+func (LanguageCriterion) CriterionType() string { return "LanguageCriterion" }
+
+// CriterionType 实现 Criterion 接口
+func (LocationCriterion) CriterionType() string { return "LocationCriterion" }
+
+// CriterionType 实现 Criterion 接口
+func (NetworkCriterion) CriterionType() string { return "NetworkCriterion" }
+
+// CriterionType 实现 Criterion 接口
+func (DeviceCriterion) CriterionType() string { return "DeviceCriterion" }
+
+func (LanguageCriterion) isCriterion() {}
+func (LocationCriterion) isCriterion() {}
+func (NetworkCriterion) isCriterion()  {}
+func (DeviceCriterion) isCriterion()   {}
+
+// Criteria 表示估算条件列表，每个条件编码为带 i:type 的 Criterion 元素，为空时不输出元素
+type Criteria []Criterion
+
+// MarshalXML 自定义 Criteria 的 XML 序列化
+func (c Criteria) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(c) == 0 {
+		return nil
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, criterion := range c {
+		itemStart := xml.StartElement{
+			Name: xml.Name{Local: "Criterion"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "i:type"}, Value: criterion.CriterionType()}},
+		}
+		if err := e.EncodeElement(criterion, itemStart); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// TrafficEstimate 表示流量估算值
+type TrafficEstimate struct {
+	AverageCpc      float64 `xml:"AverageCpc"`
+	AveragePosition float64 `xml:"AveragePosition"`
+	Clicks          float64 `xml:"Clicks"`
+	Ctr             float64 `xml:"Ctr"`
+	Impressions     int64   `xml:"Impressions"`
+	TotalCost       float64 `xml:"TotalCost"`
+}
+
+// KeywordEstimate 表示关键词的流量估算范围
+type KeywordEstimate struct {
+	Keyword Keyword         `xml:"Keyword"`
+	Maximum TrafficEstimate `xml:"Maximum"`
+	Minimum TrafficEstimate `xml:"Minimum"`
+}
+
+// AdGroupEstimate 表示广告组的流量估算
+type AdGroupEstimate struct {
+	AdGroupId        base.Nillable[int64] `xml:"AdGroupId"`
+	KeywordEstimates []KeywordEstimate    `xml:"KeywordEstimates>KeywordEstimate,omitempty"`
+}
+
+// CampaignEstimate 表示活动的流量估算
+type CampaignEstimate struct {
+	AdGroupEstimates []AdGroupEstimate    `xml:"AdGroupEstimates>AdGroupEstimate,omitempty"`
+	CampaignId       base.Nillable[int64] `xml:"CampaignId"`
+}
+
+// GetKeywordTrafficEstimatesRequest 请求结构体
+type GetKeywordTrafficEstimatesRequest struct {
+	XMLName            xml.Name            `xml:"GetKeywordTrafficEstimatesRequest"`
+	Namespace          string              `xml:"xmlns,attr"`
+	CampaignEstimators []CampaignEstimator `xml:"CampaignEstimators>CampaignEstimator"`
+}
+
+// GetKeywordTrafficEstimatesResponse 响应结构体
+type GetKeywordTrafficEstimatesResponse struct {
+	XMLName           xml.Name           `xml:"GetKeywordTrafficEstimatesResponse"`
+	Namespace         string             `xml:"xmlns,attr"`
+	CampaignEstimates []CampaignEstimate `xml:"CampaignEstimates>CampaignEstimate,omitempty"`
+}
+
+// BidLandscapePoint 表示出价与预估效果的对应点
+type BidLandscapePoint struct {
+	Bid            float64 `xml:"Bid"`
+	Clicks         float64 `xml:"Clicks"`
+	Impressions    int64   `xml:"Impressions"`
+	TopImpressions int64   `xml:"TopImpressions"`
+	CurrencyCode   string  `xml:"CurrencyCode"`
+	Cost           float64 `xml:"Cost"`
+	MarginalCPC    float64 `xml:"MarginalCPC"`
+}
+
+// KeywordBidLandscape 表示关键词的出价模拟
+type KeywordBidLandscape struct {
+	AdGroupId          int64               `xml:"AdGroupId"`
+	BidLandscapePoints []BidLandscapePoint `xml:"BidLandscapePoints>BidLandscapePoint,omitempty"`
+	EndDate            DayMonthAndYear     `xml:"EndDate"`
+	KeywordId          int64               `xml:"KeywordId"`
+	StartDate          DayMonthAndYear     `xml:"StartDate"`
+}
+
+// GetBidLandscapeByKeywordIdsRequest 请求结构体
+type GetBidLandscapeByKeywordIdsRequest struct {
+	XMLName           xml.Name         `xml:"GetBidLandscapeByKeywordIdsRequest"`
+	Namespace         string           `xml:"xmlns,attr"`
+	KeywordIds        common.LongArray `xml:"KeywordIds"`
+	IncludeCurrentBid bool             `xml:"IncludeCurrentBid"`
+}
+
+// GetBidLandscapeByKeywordIdsResponse 响应结构体
+type GetBidLandscapeByKeywordIdsResponse struct {
+	XMLName      xml.Name              `xml:"GetBidLandscapeByKeywordIdsResponse"`
+	Namespace    string                `xml:"xmlns,attr"`
+	BidLandscape []KeywordBidLandscape `xml:"BidLandscape>KeywordBidLandscape,omitempty"`
+}
+
+// KeywordAndMatchType 表示关键词及其匹配方式
+type KeywordAndMatchType struct {
+	KeywordText string      `xml:"KeywordText"`
+	MatchTypes  []MatchType `xml:"MatchTypes>MatchType"`
+}
+
+// EstimatedBidAndTraffic 表示达到目标位置所需的出价及预估流量
+type EstimatedBidAndTraffic struct {
+	MinClicksPerWeek      base.Nillable[float64] `xml:"MinClicksPerWeek"`
+	MaxClicksPerWeek      base.Nillable[float64] `xml:"MaxClicksPerWeek"`
+	AverageCPC            base.Nillable[float64] `xml:"AverageCPC"`
+	MinImpressionsPerWeek base.Nillable[int64]   `xml:"MinImpressionsPerWeek"`
+	MaxImpressionsPerWeek base.Nillable[int64]   `xml:"MaxImpressionsPerWeek"`
+	CTR                   base.Nillable[float64] `xml:"CTR"`
+	MinTotalCostPerWeek   base.Nillable[float64] `xml:"MinTotalCostPerWeek"`
+	MaxTotalCostPerWeek   base.Nillable[float64] `xml:"MaxTotalCostPerWeek"`
+	CurrencyCode          string                 `xml:"CurrencyCode"`
+	MatchType             MatchType              `xml:"MatchType"`
+	EstimatedMinBid       float64                `xml:"EstimatedMinBid"`
+}
+
+// KeywordEstimatedBid 表示关键词在各匹配方式下的预估出价
+type KeywordEstimatedBid struct {
+	Keyword       string                   `xml:"Keyword"`
+	EstimatedBids []EstimatedBidAndTraffic `xml:"EstimatedBids>EstimatedBidAndTraffic,omitempty"`
+}
+
+// GetEstimatedBidByKeywordsRequest 请求结构体
+type GetEstimatedBidByKeywordsRequest struct {
+	XMLName              xml.Name              `xml:"GetEstimatedBidByKeywordsRequest"`
+	Namespace            string                `xml:"xmlns,attr"`
+	Keywords             []KeywordAndMatchType `xml:"Keywords>KeywordAndMatchType"`
+	TargetPositionForAds TargetAdPosition      `xml:"TargetPositionForAds"`
+	Language             string                `xml:"Language,omitempty"`
+	LocationIds          common.LongArray      `xml:"LocationIds"`
+	CurrencyCode         base.Nillable[string] `xml:"CurrencyCode"`
+	CampaignId           base.Nillable[int64]  `xml:"CampaignId"`
+	AdGroupId            base.Nillable[int64]  `xml:"AdGroupId"`
+}
+
+// GetEstimatedBidByKeywordsResponse 响应结构体
+type GetEstimatedBidByKeywordsResponse struct {
+	XMLName              xml.Name              `xml:"GetEstimatedBidByKeywordsResponse"`
+	Namespace            string                `xml:"xmlns,attr"`
+	KeywordEstimatedBids []KeywordEstimatedBid `xml:"KeywordEstimatedBids>KeywordEstimatedBid,omitempty"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// AdInsightAPI 定义Ad Insight API的操作
+type AdInsightAPI interface {
+	// AdInsightService 返回关键词洞察服务
+	AdInsightService() AdInsightService
+}
+
+// AdInsightService 定义关键词建议和出价估算相关的操作
+type AdInsightService interface {
+	// GetKeywordIdeas 根据搜索条件获取关键词建议及其分类
+	GetKeywordIdeas(searchParameters []SearchParameter, ideaAttributes []KeywordIdeaAttribute, expandIdeas bool) ([]KeywordIdea, []KeywordIdeaCategory, error)
+
+	// GetKeywordTrafficEstimates 估算关键词在给定出价和预算下的流量
+	GetKeywordTrafficEstimates(campaignEstimators []CampaignEstimator) ([]CampaignEstimate, error)
+
+	// GetBidLandscapeByKeywordIds 获取已有关键词的出价模拟
+	GetBidLandscapeByKeywordIds(keywordIds []int64, includeCurrentBid bool) ([]KeywordBidLandscape, error)
+
+	// GetEstimatedBidByKeywords 估算关键词达到目标广告位置所需的出价
+	GetEstimatedBidByKeywords(request *GetEstimatedBidByKeywordsRequest) ([]KeywordEstimatedBid, error)
+
+	// SuggestKeywordsFromExistingKeywords 根据已有关键词推荐新的关键词
+	SuggestKeywordsFromExistingKeywords(request *SuggestKeywordsFromExistingKeywordsRequest) ([]KeywordSuggestion, error)
+}
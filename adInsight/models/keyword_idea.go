@@ -0,0 +1,78 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// KeywordIdea 表示关键词建议
+type KeywordIdea struct {
+	AdGroupId           base.Nillable[int64]            `xml:"AdGroupId"`
+	AdGroupName         string                          `xml:"AdGroupName"`
+	AdImpressionShare   base.Nillable[float64]          `xml:"AdImpressionShare"`
+	Competition         base.Nillable[CompetitionLevel] `xml:"Competition"`
+	Keyword             string                          `xml:"Keyword"`
+	MonthlySearchCounts common.LongArray                `xml:"MonthlySearchCounts"`
+	Relevance           base.Nillable[float64]          `xml:"Relevance"`
+	Source              base.Nillable[string]           `xml:"Source"`
+	SuggestedBid        base.Nillable[float64]          `xml:"SuggestedBid"`
+}
+
+// KeywordIdeaCategory 表示关键词建议的分类
+type KeywordIdeaCategory struct {
+	CategoryId   int64  `xml:"CategoryId"`
+	CategoryName string `xml:"CategoryName"`
+}
+
+// GetKeywordIdeasRequest 请求结构体
+type GetKeywordIdeasRequest struct {
+	XMLName          xml.Name               `xml:"GetKeywordIdeasRequest"`
+	Namespace        string                 `xml:"xmlns,attr"`
+	ExpandIdeas      bool                   `xml:"ExpandIdeas"`
+	IdeaAttributes   []KeywordIdeaAttribute `xml:"IdeaAttributes>KeywordIdeaAttribute"`
+	SearchParameters SearchParameters       `xml:"SearchParameters"`
+}
+
+// GetKeywordIdeasResponse 响应结构体
+type GetKeywordIdeasResponse struct {
+	XMLName               xml.Name              `xml:"GetKeywordIdeasResponse"`
+	Namespace             string                `xml:"xmlns,attr"`
+	KeywordIdeaCategories []KeywordIdeaCategory `xml:"KeywordIdeaCategories>KeywordIdeaCategory,omitempty"`
+	KeywordIdeas          []KeywordIdea         `xml:"KeywordIdeas>KeywordIdea,omitempty"`
+}
+
+// SuggestKeywordsFromExistingKeywordsRequest 请求结构体
+type SuggestKeywordsFromExistingKeywordsRequest struct {
+	XMLName                  xml.Name             `xml:"SuggestKeywordsFromExistingKeywordsRequest"`
+	Namespace                string               `xml:"xmlns,attr"`
+	Keywords                 common.StringArray   `xml:"Keywords"`
+	Language                 string               `xml:"Language,omitempty"`
+	PublisherCountries       common.StringArray   `xml:"PublisherCountries"`
+	MaxSuggestionsPerKeyword base.Nillable[int]   `xml:"MaxSuggestionsPerKeyword"`
+	SuggestionType           base.Nillable[int]   `xml:"SuggestionType"`
+	RemoveDuplicates         base.Nillable[bool]  `xml:"RemoveDuplicates"`
+	ExcludeBrand             base.Nillable[bool]  `xml:"ExcludeBrand"`
+	AdGroupId                base.Nillable[int64] `xml:"AdGroupId"`
+	CampaignId               base.Nillable[int64] `xml:"CampaignId"`
+}
+
+// KeywordAndConfidence 表示建议的关键词及其置信度
+type KeywordAndConfidence struct {
+	SuggestedKeyword string  `xml:"SuggestedKeyword"`
+	ConfidenceScore  float64 `xml:"ConfidenceScore"`
+}
+
+// KeywordSuggestion 表示某个关键词的建议
+type KeywordSuggestion struct {
+	Keyword                  string                 `xml:"Keyword"`
+	SuggestionsAndConfidence []KeywordAndConfidence `xml:"SuggestionsAndConfidence>KeywordAndConfidence,omitempty"`
+}
+
+// SuggestKeywordsFromExistingKeywordsResponse 响应结构体
+type SuggestKeywordsFromExistingKeywordsResponse struct {
+	XMLName            xml.Name            `xml:"SuggestKeywordsFromExistingKeywordsResponse"`
+	Namespace          string              `xml:"xmlns,attr"`
+	KeywordSuggestions []KeywordSuggestion `xml:"KeywordSuggestions>KeywordSuggestion,omitempty"`
+}
@@ -0,0 +1,188 @@
+package models
+
+import (
+	"encoding/xml"
+	"reflect"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// SearchParameter 表示 GetKeywordIdeas 的搜索条件，具体类型通过 i:type 区分
+type SearchParameter interface {
+	// SearchParameterType 返回搜索条件的 i:type 名称
+	SearchParameterType() string
+
+	isSearchParameter()
+}
+
+// DayMonthAndYear 表示日期
+type DayMonthAndYear struct {
+	Day   int `xml:"Day"`
+	Month int `xml:"Month"`
+	Year  int `xml:"Year"`
+}
+
+// NewDayMonthAndYear 根据 time.Time 创建日期
+func NewDayMonthAndYear(t time.Time) *DayMonthAndYear {
+	return &DayMonthAndYear{Day: t.Day(), Month: int(t.Month()), Year: t.Year()}
+}
+
+// LanguageCriterion 表示语言条件，例如 English
+type LanguageCriterion struct {
+	Language string `xml:"Language"`
+}
+
+// LocationCriterion 表示地理位置条件
+type LocationCriterion struct {
+	LocationId int64 `xml:"LocationId"`
+}
+
+// NetworkCriterion 表示投放网络条件
+type NetworkCriterion struct {
+	Network NetworkType `xml:"Network"`
+}
+
+// DeviceCriterion 表示设备条件，例如 Computers、Smartphones、Tablets
+type DeviceCriterion struct {
+	DeviceName string `xml:"DeviceName"`
+}
+
+// QuerySearchParameter 表示按搜索词获取建议
+type QuerySearchParameter struct {
+	Queries common.StringArray `xml:"Queries"`
+}
+
+// UrlSearchParameter 表示按网址获取建议
+type UrlSearchParameter struct {
+	Url string `xml:"Url"`
+}
+
+// CategorySearchParameter 表示按分类获取建议
+type CategorySearchParameter struct {
+	CategoryId int64 `xml:"CategoryId"`
+}
+
+// LanguageSearchParameter 表示语言条件，目前只支持一种语言
+type LanguageSearchParameter struct {
+	Languages []LanguageCriterion `xml:"Languages>LanguageCriterion"`
+}
+
+// LocationSearchParameter 表示地理位置条件
+type LocationSearchParameter struct {
+	Locations []LocationCriterion `xml:"Locations>LocationCriterion"`
+}
+
+// NetworkSearchParameter 表示投放网络条件
+type NetworkSearchParameter struct {
+	Network NetworkCriterion `xml:"Network"`
+}
+
+// DateRangeSearchParameter 表示月度搜索量的时间范围
+type DateRangeSearchParameter struct {
+	EndDate   *DayMonthAndYear `xml:"EndDate"`
+	StartDate *DayMonthAndYear `xml:"StartDate"`
+}
+
+// DeviceSearchParameter 表示设备条件
+type DeviceSearchParameter struct {
+	Device DeviceCriterion `xml:"Device"`
+}
+
+// CompetitionSearchParameter 表示按竞争程度过滤
+type CompetitionSearchParameter struct {
+	CompetitionLevels []CompetitionLevel `xml:"CompetitionLevels>CompetitionLevel"`
+}
+
+// SearchVolumeSearchParameter 表示按搜索量过滤
+type SearchVolumeSearchParameter struct {
+	Maximum base.Nillable[int64] `xml:"Maximum"`
+	Minimum base.Nillable[int64] `xml:"Minimum"`
+}
+
+// ExcludeAccountKeywordsSearchParameter 表示是否排除账户中已有的关键词
+type ExcludeAccountKeywordsSearchParameter struct {
+	ExcludeAccountKeywords bool `xml:"ExcludeAccountKeywords"`
+}
+
+// SearchParameterType 实现 SearchParameter 接口
+func (QuerySearchParameter) SearchParameterType() string { return "QuerySearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (UrlSearchParameter) SearchParameterType() string { return "UrlSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (CategorySearchParameter) SearchParameterType() string { return "CategorySearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (LanguageSearchParameter) SearchParameterType() string { return "LanguageSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (LocationSearchParameter) SearchParameterType() string { return "LocationSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (NetworkSearchParameter) SearchParameterType() string { return "NetworkSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (DateRangeSearchParameter) SearchParameterType() string { return "DateRangeSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (DeviceSearchParameter) SearchParameterType() string { return "DeviceSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (CompetitionSearchParameter) SearchParameterType() string { return "CompetitionSearchParameter" }
+
+// SearchParameterType 实现 SearchParameter 接口
+func (SearchVolumeSearchParameter) SearchParameterType() string {
+	return "SearchVolumeSearchParameter"
+}
+
+// SearchParameterType 实现 SearchParameter 接口
+func (ExcludeAccountKeywordsSearchParameter) SearchParameterType() string {
+	return "ExcludeAccountKeywordsSearchParameter"
+}
+
+func (QuerySearchParameter) isSearchParameter()                  {}
+func (UrlSearchParameter) isSearchParameter()                    {}
+func (CategorySearchParameter) isSearchParameter()               {}
+func (LanguageSearchParameter) isSearchParameter()               {}
+func (LocationSearchParameter) isSearchParameter()               {}
+func (NetworkSearchParameter) isSearchParameter()                {}
+func (DateRangeSearchParameter) isSearchParameter()              {}
+func (DeviceSearchParameter) isSearchParameter()                 {}
+func (CompetitionSearchParameter) isSearchParameter()            {}
+func (SearchVolumeSearchParameter) isSearchParameter()           {}
+func (ExcludeAccountKeywordsSearchParameter) isSearchParameter() {}
+
+// SearchParameters 表示搜索条件列表，每个条件编码为带 i:type 的 SearchParameter 元素
+type SearchParameters []SearchParameter
+
+// MarshalXML 自定义 SearchParameters 的 XML 序列化，nil 条件会被跳过
+func (p SearchParameters) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, parameter := range p {
+		if isNilSearchParameter(parameter) {
+			continue
+		}
+		itemStart := xml.StartElement{
+			Name: xml.Name{Local: "SearchParameter"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "i:type"}, Value: parameter.SearchParameterType()}},
+		}
+		if err := e.EncodeElement(parameter, itemStart); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// isNilSearchParameter 检查条件是否为 nil 或 nil 指针
+func isNilSearchParameter(parameter SearchParameter) bool {
+	if parameter == nil {
+		return true
+	}
+	v := reflect.ValueOf(parameter)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
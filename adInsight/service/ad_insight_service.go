@@ -0,0 +1,152 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/vancevox/bingads-go/adInsight/models"
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// AdInsightService 实现关键词洞察服务
+type AdInsightService struct {
+	client *Client
+}
+
+// NewAdInsightService 创建一个新的关键词洞察服务
+func NewAdInsightService(client *Client) *AdInsightService {
+	return &AdInsightService{
+		client: client,
+	}
+}
+
+// GetKeywordIdeas 根据搜索条件获取关键词建议及其分类
+//
+// searchParameters 中必须包含 QuerySearchParameter、UrlSearchParameter 或 CategorySearchParameter 之一，
+// ideaAttributes 为空时只返回关键词文本。
+func (s *AdInsightService) GetKeywordIdeas(searchParameters []models.SearchParameter, ideaAttributes []models.KeywordIdeaAttribute, expandIdeas bool) ([]models.KeywordIdea, []models.KeywordIdeaCategory, error) {
+	if len(searchParameters) == 0 {
+		return nil, nil, base.NewError(base.ErrInvalidInput, "搜索条件不能为空", nil)
+	}
+	for i, parameter := range searchParameters {
+		if parameter == nil {
+			return nil, nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("第 %d 个搜索条件为 nil", i+1), nil)
+		}
+	}
+
+	// 创建请求
+	request := models.GetKeywordIdeasRequest{
+		Namespace:        config.AdInsightNamespace,
+		ExpandIdeas:      expandIdeas,
+		IdeaAttributes:   ideaAttributes,
+		SearchParameters: searchParameters,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetKeywordIdeas, &models.AdInsightBody{
+		GetKeywordIdeasRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if body.GetKeywordIdeasResponse == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetKeywordIdeas)
+	}
+	return body.GetKeywordIdeasResponse.KeywordIdeas, body.GetKeywordIdeasResponse.KeywordIdeaCategories, nil
+}
+
+// GetKeywordTrafficEstimates 估算关键词在给定出价和预算下的流量
+func (s *AdInsightService) GetKeywordTrafficEstimates(campaignEstimators []models.CampaignEstimator) ([]models.CampaignEstimate, error) {
+	if len(campaignEstimators) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "估算条件不能为空", nil)
+	}
+
+	// 创建请求
+	request := models.GetKeywordTrafficEstimatesRequest{
+		Namespace:          config.AdInsightNamespace,
+		CampaignEstimators: campaignEstimators,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetKeywordTrafficEstimates, &models.AdInsightBody{
+		GetKeywordTrafficEstimatesRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetKeywordTrafficEstimatesResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetKeywordTrafficEstimates)
+	}
+	return body.GetKeywordTrafficEstimatesResponse.CampaignEstimates, nil
+}
+
+// GetBidLandscapeByKeywordIds 获取已有关键词的出价模拟
+func (s *AdInsightService) GetBidLandscapeByKeywordIds(keywordIds []int64, includeCurrentBid bool) ([]models.KeywordBidLandscape, error) {
+	if len(keywordIds) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "关键词 ID 不能为空", nil)
+	}
+
+	// 创建请求
+	request := models.GetBidLandscapeByKeywordIdsRequest{
+		Namespace:         config.AdInsightNamespace,
+		KeywordIds:        keywordIds,
+		IncludeCurrentBid: includeCurrentBid,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBidLandscapeByKeywordIds, &models.AdInsightBody{
+		GetBidLandscapeByKeywordIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetBidLandscapeByKeywordIdsResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetBidLandscapeByKeywordIds)
+	}
+	return body.GetBidLandscapeByKeywordIdsResponse.BidLandscape, nil
+}
+
+// GetEstimatedBidByKeywords 估算关键词达到目标广告位置所需的出价
+func (s *AdInsightService) GetEstimatedBidByKeywords(request *models.GetEstimatedBidByKeywordsRequest) ([]models.KeywordEstimatedBid, error) {
+	if request == nil || len(request.Keywords) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "关键词不能为空", nil)
+	}
+	request.Namespace = config.AdInsightNamespace
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetEstimatedBidByKeywords, &models.AdInsightBody{
+		GetEstimatedBidByKeywordsRequest: request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetEstimatedBidByKeywordsResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetEstimatedBidByKeywords)
+	}
+	return body.GetEstimatedBidByKeywordsResponse.KeywordEstimatedBids, nil
+}
+
+// SuggestKeywordsFromExistingKeywords 根据已有关键词推荐新的关键词
+func (s *AdInsightService) SuggestKeywordsFromExistingKeywords(request *models.SuggestKeywordsFromExistingKeywordsRequest) ([]models.KeywordSuggestion, error) {
+	if request == nil || len(request.Keywords) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "关键词不能为空", nil)
+	}
+	request.Namespace = config.AdInsightNamespace
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSuggestKeywordsFromExistingKeywords, &models.AdInsightBody{
+		SuggestKeywordsFromExistingKeywordsRequest: request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.SuggestKeywordsFromExistingKeywordsResponse == nil {
+		return nil, missingResponse(models.SOAPActionSuggestKeywordsFromExistingKeywords)
+	}
+	return body.SuggestKeywordsFromExistingKeywordsResponse.KeywordSuggestions, nil
+}
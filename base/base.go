@@ -157,6 +157,7 @@ type ResponseHeader struct {
 	TrackingId string   `xml:"TrackingId"`
 }
 
+// Fault 表示 SOAP 故障
 type Fault struct {
 	FaultCode   string `xml:"faultcode"`
 	FaultString string `xml:"faultstring"`
@@ -172,5 +173,23 @@ type Fault struct {
 				} `xml:"AdApiError"`
 			} `xml:"Errors"`
 		} `xml:"AdApiFaultDetail"`
+		// Campaign Management 等服务的操作错误
+		ApiFaultDetail struct {
+			TrackingId      string           `xml:"TrackingId"`
+			OperationErrors []OperationError `xml:"OperationErrors>OperationError"`
+		} `xml:"ApiFaultDetail"`
+		// Customer Management 等服务的操作错误
+		ApiFault struct {
+			TrackingId      string           `xml:"TrackingId"`
+			OperationErrors []OperationError `xml:"OperationErrors>OperationError"`
+		} `xml:"ApiFault"`
 	} `xml:"detail"`
 }
+
+// OperationError 表示 SOAP 故障中的操作错误
+type OperationError struct {
+	Code      int    `xml:"Code"`
+	Details   string `xml:"Details"`
+	ErrorCode string `xml:"ErrorCode"`
+	Message   string `xml:"Message"`
+}
@@ -22,6 +22,9 @@ const (
 	ErrAPIError       = "API_ERROR"
 	ErrAuthError      = "AUTH_ERROR"
 	ErrRateLimitError = "RATE_LIMIT_ERROR"
+
+	// 本地错误
+	ErrFileIOFail = "FILE_IO_FAIL"
 )
 
 // BingAdsError 表示 Bing Ads API 错误
@@ -0,0 +1,180 @@
+package base
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+)
+
+// nillableState 表示可空字段的状态
+type nillableState uint8
+
+const (
+	// 未设置，序列化时省略该元素
+	nillableUnset nillableState = iota
+	// 显式置空，序列化为 i:nil="true"
+	nillableNull
+	// 有值
+	nillableValue
+)
+
+// Nillable 表示一个可区分"未设置"、"显式置空"和"有值"三种状态的字段
+//
+// Bing Ads 的更新语义依赖这三种状态：未设置的元素不会被修改，
+// 带 i:nil="true" 的元素会被清空，其余元素会被更新为给定的值。
+type Nillable[T any] struct {
+	value T
+	state nillableState
+}
+
+// NewNillable 创建一个有值的可空字段
+func NewNillable[T any](v T) Nillable[T] {
+	return Nillable[T]{value: v, state: nillableValue}
+}
+
+// Null 创建一个显式置空的可空字段
+func Null[T any]() Nillable[T] {
+	return Nillable[T]{state: nillableNull}
+}
+
+// Int64 创建一个有值的 int64 可空字段
+func Int64(v int64) Nillable[int64] {
+	return NewNillable(v)
+}
+
+// Int 创建一个有值的 int 可空字段
+func Int(v int) Nillable[int] {
+	return NewNillable(v)
+}
+
+// String 创建一个有值的 string 可空字段
+func String(v string) Nillable[string] {
+	return NewNillable(v)
+}
+
+// Get 返回字段的值以及是否有值
+func (n Nillable[T]) Get() (T, bool) {
+	return n.value, n.state == nillableValue
+}
+
+// Value 返回字段的值，未设置或置空时返回零值
+func (n Nillable[T]) Value() T {
+	return n.value
+}
+
+// IsSet 检查字段是否被设置（有值或显式置空）
+func (n Nillable[T]) IsSet() bool {
+	return n.state != nillableUnset
+}
+
+// IsNull 检查字段是否被显式置空
+func (n Nillable[T]) IsNull() bool {
+	return n.state == nillableNull
+}
+
+// HasValue 检查字段是否有值
+func (n Nillable[T]) HasValue() bool {
+	return n.state == nillableValue
+}
+
+// Set 设置字段的值
+func (n *Nillable[T]) Set(v T) {
+	n.value = v
+	n.state = nillableValue
+}
+
+// SetNull 将字段显式置空
+func (n *Nillable[T]) SetNull() {
+	var zero T
+	n.value = zero
+	n.state = nillableNull
+}
+
+// Unset 将字段恢复为未设置状态
+func (n *Nillable[T]) Unset() {
+	var zero T
+	n.value = zero
+	n.state = nillableUnset
+}
+
+// MarshalXML 自定义 Nillable 的 XML 序列化
+func (n Nillable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	switch n.state {
+	case nillableNull:
+		// 与 i:type 一样，直接引用根元素中定义的 i 前缀
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "i:nil"},
+			Value: "true",
+		})
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+		return e.EncodeToken(start.End())
+	case nillableValue:
+		return e.EncodeElement(n.value, start)
+	default:
+		// 未设置时不输出任何内容
+		return nil
+	}
+}
+
+// UnmarshalXML 自定义 Nillable 的 XML 反序列化
+func (n *Nillable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if IsNilElement(start) {
+		n.SetNull()
+		return d.Skip()
+	}
+
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// jsonNull 为显式置空字段的 JSON 表示，与表示未设置的 null 区分
+var jsonNull = []byte(`{"nil":true}`)
+
+// IsZero 检查字段是否未设置，用于 json 的 omitzero 选项
+func (n Nillable[T]) IsZero() bool {
+	return n.state == nillableUnset
+}
+
+// MarshalJSON 自定义 Nillable 的 JSON 序列化
+//
+// 未设置输出 null，显式置空输出 {"nil":true}，使两种状态经过 JSON 往返后保持不变。
+// T 本身的值不能是 {"nil":true}，否则读回时会被视为显式置空。
+func (n Nillable[T]) MarshalJSON() ([]byte, error) {
+	switch n.state {
+	case nillableNull:
+		return jsonNull, nil
+	case nillableValue:
+		return json.Marshal(n.value)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// UnmarshalJSON 自定义 Nillable 的 JSON 反序列化
+//
+// null 和缺少的字段一样视为未设置，{"nil":true} 视为显式置空。
+func (n *Nillable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Unset()
+		return nil
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err == nil && bytes.Equal(compact.Bytes(), jsonNull) {
+		n.SetNull()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
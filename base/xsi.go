@@ -0,0 +1,39 @@
+package base
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// isXSIAttr 检查属性是否为 XSI 命名空间下的指定属性
+func isXSIAttr(attr xml.Attr, local string) bool {
+	// 直接使用限定名称的情况（与序列化时的写法一致）
+	if attr.Name.Space == "" && attr.Name.Local == "i:"+local {
+		return true
+	}
+	// 命名空间已声明时 Space 为 XSI 命名空间，未声明时为前缀本身
+	return attr.Name.Local == local && (attr.Name.Space == XSINamespace || attr.Name.Space == "i")
+}
+
+// IsNilElement 检查元素是否带有 i:nil="true" 属性
+func IsNilElement(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if isXSIAttr(attr, "nil") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
+
+// XSIType 返回元素 i:type 属性的类型名称（去掉命名空间前缀），不存在时返回空字符串
+func XSIType(start xml.StartElement) string {
+	for _, attr := range start.Attr {
+		if isXSIAttr(attr, "type") {
+			if idx := strings.LastIndex(attr.Value, ":"); idx >= 0 {
+				return attr.Value[idx+1:]
+			}
+			return attr.Value
+		}
+	}
+	return ""
+}
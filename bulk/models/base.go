@@ -0,0 +1,34 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// BulkBody 表示请求体
+type BulkBody struct {
+	XMLName                              xml.Name                              `xml:"s:Body"`
+	DownloadCampaignsByAccountIdsRequest *DownloadCampaignsByAccountIdsRequest `xml:"DownloadCampaignsByAccountIdsRequest,omitempty"`
+	GetBulkDownloadStatusRequest         *GetBulkDownloadStatusRequest         `xml:"GetBulkDownloadStatusRequest,omitempty"`
+	GetBulkUploadUrlRequest              *GetBulkUploadUrlRequest              `xml:"GetBulkUploadUrlRequest,omitempty"`
+	GetBulkUploadStatusRequest           *GetBulkUploadStatusRequest           `xml:"GetBulkUploadStatusRequest,omitempty"`
+}
+
+// BulkResponseBody 表示响应体
+type BulkResponseBody struct {
+	XMLName                               xml.Name                               `xml:"Body"`
+	Fault                                 *base.Fault                            `xml:"Fault,omitempty"`
+	DownloadCampaignsByAccountIdsResponse *DownloadCampaignsByAccountIdsResponse `xml:"DownloadCampaignsByAccountIdsResponse,omitempty"`
+	GetBulkDownloadStatusResponse         *GetBulkDownloadStatusResponse         `xml:"GetBulkDownloadStatusResponse,omitempty"`
+	GetBulkUploadUrlResponse              *GetBulkUploadUrlResponse              `xml:"GetBulkUploadUrlResponse,omitempty"`
+	GetBulkUploadStatusResponse           *GetBulkUploadStatusResponse           `xml:"GetBulkUploadStatusResponse,omitempty"`
+}
+
+// BulkResponseEnvelope 表示完整的 SOAP 响应
+type BulkResponseEnvelope struct {
+	XMLName xml.Name            `xml:"Envelope"`
+	XmlnsS  string              `xml:"xmlns:s,attr,omitempty"`
+	Header  base.ResponseHeader `xml:"Header"`
+	Body    BulkResponseBody    `xml:"Body"`
+}
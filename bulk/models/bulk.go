@@ -0,0 +1,88 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// BulkStatus 表示批量下载或上传的状态
+type BulkStatus struct {
+	Errors          []base.OperationError `xml:"Errors>OperationError,omitempty"`
+	PercentComplete int                   `xml:"PercentComplete"`
+	RequestStatus   string                `xml:"RequestStatus"`
+	ResultFileUrl   string                `xml:"ResultFileUrl"`
+}
+
+// BulkUploadUrl 表示批量上传的地址
+type BulkUploadUrl struct {
+	RequestId string `xml:"RequestId"`
+	UploadUrl string `xml:"UploadUrl"`
+}
+
+// DownloadCampaignsByAccountIdsRequest 请求结构体
+type DownloadCampaignsByAccountIdsRequest struct {
+	XMLName          xml.Name         `xml:"DownloadCampaignsByAccountIdsRequest"`
+	Namespace        string           `xml:"xmlns,attr"`
+	AccountIds       common.LongArray `xml:"AccountIds"`
+	CompressionType  CompressionType  `xml:"CompressionType,omitempty"`
+	DataScope        DataScope        `xml:"DataScope"`
+	DownloadEntities []DownloadEntity `xml:"DownloadEntities>DownloadEntity"`
+	DownloadFileType DownloadFileType `xml:"DownloadFileType"`
+	FormatVersion    string           `xml:"FormatVersion"`
+
+	// 上次下载的时间，设置后只下载之后发生变化的实体
+	LastSyncTimeInUTC *time.Time `xml:"LastSyncTimeInUTC,omitempty"`
+}
+
+// DownloadCampaignsByAccountIdsResponse 响应结构体
+type DownloadCampaignsByAccountIdsResponse struct {
+	XMLName           xml.Name `xml:"DownloadCampaignsByAccountIdsResponse"`
+	Namespace         string   `xml:"xmlns,attr"`
+	DownloadRequestId string   `xml:"DownloadRequestId"`
+}
+
+// GetBulkDownloadStatusRequest 请求结构体
+type GetBulkDownloadStatusRequest struct {
+	XMLName   xml.Name `xml:"GetBulkDownloadStatusRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	RequestId string   `xml:"RequestId"`
+}
+
+// GetBulkDownloadStatusResponse 响应结构体
+type GetBulkDownloadStatusResponse struct {
+	XMLName   xml.Name `xml:"GetBulkDownloadStatusResponse"`
+	Namespace string   `xml:"xmlns,attr"`
+	BulkStatus
+}
+
+// GetBulkUploadUrlRequest 请求结构体
+type GetBulkUploadUrlRequest struct {
+	XMLName      xml.Name     `xml:"GetBulkUploadUrlRequest"`
+	Namespace    string       `xml:"xmlns,attr"`
+	ResponseMode ResponseMode `xml:"ResponseMode"`
+	AccountId    int64        `xml:"AccountId"`
+}
+
+// GetBulkUploadUrlResponse 响应结构体
+type GetBulkUploadUrlResponse struct {
+	XMLName   xml.Name `xml:"GetBulkUploadUrlResponse"`
+	Namespace string   `xml:"xmlns,attr"`
+	BulkUploadUrl
+}
+
+// GetBulkUploadStatusRequest 请求结构体
+type GetBulkUploadStatusRequest struct {
+	XMLName   xml.Name `xml:"GetBulkUploadStatusRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	RequestId string   `xml:"RequestId"`
+}
+
+// GetBulkUploadStatusResponse 响应结构体
+type GetBulkUploadStatusResponse struct {
+	XMLName   xml.Name `xml:"GetBulkUploadStatusResponse"`
+	Namespace string   `xml:"xmlns,attr"`
+	BulkStatus
+}
@@ -0,0 +1,83 @@
+package models
+
+type SOAPAction string
+
+const (
+	SOAPActionDownloadCampaignsByAccountIds SOAPAction = "DownloadCampaignsByAccountIds"
+	SOAPActionGetBulkDownloadStatus         SOAPAction = "GetBulkDownloadStatus"
+	SOAPActionGetBulkUploadUrl              SOAPAction = "GetBulkUploadUrl"
+	SOAPActionGetBulkUploadStatus           SOAPAction = "GetBulkUploadStatus"
+)
+
+// DefaultFormatVersion 是批量文件的默认格式版本
+const DefaultFormatVersion = "6.0"
+
+// DownloadEntity 表示批量下载的实体类型
+type DownloadEntity string
+
+const (
+	DownloadEntityCampaigns                               DownloadEntity = "Campaigns"
+	DownloadEntityAdGroups                                DownloadEntity = "AdGroups"
+	DownloadEntityKeywords                                DownloadEntity = "Keywords"
+	DownloadEntityResponsiveSearchAds                     DownloadEntity = "ResponsiveSearchAds"
+	DownloadEntityCampaignNegativeKeywords                DownloadEntity = "CampaignNegativeKeywords"
+	DownloadEntityAdGroupNegativeKeywords                 DownloadEntity = "AdGroupNegativeKeywords"
+	DownloadEntityNegativeKeywordLists                    DownloadEntity = "NegativeKeywordLists"
+	DownloadEntitySharedNegativeKeywords                  DownloadEntity = "SharedNegativeKeywords"
+	DownloadEntityCampaignNegativeKeywordListAssociations DownloadEntity = "CampaignNegativeKeywordListAssociations"
+)
+
+// DataScope 表示批量下载包含的数据范围，多个范围用空格分隔，例如 "EntityData QualityScoreData"
+type DataScope string
+
+const (
+	DataScopeEntityData            DataScope = "EntityData"
+	DataScopeQualityScoreData      DataScope = "QualityScoreData"
+	DataScopeBidSuggestionsData    DataScope = "BidSuggestionsData"
+	DataScopeEntityPerformanceData DataScope = "EntityPerformanceData"
+)
+
+// DownloadFileType 表示批量文件格式
+type DownloadFileType string
+
+const (
+	DownloadFileTypeCsv DownloadFileType = "Csv"
+	DownloadFileTypeTsv DownloadFileType = "Tsv"
+)
+
+// CompressionType 表示批量下载文件的压缩方式
+type CompressionType string
+
+const (
+	CompressionTypeZip  CompressionType = "Zip"
+	CompressionTypeGZip CompressionType = "GZip"
+)
+
+// ResponseMode 表示上传结果文件包含的内容
+type ResponseMode string
+
+const (
+	ResponseModeErrorsOnly       ResponseMode = "ErrorsOnly"
+	ResponseModeErrorsAndResults ResponseMode = "ErrorsAndResults"
+)
+
+// 批量下载的状态
+const (
+	DownloadStatusInProgress             = "InProgress"
+	DownloadStatusCompleted              = "Completed"
+	DownloadStatusFailed                 = "Failed"
+	DownloadStatusFailedFullSyncRequired = "FailedFullSyncRequired"
+)
+
+// 批量上传的状态
+const (
+	UploadStatusPendingFileUpload            = "PendingFileUpload"
+	UploadStatusFileUploaded                 = "FileUploaded"
+	UploadStatusInProgress                   = "InProgress"
+	UploadStatusCompleted                    = "Completed"
+	UploadStatusCompletedWithErrors          = "CompletedWithErrors"
+	UploadStatusFailed                       = "Failed"
+	UploadStatusExpired                      = "Expired"
+	UploadStatusUploadFileRowCountExceeded   = "UploadFileRowCountExceeded"
+	UploadStatusUploadFileFormatNotSupported = "UploadFileFormatNotSupported"
+)
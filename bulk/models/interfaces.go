@@ -0,0 +1,43 @@
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// BulkAPI 定义Bulk API的操作
+type BulkAPI interface {
+	// BulkService 返回批量服务
+	BulkService() BulkService
+}
+
+// BulkService 定义批量下载和上传的操作
+type BulkService interface {
+	// DownloadCampaignsByAccountIds 提交批量下载请求，返回下载请求 ID
+	DownloadCampaignsByAccountIds(request DownloadCampaignsByAccountIdsRequest) (string, error)
+
+	// GetBulkDownloadStatus 查询批量下载的状态
+	GetBulkDownloadStatus(requestId string) (*BulkStatus, error)
+
+	// WaitForDownload 按 interval 轮询直到批量下载完成、失败或 ctx 结束
+	WaitForDownload(ctx context.Context, requestId string, interval time.Duration) (*BulkStatus, error)
+
+	// GetBulkUploadUrl 获取批量上传的地址和请求 ID
+	GetBulkUploadUrl(accountId int64, responseMode ResponseMode) (*BulkUploadUrl, error)
+
+	// UploadFile 将 CSV 内容压缩后上传到 GetBulkUploadUrl 返回的地址
+	UploadFile(ctx context.Context, uploadUrl string, fileName string, csv io.Reader) error
+
+	// UploadZipFile 上传已经压缩的批量文件
+	UploadZipFile(ctx context.Context, uploadUrl string, fileName string, zipped io.Reader) error
+
+	// GetBulkUploadStatus 查询批量上传的状态
+	GetBulkUploadStatus(requestId string) (*BulkStatus, error)
+
+	// WaitForUpload 按 interval 轮询直到批量上传处理完成、失败或 ctx 结束
+	WaitForUpload(ctx context.Context, requestId string, interval time.Duration) (*BulkStatus, error)
+
+	// DownloadResultFile 下载并解压批量下载文件或上传结果文件，调用方负责关闭
+	DownloadResultFile(ctx context.Context, resultFileUrl string) (io.ReadCloser, error)
+}
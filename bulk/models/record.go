@@ -0,0 +1,586 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 批量文件的列名
+const (
+	ColumnType         = "Type"
+	ColumnStatus       = "Status"
+	ColumnId           = "Id"
+	ColumnParentId     = "Parent Id"
+	ColumnCampaign     = "Campaign"
+	ColumnAdGroup      = "Ad Group"
+	ColumnClientId     = "Client Id"
+	ColumnModifiedTime = "Modified Time"
+	ColumnSyncTime     = "Sync Time"
+	ColumnName         = "Name"
+	ColumnKeyword      = "Keyword"
+	ColumnMatchType    = "Match Type"
+	ColumnBid          = "Bid"
+	ColumnCpcBid       = "Cpc Bid"
+	ColumnFinalUrl     = "Final Url"
+	ColumnBudget       = "Budget"
+	ColumnBudgetType   = "Budget Type"
+	ColumnTimeZone     = "Time Zone"
+	ColumnCampaignType = "Campaign Type"
+	ColumnLanguage     = "Language"
+	ColumnStartDate    = "Start Date"
+	ColumnEndDate      = "End Date"
+	ColumnNetwork      = "Network"
+	ColumnHeadline     = "Headline"
+	ColumnDescription  = "Description"
+	ColumnPath1        = "Path 1"
+	ColumnPath2        = "Path 2"
+	ColumnError        = "Error"
+	ColumnErrorNumber  = "Error Number"
+)
+
+// BulkColumns 是写入批量文件时使用的列，包含所有支持的记录类型用到的列
+var BulkColumns = []string{
+	ColumnType, ColumnStatus, ColumnId, ColumnParentId, ColumnCampaign, ColumnAdGroup,
+	ColumnClientId, ColumnModifiedTime, ColumnSyncTime, ColumnName, ColumnKeyword, ColumnMatchType,
+	ColumnBid, ColumnCpcBid, ColumnFinalUrl, ColumnBudget, ColumnBudgetType, ColumnTimeZone,
+	ColumnCampaignType, ColumnLanguage, ColumnStartDate, ColumnEndDate, ColumnNetwork,
+	ColumnHeadline, ColumnDescription, ColumnPath1, ColumnPath2, ColumnError, ColumnErrorNumber,
+}
+
+// 批量文件的记录类型，即 Type 列的值
+const (
+	RecordTypeFormatVersion                          = "Format Version"
+	RecordTypeAccount                                = "Account"
+	RecordTypeCampaign                               = "Campaign"
+	RecordTypeAdGroup                                = "Ad Group"
+	RecordTypeKeyword                                = "Keyword"
+	RecordTypeNegativeKeywordList                    = "Negative Keyword List"
+	RecordTypeSharedNegativeKeyword                  = "Shared Negative Keyword"
+	RecordTypeCampaignNegativeKeywordListAssociation = "Campaign Negative Keyword List Association"
+	RecordTypeResponsiveSearchAd                     = "Responsive Search Ad"
+)
+
+// errorRecordSuffix 是上传结果文件中错误行 Type 列的后缀，例如 "Keyword Error"
+const errorRecordSuffix = " Error"
+
+// BulkRow 表示批量文件中的一行，键为列名
+type BulkRow map[string]string
+
+// String 返回列的值
+func (r BulkRow) String(column string) string {
+	return strings.TrimSpace(r[column])
+}
+
+// Int64 解析整数列，空值时返回 0
+func (r BulkRow) Int64(column string) (int64, error) {
+	value := r.String(column)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// Float64 解析数值列，空值时返回 0
+func (r BulkRow) Float64(column string) (float64, error) {
+	value := r.String(column)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// SetString 设置列的值，空字符串不写入
+func (r BulkRow) SetString(column, value string) {
+	if value != "" {
+		r[column] = value
+	}
+}
+
+// SetInt64 设置整数列，0 不写入
+func (r BulkRow) SetInt64(column string, value int64) {
+	if value != 0 {
+		r[column] = strconv.FormatInt(value, 10)
+	}
+}
+
+// SetFloat64 设置数值列，0 不写入
+func (r BulkRow) SetFloat64(column string, value float64) {
+	if value != 0 {
+		r[column] = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+}
+
+// BulkRowError 表示批量文件中某一列的值无法解析
+type BulkRowError struct {
+	Column string
+	Value  string
+	Err    error
+}
+
+// Error 实现 error 接口
+func (e *BulkRowError) Error() string {
+	return fmt.Sprintf("%s 列的值 %q 无法解析: %v", e.Column, e.Value, e.Err)
+}
+
+// Unwrap 返回底层错误
+func (e *BulkRowError) Unwrap() error {
+	return e.Err
+}
+
+// rowParser 依次解析多个列，记录第一个错误
+type rowParser struct {
+	row BulkRow
+	err error
+}
+
+func (p *rowParser) int64(column string) int64 {
+	v, err := p.row.Int64(column)
+	if err != nil && p.err == nil {
+		p.err = &BulkRowError{Column: column, Value: p.row[column], Err: err}
+	}
+	return v
+}
+
+func (p *rowParser) int(column string) int {
+	return int(p.int64(column))
+}
+
+func (p *rowParser) float64(column string) float64 {
+	v, err := p.row.Float64(column)
+	if err != nil && p.err == nil {
+		p.err = &BulkRowError{Column: column, Value: p.row[column], Err: err}
+	}
+	return v
+}
+
+// BulkRecord 表示批量文件中的一条记录
+type BulkRecord interface {
+	// RecordType 返回 Type 列的值
+	RecordType() string
+
+	// MarshalBulkRow 将记录写入行
+	MarshalBulkRow(row BulkRow)
+}
+
+// bulkRecordDecoder 是可以从行中解析的记录
+type bulkRecordDecoder interface {
+	BulkRecord
+	unmarshalBulkRow(row BulkRow) error
+}
+
+// 记录类型到构造函数的映射
+var recordFactories = map[string]func() bulkRecordDecoder{
+	RecordTypeFormatVersion:                          func() bulkRecordDecoder { return &FormatVersion{} },
+	RecordTypeAccount:                                func() bulkRecordDecoder { return &Account{} },
+	RecordTypeCampaign:                               func() bulkRecordDecoder { return &Campaign{} },
+	RecordTypeAdGroup:                                func() bulkRecordDecoder { return &AdGroup{} },
+	RecordTypeKeyword:                                func() bulkRecordDecoder { return &Keyword{} },
+	RecordTypeNegativeKeywordList:                    func() bulkRecordDecoder { return &NegativeKeywordList{} },
+	RecordTypeSharedNegativeKeyword:                  func() bulkRecordDecoder { return &SharedNegativeKeyword{} },
+	RecordTypeCampaignNegativeKeywordListAssociation: func() bulkRecordDecoder { return &CampaignNegativeKeywordListAssociation{} },
+	RecordTypeResponsiveSearchAd:                     func() bulkRecordDecoder { return &ResponsiveSearchAd{} },
+}
+
+// DecodeBulkRow 根据 Type 列将行解析为具体的记录
+//
+// 上传结果文件中的错误行解析为 *BulkError，不支持的类型解析为 *UnknownRecord。
+func DecodeBulkRow(row BulkRow) (BulkRecord, error) {
+	recordType := row.String(ColumnType)
+
+	factory, ok := recordFactories[recordType]
+	if !ok {
+		if strings.HasSuffix(recordType, errorRecordSuffix) {
+			record := &BulkError{}
+			return record, record.unmarshalBulkRow(row)
+		}
+		return &UnknownRecord{Type: recordType, Row: row}, nil
+	}
+
+	record := factory()
+	if err := record.unmarshalBulkRow(row); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// RowBase 包含大多数记录共有的列
+type RowBase struct {
+	Status       string
+	Id           int64
+	ParentId     int64
+	ClientId     string
+	ModifiedTime string
+
+	// 上传结果文件中该行的错误信息
+	Error       string
+	ErrorNumber int
+}
+
+// BulkRowBase 返回记录共有的列
+func (b RowBase) BulkRowBase() RowBase {
+	return b
+}
+
+// HasError 判断上传结果中该行是否有错误
+func (b RowBase) HasError() bool {
+	return b.Error != "" || b.ErrorNumber != 0
+}
+
+func (b RowBase) marshalBase(recordType string, row BulkRow) {
+	row[ColumnType] = recordType
+	row.SetString(ColumnStatus, b.Status)
+	row.SetInt64(ColumnId, b.Id)
+	row.SetInt64(ColumnParentId, b.ParentId)
+	row.SetString(ColumnClientId, b.ClientId)
+	row.SetString(ColumnModifiedTime, b.ModifiedTime)
+	row.SetString(ColumnError, b.Error)
+	row.SetInt64(ColumnErrorNumber, int64(b.ErrorNumber))
+}
+
+func (b *RowBase) unmarshalBase(p *rowParser) {
+	b.Status = p.row.String(ColumnStatus)
+	b.Id = p.int64(ColumnId)
+	b.ParentId = p.int64(ColumnParentId)
+	b.ClientId = p.row.String(ColumnClientId)
+	b.ModifiedTime = p.row.String(ColumnModifiedTime)
+	b.Error = p.row.String(ColumnError)
+	b.ErrorNumber = p.int(ColumnErrorNumber)
+}
+
+// FormatVersion 表示文件格式版本行，版本号位于 Name 列
+type FormatVersion struct {
+	Version string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (FormatVersion) RecordType() string { return RecordTypeFormatVersion }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (f FormatVersion) MarshalBulkRow(row BulkRow) {
+	row[ColumnType] = RecordTypeFormatVersion
+	row.SetString(ColumnName, f.Version)
+}
+
+func (f *FormatVersion) unmarshalBulkRow(row BulkRow) error {
+	f.Version = row.String(ColumnName)
+	return nil
+}
+
+// Account 表示账户行，Parent Id 为客户 ID
+type Account struct {
+	RowBase
+	Name     string
+	SyncTime string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (Account) RecordType() string { return RecordTypeAccount }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (a Account) MarshalBulkRow(row BulkRow) {
+	a.marshalBase(RecordTypeAccount, row)
+	row.SetString(ColumnName, a.Name)
+	row.SetString(ColumnSyncTime, a.SyncTime)
+}
+
+func (a *Account) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	a.unmarshalBase(p)
+	a.Name = row.String(ColumnName)
+	a.SyncTime = row.String(ColumnSyncTime)
+	return p.err
+}
+
+// Campaign 表示活动行，Parent Id 为账户 ID
+type Campaign struct {
+	RowBase
+	Name         string
+	Budget       float64
+	BudgetType   string
+	TimeZone     string
+	CampaignType string
+	Language     string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (Campaign) RecordType() string { return RecordTypeCampaign }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (c Campaign) MarshalBulkRow(row BulkRow) {
+	c.marshalBase(RecordTypeCampaign, row)
+	row.SetString(ColumnCampaign, c.Name)
+	row.SetFloat64(ColumnBudget, c.Budget)
+	row.SetString(ColumnBudgetType, c.BudgetType)
+	row.SetString(ColumnTimeZone, c.TimeZone)
+	row.SetString(ColumnCampaignType, c.CampaignType)
+	row.SetString(ColumnLanguage, c.Language)
+}
+
+func (c *Campaign) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	c.unmarshalBase(p)
+	c.Name = row.String(ColumnCampaign)
+	c.Budget = p.float64(ColumnBudget)
+	c.BudgetType = row.String(ColumnBudgetType)
+	c.TimeZone = row.String(ColumnTimeZone)
+	c.CampaignType = row.String(ColumnCampaignType)
+	c.Language = row.String(ColumnLanguage)
+	return p.err
+}
+
+// AdGroup 表示广告组行，Parent Id 为活动 ID
+type AdGroup struct {
+	RowBase
+	CampaignName string
+	Name         string
+	CpcBid       float64
+	Language     string
+	StartDate    string
+	EndDate      string
+	Network      string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (AdGroup) RecordType() string { return RecordTypeAdGroup }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (g AdGroup) MarshalBulkRow(row BulkRow) {
+	g.marshalBase(RecordTypeAdGroup, row)
+	row.SetString(ColumnCampaign, g.CampaignName)
+	row.SetString(ColumnAdGroup, g.Name)
+	row.SetFloat64(ColumnCpcBid, g.CpcBid)
+	row.SetString(ColumnLanguage, g.Language)
+	row.SetString(ColumnStartDate, g.StartDate)
+	row.SetString(ColumnEndDate, g.EndDate)
+	row.SetString(ColumnNetwork, g.Network)
+}
+
+func (g *AdGroup) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	g.unmarshalBase(p)
+	g.CampaignName = row.String(ColumnCampaign)
+	g.Name = row.String(ColumnAdGroup)
+	g.CpcBid = p.float64(ColumnCpcBid)
+	g.Language = row.String(ColumnLanguage)
+	g.StartDate = row.String(ColumnStartDate)
+	g.EndDate = row.String(ColumnEndDate)
+	g.Network = row.String(ColumnNetwork)
+	return p.err
+}
+
+// Keyword 表示关键词行，Parent Id 为广告组 ID
+type Keyword struct {
+	RowBase
+	CampaignName string
+	AdGroupName  string
+	Text         string
+	MatchType    string
+	Bid          float64
+	FinalUrl     string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (Keyword) RecordType() string { return RecordTypeKeyword }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (k Keyword) MarshalBulkRow(row BulkRow) {
+	k.marshalBase(RecordTypeKeyword, row)
+	row.SetString(ColumnCampaign, k.CampaignName)
+	row.SetString(ColumnAdGroup, k.AdGroupName)
+	row.SetString(ColumnKeyword, k.Text)
+	row.SetString(ColumnMatchType, k.MatchType)
+	row.SetFloat64(ColumnBid, k.Bid)
+	row.SetString(ColumnFinalUrl, k.FinalUrl)
+}
+
+func (k *Keyword) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	k.unmarshalBase(p)
+	k.CampaignName = row.String(ColumnCampaign)
+	k.AdGroupName = row.String(ColumnAdGroup)
+	k.Text = row.String(ColumnKeyword)
+	k.MatchType = row.String(ColumnMatchType)
+	k.Bid = p.float64(ColumnBid)
+	k.FinalUrl = row.String(ColumnFinalUrl)
+	return p.err
+}
+
+// NegativeKeywordList 表示否定关键词列表行，Parent Id 为账户 ID
+type NegativeKeywordList struct {
+	RowBase
+	Name string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (NegativeKeywordList) RecordType() string { return RecordTypeNegativeKeywordList }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (l NegativeKeywordList) MarshalBulkRow(row BulkRow) {
+	l.marshalBase(RecordTypeNegativeKeywordList, row)
+	row.SetString(ColumnName, l.Name)
+}
+
+func (l *NegativeKeywordList) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	l.unmarshalBase(p)
+	l.Name = row.String(ColumnName)
+	return p.err
+}
+
+// SharedNegativeKeyword 表示否定关键词列表中的关键词行，Parent Id 为列表 ID
+type SharedNegativeKeyword struct {
+	RowBase
+	Text      string
+	MatchType string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (SharedNegativeKeyword) RecordType() string { return RecordTypeSharedNegativeKeyword }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (k SharedNegativeKeyword) MarshalBulkRow(row BulkRow) {
+	k.marshalBase(RecordTypeSharedNegativeKeyword, row)
+	row.SetString(ColumnKeyword, k.Text)
+	row.SetString(ColumnMatchType, k.MatchType)
+}
+
+func (k *SharedNegativeKeyword) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	k.unmarshalBase(p)
+	k.Text = row.String(ColumnKeyword)
+	k.MatchType = row.String(ColumnMatchType)
+	return p.err
+}
+
+// CampaignNegativeKeywordListAssociation 表示活动与否定关键词列表的关联行
+//
+// Id 为列表 ID，Parent Id 为活动 ID。
+type CampaignNegativeKeywordListAssociation struct {
+	RowBase
+	CampaignName string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (CampaignNegativeKeywordListAssociation) RecordType() string {
+	return RecordTypeCampaignNegativeKeywordListAssociation
+}
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (a CampaignNegativeKeywordListAssociation) MarshalBulkRow(row BulkRow) {
+	a.marshalBase(RecordTypeCampaignNegativeKeywordListAssociation, row)
+	row.SetString(ColumnCampaign, a.CampaignName)
+}
+
+func (a *CampaignNegativeKeywordListAssociation) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	a.unmarshalBase(p)
+	a.CampaignName = row.String(ColumnCampaign)
+	return p.err
+}
+
+// AssetLink 表示响应式搜索广告的标题或描述
+type AssetLink struct {
+	Text        string `json:"text"`
+	PinnedField string `json:"pinnedField,omitempty"`
+}
+
+// ResponsiveSearchAd 表示响应式搜索广告行，Parent Id 为广告组 ID
+//
+// Headline 和 Description 列是 AssetLink 的 JSON 数组。
+type ResponsiveSearchAd struct {
+	RowBase
+	CampaignName string
+	AdGroupName  string
+	Headlines    []AssetLink
+	Descriptions []AssetLink
+	Path1        string
+	Path2        string
+	FinalUrl     string
+}
+
+// RecordType 实现 BulkRecord 接口
+func (ResponsiveSearchAd) RecordType() string { return RecordTypeResponsiveSearchAd }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (a ResponsiveSearchAd) MarshalBulkRow(row BulkRow) {
+	a.marshalBase(RecordTypeResponsiveSearchAd, row)
+	row.SetString(ColumnCampaign, a.CampaignName)
+	row.SetString(ColumnAdGroup, a.AdGroupName)
+	row.SetString(ColumnHeadline, encodeAssetLinks(a.Headlines))
+	row.SetString(ColumnDescription, encodeAssetLinks(a.Descriptions))
+	row.SetString(ColumnPath1, a.Path1)
+	row.SetString(ColumnPath2, a.Path2)
+	row.SetString(ColumnFinalUrl, a.FinalUrl)
+}
+
+func (a *ResponsiveSearchAd) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	a.unmarshalBase(p)
+	a.CampaignName = row.String(ColumnCampaign)
+	a.AdGroupName = row.String(ColumnAdGroup)
+	a.Path1 = row.String(ColumnPath1)
+	a.Path2 = row.String(ColumnPath2)
+	a.FinalUrl = row.String(ColumnFinalUrl)
+
+	var err error
+	if a.Headlines, err = decodeAssetLinks(row, ColumnHeadline); err != nil && p.err == nil {
+		p.err = err
+	}
+	if a.Descriptions, err = decodeAssetLinks(row, ColumnDescription); err != nil && p.err == nil {
+		p.err = err
+	}
+	return p.err
+}
+
+// BulkError 表示上传结果文件中的错误行
+type BulkError struct {
+	// 出错记录的类型，例如 Keyword
+	EntityType  string
+	Id          int64
+	ParentId    int64
+	Error       string
+	ErrorNumber int
+
+	// 错误所在的行号，由读取器设置
+	Line int
+}
+
+// RecordType 实现 BulkRecord 接口
+func (e BulkError) RecordType() string { return e.EntityType + errorRecordSuffix }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (e BulkError) MarshalBulkRow(row BulkRow) {
+	row[ColumnType] = e.RecordType()
+	row.SetInt64(ColumnId, e.Id)
+	row.SetInt64(ColumnParentId, e.ParentId)
+	row.SetString(ColumnError, e.Error)
+	row.SetInt64(ColumnErrorNumber, int64(e.ErrorNumber))
+}
+
+func (e *BulkError) unmarshalBulkRow(row BulkRow) error {
+	p := &rowParser{row: row}
+	e.EntityType = strings.TrimSuffix(row.String(ColumnType), errorRecordSuffix)
+	e.Id = p.int64(ColumnId)
+	e.ParentId = p.int64(ColumnParentId)
+	e.Error = row.String(ColumnError)
+	e.ErrorNumber = p.int(ColumnErrorNumber)
+	return p.err
+}
+
+// UnknownRecord 表示不支持的记录类型，保留原始的行
+type UnknownRecord struct {
+	Type string
+	Row  BulkRow
+}
+
+// RecordType 实现 BulkRecord 接口
+func (u UnknownRecord) RecordType() string { return u.Type }
+
+// MarshalBulkRow 实现 BulkRecord 接口
+func (u UnknownRecord) MarshalBulkRow(row BulkRow) {
+	for column, value := range u.Row {
+		row[column] = value
+	}
+	row[ColumnType] = u.Type
+}
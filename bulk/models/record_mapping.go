@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/vancevox/bingads-go/base"
+	cm "github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+// encodeAssetLinks 将标题或描述编码为 JSON 数组，空列表时返回空字符串
+func encodeAssetLinks(links []AssetLink) string {
+	if len(links) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(links)
+	return string(data)
+}
+
+// decodeAssetLinks 解析 JSON 数组格式的标题或描述
+func decodeAssetLinks(row BulkRow, column string) ([]AssetLink, error) {
+	value := row.String(column)
+	if value == "" {
+		return nil, nil
+	}
+	var links []AssetLink
+	if err := json.Unmarshal([]byte(value), &links); err != nil {
+		return nil, &BulkRowError{Column: column, Value: value, Err: err}
+	}
+	return links, nil
+}
+
+// ToNegativeKeywordList 转换为 Campaign Management 的否定关键词列表
+func (l NegativeKeywordList) ToNegativeKeywordList() cm.NegativeKeywordList {
+	list := cm.NegativeKeywordList{}
+	list.Name = l.Name
+	if l.Id != 0 {
+		list.Id = base.Int64(l.Id)
+	}
+	return list
+}
+
+// ToSharedListItem 转换为共享列表项
+func (k SharedNegativeKeyword) ToSharedListItem() cm.SharedListItem {
+	item := cm.SharedListItem{
+		Type:      cm.SharedListItemTypeNegativeKeyword,
+		MatchType: k.MatchType,
+		Text:      k.Text,
+	}
+	if k.Id != 0 {
+		item.ID = base.Int64(k.Id)
+	}
+	return item
+}
+
+// SharedNegativeKeywordFromListItem 根据共享列表项创建否定关键词行，listId 为所属列表的 ID
+func SharedNegativeKeywordFromListItem(listId int64, item cm.SharedListItem) SharedNegativeKeyword {
+	return SharedNegativeKeyword{
+		RowBase:   RowBase{Id: item.ID.Value(), ParentId: listId},
+		Text:      item.Text,
+		MatchType: item.MatchType,
+	}
+}
+
+// ToSharedEntityAssociation 转换为共享实体关联
+func (a CampaignNegativeKeywordListAssociation) ToSharedEntityAssociation() cm.SharedEntityAssociation {
+	return cm.SharedEntityAssociation{
+		EntityId:         a.ParentId,
+		EntityType:       cm.EntityTypeCampaign,
+		SharedEntityId:   a.Id,
+		SharedEntityType: cm.SharedEntityTypeNegativeKeywordList,
+	}
+}
+
+// CampaignNegativeKeywordListAssociationFrom 根据共享实体关联创建关联行
+func CampaignNegativeKeywordListAssociationFrom(association cm.SharedEntityAssociation) CampaignNegativeKeywordListAssociation {
+	return CampaignNegativeKeywordListAssociation{
+		RowBase: RowBase{Id: association.SharedEntityId, ParentId: association.EntityId},
+	}
+}
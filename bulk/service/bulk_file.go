@@ -0,0 +1,212 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vancevox/bingads-go/bulk/models"
+)
+
+// BulkFileError 表示批量文件中某一行无法解析
+type BulkFileError struct {
+	// 行号，从 1 开始，包含列名行
+	Line int
+
+	Err error
+}
+
+// Error 实现 error 接口
+func (e *BulkFileError) Error() string {
+	return fmt.Sprintf("批量文件第 %d 行无法解析: %v", e.Line, e.Err)
+}
+
+// Unwrap 返回底层错误
+func (e *BulkFileError) Unwrap() error {
+	return e.Err
+}
+
+// BulkFileReader 以流的方式逐行读取批量文件或上传结果文件
+//
+// 每一行按 Type 列解析为具体的记录类型，例如 *models.Keyword；上传结果中的错误行解析为
+// *models.BulkError，不支持的类型解析为 *models.UnknownRecord。
+type BulkFileReader struct {
+	csv     *csv.Reader
+	columns []string
+	line    int
+	record  models.BulkRecord
+	err     error
+	done    bool
+}
+
+// NewBulkFileReader 创建批量文件读取器并读取列名行，fileType 为空时按 CSV 处理
+func NewBulkFileReader(r io.Reader, fileType models.DownloadFileType) (*BulkFileReader, error) {
+	reader := csv.NewReader(skipBOM(r))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if fileType == models.DownloadFileTypeTsv {
+		reader.Comma = '\t'
+	}
+
+	columns, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("批量文件中没有列名")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i])
+	}
+
+	return &BulkFileReader{csv: reader, columns: columns, line: 1}, nil
+}
+
+// Columns 返回批量文件的列名
+func (r *BulkFileReader) Columns() []string {
+	return r.columns
+}
+
+// Next 读取下一条记录，没有更多数据或出错时返回 false
+func (r *BulkFileReader) Next() bool {
+	if r.done {
+		return false
+	}
+
+	values, err := r.csv.Read()
+	if err != nil {
+		r.done = true
+		if err != io.EOF {
+			r.err = err
+		}
+		return false
+	}
+	r.line, _ = r.csv.FieldPos(0)
+
+	row := make(models.BulkRow, len(r.columns))
+	for i, value := range values {
+		if i < len(r.columns) && value != "" {
+			row[r.columns[i]] = value
+		}
+	}
+
+	record, err := models.DecodeBulkRow(row)
+	if err != nil {
+		r.done = true
+		r.err = &BulkFileError{Line: r.line, Err: err}
+		return false
+	}
+	if bulkErr, ok := record.(*models.BulkError); ok {
+		bulkErr.Line = r.line
+	}
+	r.record = record
+	return true
+}
+
+// Record 返回当前记录
+func (r *BulkFileReader) Record() models.BulkRecord {
+	return r.record
+}
+
+// Line 返回当前记录所在的行号
+func (r *BulkFileReader) Line() int {
+	return r.line
+}
+
+// Err 返回读取过程中遇到的错误
+func (r *BulkFileReader) Err() error {
+	return r.err
+}
+
+// ReadBulkErrors 从上传结果文件中提取所有错误
+//
+// 结果文件中的错误既可能是单独的错误行（例如 "Keyword Error"），也可能是实体行上的 Error 列，
+// 两种情况都会转换为 models.BulkError。遇到无法解析的行时返回已经提取的错误和 *BulkFileError。
+func ReadBulkErrors(r io.Reader, fileType models.DownloadFileType) ([]models.BulkError, error) {
+	reader, err := NewBulkFileReader(r, fileType)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []models.BulkError
+	for reader.Next() {
+		switch record := reader.Record().(type) {
+		case *models.BulkError:
+			errs = append(errs, *record)
+		case interface{ BulkRowBase() models.RowBase }:
+			rowBase := record.BulkRowBase()
+			if !rowBase.HasError() {
+				continue
+			}
+			errs = append(errs, models.BulkError{
+				EntityType:  reader.Record().RecordType(),
+				Id:          rowBase.Id,
+				ParentId:    rowBase.ParentId,
+				Error:       rowBase.Error,
+				ErrorNumber: rowBase.ErrorNumber,
+				Line:        reader.Line(),
+			})
+		}
+	}
+	return errs, reader.Err()
+}
+
+// BulkFileWriter 以流的方式写入批量文件
+//
+// 第一条记录不是 Format Version 时会自动写入 models.DefaultFormatVersion。
+type BulkFileWriter struct {
+	csv     *csv.Writer
+	columns []string
+	started bool
+}
+
+// NewBulkFileWriter 创建批量文件写入器，使用 models.BulkColumns 作为列，fileType 为空时按 CSV 处理
+func NewBulkFileWriter(w io.Writer, fileType models.DownloadFileType) *BulkFileWriter {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	if fileType == models.DownloadFileTypeTsv {
+		writer.Comma = '\t'
+	}
+	return &BulkFileWriter{csv: writer, columns: models.BulkColumns}
+}
+
+// Write 写入一条记录
+func (w *BulkFileWriter) Write(record models.BulkRecord) error {
+	if !w.started {
+		w.started = true
+		if err := w.csv.Write(w.columns); err != nil {
+			return err
+		}
+		if record.RecordType() != models.RecordTypeFormatVersion {
+			if err := w.Write(models.FormatVersion{Version: models.DefaultFormatVersion}); err != nil {
+				return err
+			}
+		}
+	}
+
+	row := make(models.BulkRow, len(w.columns))
+	record.MarshalBulkRow(row)
+
+	values := make([]string, len(w.columns))
+	for i, column := range w.columns {
+		values[i] = row[column]
+	}
+	return w.csv.Write(values)
+}
+
+// Flush 将缓冲的数据写入底层 io.Writer
+func (w *BulkFileWriter) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// skipBOM 跳过 UTF-8 BOM
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && string(bom) == "\xef\xbb\xbf" {
+		br.Discard(3)
+	}
+	return br
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/bulk/models"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// BulkService 实现批量服务
+type BulkService struct {
+	client *Client
+}
+
+// NewBulkService 创建一个新的批量服务
+func NewBulkService(client *Client) *BulkService {
+	return &BulkService{
+		client: client,
+	}
+}
+
+// DownloadCampaignsByAccountIds 提交批量下载请求，返回下载请求 ID
+//
+// 未设置的 DataScope、DownloadFileType 和 FormatVersion 分别使用 EntityData、Csv 和 6.0。
+func (s *BulkService) DownloadCampaignsByAccountIds(request models.DownloadCampaignsByAccountIdsRequest) (string, error) {
+	if len(request.AccountIds) == 0 {
+		return "", base.NewError(base.ErrInvalidInput, "AccountIds 不能为空", nil)
+	}
+
+	// 补全默认值
+	request.Namespace = config.CampaignManagementNamespace
+	if request.DataScope == "" {
+		request.DataScope = models.DataScopeEntityData
+	}
+	if request.DownloadFileType == "" {
+		request.DownloadFileType = models.DownloadFileTypeCsv
+	}
+	if request.FormatVersion == "" {
+		request.FormatVersion = models.DefaultFormatVersion
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDownloadCampaignsByAccountIds, &models.BulkBody{
+		DownloadCampaignsByAccountIdsRequest: &request,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if body.DownloadCampaignsByAccountIdsResponse == nil {
+		return "", missingResponse(models.SOAPActionDownloadCampaignsByAccountIds)
+	}
+	return body.DownloadCampaignsByAccountIdsResponse.DownloadRequestId, nil
+}
+
+// GetBulkDownloadStatus 查询批量下载的状态
+func (s *BulkService) GetBulkDownloadStatus(requestId string) (*models.BulkStatus, error) {
+	// 创建请求
+	request := models.GetBulkDownloadStatusRequest{
+		Namespace: config.CampaignManagementNamespace,
+		RequestId: requestId,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBulkDownloadStatus, &models.BulkBody{
+		GetBulkDownloadStatusRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetBulkDownloadStatusResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetBulkDownloadStatus)
+	}
+	return &body.GetBulkDownloadStatusResponse.BulkStatus, nil
+}
+
+// WaitForDownload 按 interval 轮询直到批量下载完成、失败或 ctx 结束
+//
+// interval 为 0 时使用 DefaultPollInterval，为负数时返回 INVALID_INPUT。下载失败时返回 API_ERROR，同时返回最后一次查询到的状态。
+func (s *BulkService) WaitForDownload(ctx context.Context, requestId string, interval time.Duration) (*models.BulkStatus, error) {
+	return waitForStatus(ctx, interval, func() (*models.BulkStatus, error) {
+		return s.GetBulkDownloadStatus(requestId)
+	}, map[string]bool{
+		models.DownloadStatusCompleted:              true,
+		models.DownloadStatusFailed:                 false,
+		models.DownloadStatusFailedFullSyncRequired: false,
+	})
+}
+
+// GetBulkUploadUrl 获取批量上传的地址和请求 ID
+func (s *BulkService) GetBulkUploadUrl(accountId int64, responseMode models.ResponseMode) (*models.BulkUploadUrl, error) {
+	if responseMode == "" {
+		responseMode = models.ResponseModeErrorsOnly
+	}
+
+	// 创建请求
+	request := models.GetBulkUploadUrlRequest{
+		Namespace:    config.CampaignManagementNamespace,
+		ResponseMode: responseMode,
+		AccountId:    accountId,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBulkUploadUrl, &models.BulkBody{
+		GetBulkUploadUrlRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetBulkUploadUrlResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetBulkUploadUrl)
+	}
+	return &body.GetBulkUploadUrlResponse.BulkUploadUrl, nil
+}
+
+// UploadFile 将 CSV 内容压缩后上传到 GetBulkUploadUrl 返回的地址
+//
+// 压缩和上传以流的方式进行，csv 不会被整体读入内存。
+func (s *BulkService) UploadFile(ctx context.Context, uploadUrl string, fileName string, csv io.Reader) error {
+	name := strings.TrimSuffix(path.Base(fileName), path.Ext(fileName))
+	return s.upload(ctx, uploadUrl, name+".zip", func(w io.Writer) error {
+		zw := zip.NewWriter(w)
+		entry, err := zw.Create(name + ".csv")
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(entry, csv); err != nil {
+			return err
+		}
+		return zw.Close()
+	})
+}
+
+// UploadZipFile 上传已经压缩的批量文件
+func (s *BulkService) UploadZipFile(ctx context.Context, uploadUrl string, fileName string, zipped io.Reader) error {
+	return s.upload(ctx, uploadUrl, path.Base(fileName), func(w io.Writer) error {
+		_, err := io.Copy(w, zipped)
+		return err
+	})
+}
+
+// GetBulkUploadStatus 查询批量上传的状态
+func (s *BulkService) GetBulkUploadStatus(requestId string) (*models.BulkStatus, error) {
+	// 创建请求
+	request := models.GetBulkUploadStatusRequest{
+		Namespace: config.CampaignManagementNamespace,
+		RequestId: requestId,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBulkUploadStatus, &models.BulkBody{
+		GetBulkUploadStatusRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetBulkUploadStatusResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetBulkUploadStatus)
+	}
+	return &body.GetBulkUploadStatusResponse.BulkStatus, nil
+}
+
+// WaitForUpload 按 interval 轮询直到批量上传处理完成、失败或 ctx 结束
+//
+// interval 为 0 时使用 DefaultPollInterval，为负数时返回 INVALID_INPUT。CompletedWithErrors 视为完成，
+// 逐行错误需要从结果文件中读取；上传失败时返回 API_ERROR。
+func (s *BulkService) WaitForUpload(ctx context.Context, requestId string, interval time.Duration) (*models.BulkStatus, error) {
+	return waitForStatus(ctx, interval, func() (*models.BulkStatus, error) {
+		return s.GetBulkUploadStatus(requestId)
+	}, map[string]bool{
+		models.UploadStatusCompleted:                    true,
+		models.UploadStatusCompletedWithErrors:          true,
+		models.UploadStatusFailed:                       false,
+		models.UploadStatusExpired:                      false,
+		models.UploadStatusUploadFileRowCountExceeded:   false,
+		models.UploadStatusUploadFileFormatNotSupported: false,
+	})
+}
+
+// DownloadResultFile 下载并解压批量下载文件或上传结果文件，调用方负责关闭
+func (s *BulkService) DownloadResultFile(ctx context.Context, resultFileUrl string) (io.ReadCloser, error) {
+	if resultFileUrl == "" {
+		return nil, base.NewError(base.ErrInvalidInput, "结果文件地址为空", nil)
+	}
+	return common.DownloadZip(ctx, s.client.FileClient, resultFileUrl)
+}
+
+// upload 以 multipart/form-data 上传文件，write 负责写入文件内容
+func (s *BulkService) upload(ctx context.Context, uploadUrl string, fileName string, write func(io.Writer) error) error {
+	if uploadUrl == "" {
+		return base.NewError(base.ErrInvalidInput, "上传地址为空", nil)
+	}
+
+	// 通过管道边写边传，避免大文件整体读入内存
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	form := multipart.NewWriter(pw)
+	go func() {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="uploadFile"; filename="%s"`, fileName))
+		header.Set("Content-Type", "application/zip")
+
+		part, err := form.CreatePart(header)
+		if err == nil {
+			err = write(part)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadUrl, pr)
+	if err != nil {
+		return base.NewError(base.ErrInvalidInput, "创建上传请求失败", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	for name, value := range common.AuthHeaders(s.client.Config) {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.FileClient.Do(req)
+	if err != nil {
+		return base.NewError(base.ErrNetworkFail, "上传批量文件失败", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return base.NewError(base.ErrHTTPRequestFail, fmt.Sprintf("上传批量文件返回非 200 状态码: %d %s", resp.StatusCode, body), nil)
+	}
+	return nil
+}
+
+// DefaultPollInterval 为 WaitForDownload 和 WaitForUpload 的 interval 为 0 时使用的轮询间隔
+const DefaultPollInterval = 5 * time.Second
+
+// waitForStatus 按 interval 轮询直到状态出现在 final 中，final 的值表示该状态是否成功
+//
+// interval 为 0 时使用 DefaultPollInterval，为负数时返回 INVALID_INPUT。
+func waitForStatus(ctx context.Context, interval time.Duration, poll func() (*models.BulkStatus, error), final map[string]bool) (*models.BulkStatus, error) {
+	if interval < 0 {
+		return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("轮询间隔不能为负数: %v", interval), nil)
+	}
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := poll()
+		if err != nil {
+			return nil, err
+		}
+
+		if ok, done := final[status.RequestStatus]; done {
+			if ok {
+				return status, nil
+			}
+			message := fmt.Sprintf("批量操作失败: %s", status.RequestStatus)
+			if len(status.Errors) > 0 {
+				message += fmt.Sprintf(" [%s]: %s", status.Errors[0].ErrorCode, status.Errors[0].Message)
+			}
+			return status, base.NewError(base.ErrAPIError, message, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
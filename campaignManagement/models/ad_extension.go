@@ -0,0 +1,636 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// AdExtensionType 表示广告附加信息的类型，查询时使用该值筛选附加信息
+type AdExtensionType string
+
+const (
+	AdExtensionTypeSitelink          AdExtensionType = "SitelinkAdExtension"
+	AdExtensionTypeCallout           AdExtensionType = "CalloutAdExtension"
+	AdExtensionTypeStructuredSnippet AdExtensionType = "StructuredSnippetAdExtension"
+	AdExtensionTypeCall              AdExtensionType = "CallAdExtension"
+	AdExtensionTypeLocation          AdExtensionType = "LocationAdExtension"
+	AdExtensionTypePrice             AdExtensionType = "PriceAdExtension"
+	AdExtensionTypePromotion         AdExtensionType = "PromotionAdExtension"
+	AdExtensionTypeImage             AdExtensionType = "ImageAdExtension"
+	AdExtensionTypeAction            AdExtensionType = "ActionAdExtension"
+	AdExtensionTypeFilterLink        AdExtensionType = "FilterLinkAdExtension"
+)
+
+// AdExtensionTypes 是未指定类型时查询的附加信息类型
+var AdExtensionTypes = []AdExtensionType{
+	AdExtensionTypeSitelink,
+	AdExtensionTypeCallout,
+	AdExtensionTypeStructuredSnippet,
+	AdExtensionTypeCall,
+	AdExtensionTypeLocation,
+	AdExtensionTypePrice,
+	AdExtensionTypePromotion,
+	AdExtensionTypeImage,
+	AdExtensionTypeAction,
+	AdExtensionTypeFilterLink,
+}
+
+// AdExtensionStatus 表示附加信息的状态
+type AdExtensionStatus string
+
+const (
+	AdExtensionStatusActive  AdExtensionStatus = "Active"
+	AdExtensionStatusDeleted AdExtensionStatus = "Deleted"
+)
+
+// AssociationType 表示附加信息关联的实体层级
+type AssociationType string
+
+const (
+	AssociationTypeAccount  AssociationType = "Account"
+	AssociationTypeCampaign AssociationType = "Campaign"
+	AssociationTypeAdGroup  AssociationType = "AdGroup"
+)
+
+// AdExtensionEditorialStatus 表示附加信息关联的审核状态
+type AdExtensionEditorialStatus string
+
+const (
+	AdExtensionEditorialStatusActive        AdExtensionEditorialStatus = "Active"
+	AdExtensionEditorialStatusDisapproved   AdExtensionEditorialStatus = "Disapproved"
+	AdExtensionEditorialStatusInactive      AdExtensionEditorialStatus = "Inactive"
+	AdExtensionEditorialStatusActiveLimited AdExtensionEditorialStatus = "ActiveLimited"
+	AdExtensionEditorialStatusAppealPending AdExtensionEditorialStatus = "AppealPending"
+)
+
+// Address 表示位置附加信息的地址
+type Address struct {
+	CityName     base.Nillable[string] `xml:"CityName"`
+	CountryCode  base.Nillable[string] `xml:"CountryCode"`
+	Line1        base.Nillable[string] `xml:"Line1"`
+	Line2        base.Nillable[string] `xml:"Line2"`
+	Line3        base.Nillable[string] `xml:"Line3"`
+	Line4        base.Nillable[string] `xml:"Line4"`
+	PostalCode   base.Nillable[string] `xml:"PostalCode"`
+	ProvinceCode base.Nillable[string] `xml:"ProvinceCode"`
+	ProvinceName base.Nillable[string] `xml:"ProvinceName"`
+}
+
+// PriceTableRow 表示价格附加信息中的一行
+type PriceTableRow struct {
+	CurrencyCode          base.Nillable[string]  `xml:"CurrencyCode"`
+	Description           base.Nillable[string]  `xml:"Description"`
+	FinalMobileUrls       common.StringArray     `xml:"FinalMobileUrls"`
+	FinalUrls             common.StringArray     `xml:"FinalUrls"`
+	Header                base.Nillable[string]  `xml:"Header"`
+	Price                 base.Nillable[float64] `xml:"Price"`
+	PriceQualifier        base.Nillable[string]  `xml:"PriceQualifier"`
+	PriceUnit             base.Nillable[string]  `xml:"PriceUnit"`
+	TermsAndConditions    base.Nillable[string]  `xml:"TermsAndConditions"`
+	TermsAndConditionsUrl base.Nillable[string]  `xml:"TermsAndConditionsUrl"`
+}
+
+// AdExtension 表示一个具体类型的广告附加信息
+//
+// 该接口是封闭的，只能由本包中的 *AdExtension 类型和 UnknownAdExtension 实现。
+type AdExtension interface {
+	// AdExtensionType 返回附加信息的 i:type 类型
+	AdExtensionType() AdExtensionType
+
+	isAdExtension()
+}
+
+// AdExtensionBase 附加信息的公共字段，字段顺序与 WSDL 保持一致
+type AdExtensionBase struct {
+	// 设备偏好，为空表示所有设备，30001 表示仅移动设备
+	DevicePreference        base.Nillable[int64]             `xml:"DevicePreference"`
+	ForwardCompatibilityMap ForwardCompatibilityMap          `xml:"ForwardCompatibilityMap"`
+	Id                      base.Nillable[int64]             `xml:"Id"`
+	Scheduling              *Schedule                        `xml:"Scheduling,omitempty"`
+	Status                  base.Nillable[AdExtensionStatus] `xml:"Status"`
+	// Type 和 Version 由服务端返回，添加或更新时不需要设置
+	Type    base.Nillable[string] `xml:"Type"`
+	Version base.Nillable[int]    `xml:"Version"`
+}
+
+// SitelinkAdExtension 表示附加链接
+type SitelinkAdExtension struct {
+	AdExtensionBase
+	Description1        base.Nillable[string] `xml:"Description1"`
+	Description2        base.Nillable[string] `xml:"Description2"`
+	DestinationUrl      base.Nillable[string] `xml:"DestinationUrl"`
+	DisplayText         base.Nillable[string] `xml:"DisplayText"`
+	FinalMobileUrls     common.StringArray    `xml:"FinalMobileUrls"`
+	FinalUrlSuffix      base.Nillable[string] `xml:"FinalUrlSuffix"`
+	FinalUrls           common.StringArray    `xml:"FinalUrls"`
+	TrackingUrlTemplate base.Nillable[string] `xml:"TrackingUrlTemplate"`
+}
+
+// CalloutAdExtension 表示宣传信息
+type CalloutAdExtension struct {
+	AdExtensionBase
+	Text base.Nillable[string] `xml:"Text"`
+}
+
+// StructuredSnippetAdExtension 表示结构化摘要，Header 必须是预定义的标题之一
+type StructuredSnippetAdExtension struct {
+	AdExtensionBase
+	Header base.Nillable[string] `xml:"Header"`
+	Values common.StringArray    `xml:"Values"`
+}
+
+// CallAdExtension 表示电话附加信息
+type CallAdExtension struct {
+	AdExtensionBase
+	CountryCode                   base.Nillable[string] `xml:"CountryCode"`
+	IsCallOnly                    base.Nillable[bool]   `xml:"IsCallOnly"`
+	IsCallTrackingEnabled         base.Nillable[bool]   `xml:"IsCallTrackingEnabled"`
+	PhoneNumber                   base.Nillable[string] `xml:"PhoneNumber"`
+	RequireTollFreeTrackingNumber base.Nillable[bool]   `xml:"RequireTollFreeTrackingNumber"`
+}
+
+// LocationAdExtension 表示位置附加信息
+type LocationAdExtension struct {
+	AdExtensionBase
+	Address     *Address              `xml:"Address,omitempty"`
+	CompanyName base.Nillable[string] `xml:"CompanyName"`
+	// GeoCodeStatus 由服务端返回
+	GeoCodeStatus base.Nillable[string] `xml:"GeoCodeStatus"`
+	IconMediaId   base.Nillable[int64]  `xml:"IconMediaId"`
+	ImageMediaId  base.Nillable[int64]  `xml:"ImageMediaId"`
+	PhoneNumber   base.Nillable[string] `xml:"PhoneNumber"`
+}
+
+// PriceAdExtension 表示价格附加信息
+type PriceAdExtension struct {
+	AdExtensionBase
+	FinalMobileUrls     common.StringArray    `xml:"FinalMobileUrls"`
+	FinalUrlSuffix      base.Nillable[string] `xml:"FinalUrlSuffix"`
+	FinalUrls           common.StringArray    `xml:"FinalUrls"`
+	Language            base.Nillable[string] `xml:"Language"`
+	PriceExtensionType  base.Nillable[string] `xml:"PriceExtensionType"`
+	TableRows           []PriceTableRow       `xml:"TableRows>PriceTableRow,omitempty"`
+	TrackingUrlTemplate base.Nillable[string] `xml:"TrackingUrlTemplate"`
+}
+
+// PromotionAdExtension 表示促销附加信息，MoneyAmountOff 与 PercentOff 二选一
+type PromotionAdExtension struct {
+	AdExtensionBase
+	CurrencyCode        base.Nillable[string]  `xml:"CurrencyCode"`
+	DiscountModifier    base.Nillable[string]  `xml:"DiscountModifier"`
+	FinalMobileUrls     common.StringArray     `xml:"FinalMobileUrls"`
+	FinalUrlSuffix      base.Nillable[string]  `xml:"FinalUrlSuffix"`
+	FinalUrls           common.StringArray     `xml:"FinalUrls"`
+	Language            base.Nillable[string]  `xml:"Language"`
+	MoneyAmountOff      base.Nillable[float64] `xml:"MoneyAmountOff"`
+	OrdersOverAmount    base.Nillable[float64] `xml:"OrdersOverAmount"`
+	PercentOff          base.Nillable[float64] `xml:"PercentOff"`
+	PromotionCode       base.Nillable[string]  `xml:"PromotionCode"`
+	PromotionEndDate    *Date                  `xml:"PromotionEndDate,omitempty"`
+	PromotionItem       base.Nillable[string]  `xml:"PromotionItem"`
+	PromotionOccasion   base.Nillable[string]  `xml:"PromotionOccasion"`
+	PromotionStartDate  *Date                  `xml:"PromotionStartDate,omitempty"`
+	TrackingUrlTemplate base.Nillable[string]  `xml:"TrackingUrlTemplate"`
+}
+
+// ImageAdExtension 表示图片附加信息，ImageMediaIds 为 AddMedia 返回的媒体 ID
+type ImageAdExtension struct {
+	AdExtensionBase
+	AlternativeText     base.Nillable[string] `xml:"AlternativeText"`
+	Description         base.Nillable[string] `xml:"Description"`
+	DestinationUrl      base.Nillable[string] `xml:"DestinationUrl"`
+	FinalMobileUrls     common.StringArray    `xml:"FinalMobileUrls"`
+	FinalUrlSuffix      base.Nillable[string] `xml:"FinalUrlSuffix"`
+	FinalUrls           common.StringArray    `xml:"FinalUrls"`
+	ImageMediaIds       common.LongArray      `xml:"ImageMediaIds"`
+	TrackingUrlTemplate base.Nillable[string] `xml:"TrackingUrlTemplate"`
+}
+
+// ActionAdExtension 表示行动号召附加信息
+type ActionAdExtension struct {
+	AdExtensionBase
+	ActionType          base.Nillable[string] `xml:"ActionType"`
+	FinalMobileUrls     common.StringArray    `xml:"FinalMobileUrls"`
+	FinalUrlSuffix      base.Nillable[string] `xml:"FinalUrlSuffix"`
+	FinalUrls           common.StringArray    `xml:"FinalUrls"`
+	Language            base.Nillable[string] `xml:"Language"`
+	TrackingUrlTemplate base.Nillable[string] `xml:"TrackingUrlTemplate"`
+}
+
+// FilterLinkAdExtension 表示筛选链接附加信息
+type FilterLinkAdExtension struct {
+	AdExtensionBase
+	AdExtensionHeaderType base.Nillable[string] `xml:"AdExtensionHeaderType"`
+	FinalMobileUrls       common.StringArray    `xml:"FinalMobileUrls"`
+	FinalUrlSuffix        base.Nillable[string] `xml:"FinalUrlSuffix"`
+	FinalUrls             common.StringArray    `xml:"FinalUrls"`
+	Language              base.Nillable[string] `xml:"Language"`
+	Texts                 common.StringArray    `xml:"Texts"`
+	TrackingUrlTemplate   base.Nillable[string] `xml:"TrackingUrlTemplate"`
+}
+
+// UnknownAdExtension 保存无法识别类型的附加信息的原始 XML，用于向前兼容
+type UnknownAdExtension struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (SitelinkAdExtension) isAdExtension()          {}
+func (CalloutAdExtension) isAdExtension()           {}
+func (StructuredSnippetAdExtension) isAdExtension() {}
+func (CallAdExtension) isAdExtension()              {}
+func (LocationAdExtension) isAdExtension()          {}
+func (PriceAdExtension) isAdExtension()             {}
+func (PromotionAdExtension) isAdExtension()         {}
+func (ImageAdExtension) isAdExtension()             {}
+func (ActionAdExtension) isAdExtension()            {}
+func (FilterLinkAdExtension) isAdExtension()        {}
+func (UnknownAdExtension) isAdExtension()           {}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (SitelinkAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeSitelink
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (CalloutAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeCallout
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (StructuredSnippetAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeStructuredSnippet
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (CallAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeCall
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (LocationAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeLocation
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (PriceAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypePrice
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (PromotionAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypePromotion
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (ImageAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeImage
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (ActionAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeAction
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (FilterLinkAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionTypeFilterLink
+}
+
+// AdExtensionType 返回附加信息的 i:type 类型
+func (a UnknownAdExtension) AdExtensionType() AdExtensionType {
+	return AdExtensionType(a.TypeName)
+}
+
+// MarshalXML 自定义 SitelinkAdExtension 的 XML 序列化
+func (a SitelinkAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension SitelinkAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 CalloutAdExtension 的 XML 序列化
+func (a CalloutAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension CalloutAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 StructuredSnippetAdExtension 的 XML 序列化
+func (a StructuredSnippetAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension StructuredSnippetAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 CallAdExtension 的 XML 序列化
+func (a CallAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension CallAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 LocationAdExtension 的 XML 序列化
+func (a LocationAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension LocationAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 PriceAdExtension 的 XML 序列化
+func (a PriceAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension PriceAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 PromotionAdExtension 的 XML 序列化
+func (a PromotionAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension PromotionAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 ImageAdExtension 的 XML 序列化
+func (a ImageAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension ImageAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 ActionAdExtension 的 XML 序列化
+func (a ActionAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension ActionAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 FilterLinkAdExtension 的 XML 序列化
+func (a FilterLinkAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type extension FilterLinkAdExtension
+	return encodeAdExtension(e, start, a.AdExtensionType(), extension(a))
+}
+
+// MarshalXML 自定义 UnknownAdExtension 的 XML 序列化，原样输出保存的 XML
+func (a UnknownAdExtension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a.TypeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: a.TypeName})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := common.CopyRawXML(e, a.InnerXML); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeAdExtension 编码附加信息并加上 i:type 属性
+func encodeAdExtension(e *xml.Encoder, start xml.StartElement, typeName AdExtensionType, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: string(typeName)})
+	return e.EncodeElement(v, start)
+}
+
+// DecodeAdExtension 根据 i:type 属性将 AdExtension 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownAdExtension，元素为 i:nil 时返回 nil。
+func DecodeAdExtension(d *xml.Decoder, start xml.StartElement) (AdExtension, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); AdExtensionType(typeName) {
+	case AdExtensionTypeSitelink:
+		return decodeAdExtension[SitelinkAdExtension](d, start)
+	case AdExtensionTypeCallout:
+		return decodeAdExtension[CalloutAdExtension](d, start)
+	case AdExtensionTypeStructuredSnippet:
+		return decodeAdExtension[StructuredSnippetAdExtension](d, start)
+	case AdExtensionTypeCall:
+		return decodeAdExtension[CallAdExtension](d, start)
+	case AdExtensionTypeLocation:
+		return decodeAdExtension[LocationAdExtension](d, start)
+	case AdExtensionTypePrice:
+		return decodeAdExtension[PriceAdExtension](d, start)
+	case AdExtensionTypePromotion:
+		return decodeAdExtension[PromotionAdExtension](d, start)
+	case AdExtensionTypeImage:
+		return decodeAdExtension[ImageAdExtension](d, start)
+	case AdExtensionTypeAction:
+		return decodeAdExtension[ActionAdExtension](d, start)
+	case AdExtensionTypeFilterLink:
+		return decodeAdExtension[FilterLinkAdExtension](d, start)
+	default:
+		unknown, err := decodeAdExtension[UnknownAdExtension](d, start)
+		if err != nil {
+			return nil, err
+		}
+		extension := unknown.(UnknownAdExtension)
+		extension.TypeName = typeName
+		return extension, nil
+	}
+}
+
+// decodeAdExtension 将元素解码为指定类型的附加信息
+func decodeAdExtension[T AdExtension](d *xml.Decoder, start xml.StartElement) (AdExtension, error) {
+	var extension T
+	if err := d.DecodeElement(&extension, &start); err != nil {
+		return nil, err
+	}
+	return extension, nil
+}
+
+// adExtensionElement 用于在结构体字段中解码多态的 AdExtension
+type adExtensionElement struct {
+	extension AdExtension
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *adExtensionElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	extension, err := DecodeAdExtension(d, start)
+	if err != nil {
+		return err
+	}
+	el.extension = extension
+	return nil
+}
+
+// AdExtensionIdentity 表示附加信息的 ID 和版本
+type AdExtensionIdentity struct {
+	Id      int64 `xml:"Id"`
+	Version int   `xml:"Version"`
+}
+
+// BatchErrorCollection 表示一个实体的多个错误，BatchErrors 为实体内部各字段的错误
+type BatchErrorCollection struct {
+	BatchErrors []BatchError          `xml:"BatchErrors>BatchError,omitempty"`
+	Code        int                   `xml:"Code"`
+	Details     base.Nillable[string] `xml:"Details"`
+	ErrorCode   string                `xml:"ErrorCode,omitempty"`
+	FieldPath   base.Nillable[string] `xml:"FieldPath"`
+	Index       int                   `xml:"Index"`
+	Message     string                `xml:"Message,omitempty"`
+	Type        string                `xml:"Type,omitempty"`
+}
+
+// AdExtensionIdToEntityIdAssociation 表示附加信息与账户、活动或广告组的关联
+type AdExtensionIdToEntityIdAssociation struct {
+	AdExtensionId int64 `xml:"AdExtensionId"`
+	EntityId      int64 `xml:"EntityId"`
+}
+
+// AdExtensionAssociation 表示实体关联的附加信息
+type AdExtensionAssociation struct {
+	AdExtension     AdExtension                `xml:"AdExtension"`
+	AssociationType AssociationType            `xml:"AssociationType"`
+	EditorialStatus AdExtensionEditorialStatus `xml:"EditorialStatus"`
+	EntityId        int64                      `xml:"EntityId"`
+}
+
+// UnmarshalXML 自定义 AdExtensionAssociation 的 XML 反序列化，按 i:type 解码 AdExtension
+func (a *AdExtensionAssociation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type association AdExtensionAssociation
+	var v struct {
+		association
+		AdExtension adExtensionElement `xml:"AdExtension"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*a = AdExtensionAssociation(v.association)
+	a.AdExtension = v.AdExtension.extension
+	return nil
+}
+
+// AdExtensionAssociationCollection 表示一个实体关联的所有附加信息
+type AdExtensionAssociationCollection struct {
+	AdExtensionAssociations []AdExtensionAssociation `xml:"AdExtensionAssociations>AdExtensionAssociation,omitempty"`
+}
+
+// AddAdExtensionsRequest 请求结构体
+type AddAdExtensionsRequest struct {
+	XMLName      xml.Name      `xml:"AddAdExtensionsRequest"`
+	Namespace    string        `xml:"xmlns,attr"`
+	AccountId    int64         `xml:"AccountId"`
+	AdExtensions []AdExtension `xml:"AdExtensions>AdExtension"`
+}
+
+// AddAdExtensionsResponse 响应结构体
+type AddAdExtensionsResponse struct {
+	XMLName               xml.Name               `xml:"AddAdExtensionsResponse"`
+	Namespace             string                 `xml:"xmlns,attr"`
+	AdExtensionIdentities []AdExtensionIdentity  `xml:"AdExtensionIdentities>AdExtensionIdentity,omitempty"`
+	NestedPartialErrors   []BatchErrorCollection `xml:"NestedPartialErrors>BatchErrorCollection,omitempty"`
+}
+
+// GetAdExtensionsByIdsRequest 请求结构体
+type GetAdExtensionsByIdsRequest struct {
+	XMLName        xml.Name         `xml:"GetAdExtensionsByIdsRequest"`
+	Namespace      string           `xml:"xmlns,attr"`
+	AccountId      int64            `xml:"AccountId"`
+	AdExtensionIds common.LongArray `xml:"AdExtensionIds"`
+	// 以空格分隔的附加信息类型
+	AdExtensionType string `xml:"AdExtensionType"`
+}
+
+// GetAdExtensionsByIdsResponse 响应结构体
+type GetAdExtensionsByIdsResponse struct {
+	XMLName       xml.Name      `xml:"GetAdExtensionsByIdsResponse"`
+	Namespace     string        `xml:"xmlns,attr"`
+	AdExtensions  []AdExtension `xml:"-"`
+	PartialErrors []BatchError  `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UnmarshalXML 自定义 GetAdExtensionsByIdsResponse 的 XML 反序列化，按 i:type 解码附加信息
+func (r *GetAdExtensionsByIdsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		AdExtensions  []adExtensionElement `xml:"AdExtensions>AdExtension"`
+		PartialErrors []BatchError         `xml:"PartialErrors>BatchError"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	r.XMLName = start.Name
+	r.AdExtensions = make([]AdExtension, len(v.AdExtensions))
+	for i, el := range v.AdExtensions {
+		r.AdExtensions[i] = el.extension
+	}
+	r.PartialErrors = v.PartialErrors
+	return nil
+}
+
+// GetAdExtensionIdsByAccountIdRequest 请求结构体
+type GetAdExtensionIdsByAccountIdRequest struct {
+	XMLName   xml.Name `xml:"GetAdExtensionIdsByAccountIdRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	AccountId int64    `xml:"AccountId"`
+	// 以空格分隔的附加信息类型
+	AdExtensionType string `xml:"AdExtensionType"`
+	// 为空时返回所有附加信息，否则只返回已关联到该层级的附加信息
+	AssociationType AssociationType `xml:"AssociationType,omitempty"`
+}
+
+// GetAdExtensionIdsByAccountIdResponse 响应结构体
+type GetAdExtensionIdsByAccountIdResponse struct {
+	XMLName        xml.Name `xml:"GetAdExtensionIdsByAccountIdResponse"`
+	Namespace      string   `xml:"xmlns,attr"`
+	AdExtensionIds []int64  `xml:"AdExtensionIds>long,omitempty"`
+}
+
+// UpdateAdExtensionsRequest 请求结构体
+type UpdateAdExtensionsRequest struct {
+	XMLName      xml.Name      `xml:"UpdateAdExtensionsRequest"`
+	Namespace    string        `xml:"xmlns,attr"`
+	AccountId    int64         `xml:"AccountId"`
+	AdExtensions []AdExtension `xml:"AdExtensions>AdExtension"`
+}
+
+// UpdateAdExtensionsResponse 响应结构体
+type UpdateAdExtensionsResponse struct {
+	XMLName             xml.Name               `xml:"UpdateAdExtensionsResponse"`
+	Namespace           string                 `xml:"xmlns,attr"`
+	NestedPartialErrors []BatchErrorCollection `xml:"NestedPartialErrors>BatchErrorCollection,omitempty"`
+}
+
+// DeleteAdExtensionsRequest 请求结构体
+type DeleteAdExtensionsRequest struct {
+	XMLName        xml.Name         `xml:"DeleteAdExtensionsRequest"`
+	Namespace      string           `xml:"xmlns,attr"`
+	AccountId      int64            `xml:"AccountId"`
+	AdExtensionIds common.LongArray `xml:"AdExtensionIds"`
+}
+
+// DeleteAdExtensionsResponse 响应结构体
+type DeleteAdExtensionsResponse struct {
+	XMLName       xml.Name     `xml:"DeleteAdExtensionsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// SetAdExtensionsAssociationsRequest 请求结构体
+type SetAdExtensionsAssociationsRequest struct {
+	XMLName                             xml.Name                             `xml:"SetAdExtensionsAssociationsRequest"`
+	Namespace                           string                               `xml:"xmlns,attr"`
+	AccountId                           int64                                `xml:"AccountId"`
+	AdExtensionIdToEntityIdAssociations []AdExtensionIdToEntityIdAssociation `xml:"AdExtensionIdToEntityIdAssociations>AdExtensionIdToEntityIdAssociation"`
+	AssociationType                     AssociationType                      `xml:"AssociationType"`
+}
+
+// SetAdExtensionsAssociationsResponse 响应结构体
+type SetAdExtensionsAssociationsResponse struct {
+	XMLName       xml.Name     `xml:"SetAdExtensionsAssociationsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetAdExtensionsAssociationsRequest 请求结构体
+type GetAdExtensionsAssociationsRequest struct {
+	XMLName   xml.Name `xml:"GetAdExtensionsAssociationsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	AccountId int64    `xml:"AccountId"`
+	// 以空格分隔的附加信息类型
+	AdExtensionType string           `xml:"AdExtensionType"`
+	AssociationType AssociationType  `xml:"AssociationType"`
+	EntityIds       common.LongArray `xml:"EntityIds"`
+}
+
+// GetAdExtensionsAssociationsResponse 响应结构体
+type GetAdExtensionsAssociationsResponse struct {
+	XMLName                          xml.Name                           `xml:"GetAdExtensionsAssociationsResponse"`
+	Namespace                        string                             `xml:"xmlns,attr"`
+	AdExtensionAssociationCollection []AdExtensionAssociationCollection `xml:"AdExtensionAssociationCollection>AdExtensionAssociationCollection,omitempty"`
+	PartialErrors                    []BatchError                       `xml:"PartialErrors>BatchError,omitempty"`
+}
@@ -0,0 +1,456 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// AdGroupCriterionType 表示查询广告组条件时使用的条件类型
+type AdGroupCriterionType string
+
+const (
+	AdGroupCriterionTypeProductPartition AdGroupCriterionType = "ProductPartition"
+)
+
+// AdGroupCriterionStatus 表示广告组条件的状态
+type AdGroupCriterionStatus string
+
+const (
+	AdGroupCriterionStatusActive  AdGroupCriterionStatus = "Active"
+	AdGroupCriterionStatusPaused  AdGroupCriterionStatus = "Paused"
+	AdGroupCriterionStatusDeleted AdGroupCriterionStatus = "Deleted"
+)
+
+// ItemAction 表示 ApplyProductPartitionActions 中的操作类型
+type ItemAction string
+
+const (
+	ItemActionAdd    ItemAction = "Add"
+	ItemActionUpdate ItemAction = "Update"
+	ItemActionDelete ItemAction = "Delete"
+)
+
+// ProductPartitionType 表示产品分组节点的类型
+type ProductPartitionType string
+
+const (
+	// ProductPartitionTypeSubdivision 表示继续细分的节点，不能设置出价
+	ProductPartitionTypeSubdivision ProductPartitionType = "Subdivision"
+	// ProductPartitionTypeUnit 表示叶子节点，可以设置出价或被排除
+	ProductPartitionTypeUnit ProductPartitionType = "Unit"
+)
+
+// ProductConditionOperandAll 是根节点条件使用的 Operand
+const ProductConditionOperandAll = "All"
+
+// ProductCondition 表示产品分组的条件，Attribute 为空表示"其他所有"
+type ProductCondition struct {
+	Attribute string `xml:"Attribute,omitempty"`
+	Operand   string `xml:"Operand"`
+	Operator  string `xml:"Operator,omitempty"`
+}
+
+// Criterion 表示一个具体类型的条件
+//
+// 该接口是封闭的，只能由本包中的 ProductPartition 和 UnknownCriterion 实现。
+type Criterion interface {
+	// CriterionType 返回条件的 i:type 类型
+	CriterionType() string
+
+	isCriterion()
+}
+
+// ProductPartition 表示购物活动中产品分组树的一个节点
+type ProductPartition struct {
+	Condition ProductCondition `xml:"Condition"`
+	// 根节点的父节点 ID 为空，同一批操作中新增的父节点使用负数临时 ID
+	ParentCriterionId base.Nillable[int64] `xml:"ParentCriterionId"`
+	PartitionType     ProductPartitionType `xml:"PartitionType"`
+}
+
+// UnknownCriterion 保存无法识别类型的条件的原始 XML，用于向前兼容
+type UnknownCriterion struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (ProductPartition) isCriterion() {}
+func (UnknownCriterion) isCriterion() {}
+
+// CriterionType 返回条件的 i:type 类型
+func (ProductPartition) CriterionType() string {
+	return "ProductPartition"
+}
+
+// CriterionType 返回条件的 i:type 类型
+func (c UnknownCriterion) CriterionType() string {
+	return c.TypeName
+}
+
+// MarshalXML 自定义 ProductPartition 的 XML 序列化
+func (c ProductPartition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type criterion ProductPartition
+	return encodeTyped(e, start, c.CriterionType(), criterion(c))
+}
+
+// MarshalXML 自定义 UnknownCriterion 的 XML 序列化，原样输出保存的 XML
+func (c UnknownCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeRaw(e, start, c.TypeName, c.InnerXML)
+}
+
+// DecodeCriterion 根据 i:type 属性将 Criterion 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownCriterion，元素为 i:nil 时返回 nil。
+func DecodeCriterion(d *xml.Decoder, start xml.StartElement) (Criterion, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); typeName {
+	case "ProductPartition":
+		var c ProductPartition
+		if err := d.DecodeElement(&c, &start); err != nil {
+			return nil, err
+		}
+		return c, nil
+	default:
+		c := UnknownCriterion{TypeName: typeName}
+		if err := d.DecodeElement(&c, &start); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+}
+
+// criterionElement 用于在结构体字段中解码多态的 Criterion
+type criterionElement struct {
+	criterion Criterion
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *criterionElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	criterion, err := DecodeCriterion(d, start)
+	if err != nil {
+		return err
+	}
+	el.criterion = criterion
+	return nil
+}
+
+// CriterionBid 表示一个具体类型的条件出价
+//
+// 该接口是封闭的，只能由本包中的 FixedBid 和 UnknownCriterionBid 实现。
+type CriterionBid interface {
+	// CriterionBidType 返回出价的 i:type 类型
+	CriterionBidType() string
+
+	isCriterionBid()
+}
+
+// FixedBid 表示固定出价
+type FixedBid struct {
+	Amount float64 `xml:"Amount"`
+}
+
+// UnknownCriterionBid 保存无法识别类型的出价的原始 XML，用于向前兼容
+type UnknownCriterionBid struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (FixedBid) isCriterionBid()            {}
+func (UnknownCriterionBid) isCriterionBid() {}
+
+// CriterionBidType 返回出价的 i:type 类型
+func (FixedBid) CriterionBidType() string {
+	return "FixedBid"
+}
+
+// CriterionBidType 返回出价的 i:type 类型
+func (b UnknownCriterionBid) CriterionBidType() string {
+	return b.TypeName
+}
+
+// MarshalXML 自定义 FixedBid 的 XML 序列化
+func (b FixedBid) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type bid FixedBid
+	return encodeTyped(e, start, b.CriterionBidType(), bid(b))
+}
+
+// MarshalXML 自定义 UnknownCriterionBid 的 XML 序列化，原样输出保存的 XML
+func (b UnknownCriterionBid) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeRaw(e, start, b.TypeName, b.InnerXML)
+}
+
+// DecodeCriterionBid 根据 i:type 属性将 CriterionBid 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownCriterionBid，元素为 i:nil 时返回 nil。
+func DecodeCriterionBid(d *xml.Decoder, start xml.StartElement) (CriterionBid, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); typeName {
+	case "FixedBid":
+		var b FixedBid
+		if err := d.DecodeElement(&b, &start); err != nil {
+			return nil, err
+		}
+		return b, nil
+	default:
+		b := UnknownCriterionBid{TypeName: typeName}
+		if err := d.DecodeElement(&b, &start); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+}
+
+// criterionBidElement 用于在结构体字段中解码多态的 CriterionBid
+type criterionBidElement struct {
+	bid CriterionBid
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *criterionBidElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	bid, err := DecodeCriterionBid(d, start)
+	if err != nil {
+		return err
+	}
+	el.bid = bid
+	return nil
+}
+
+// AdGroupCriterion 表示一个具体类型的广告组条件
+//
+// 该接口是封闭的，只能由本包中的 BiddableAdGroupCriterion、NegativeAdGroupCriterion
+// 和 UnknownAdGroupCriterion 实现。
+type AdGroupCriterion interface {
+	// AdGroupCriterionType 返回广告组条件的 i:type 类型
+	AdGroupCriterionType() string
+
+	isAdGroupCriterion()
+}
+
+// AdGroupCriterionBase 广告组条件的公共字段，字段顺序与 WSDL 保持一致
+type AdGroupCriterionBase struct {
+	AdGroupId int64 `xml:"AdGroupId"`
+	// 更新出价时可以不设置
+	Criterion               Criterion               `xml:"Criterion,omitempty"`
+	ForwardCompatibilityMap ForwardCompatibilityMap `xml:"ForwardCompatibilityMap"`
+	Id                      base.Nillable[int64]    `xml:"Id"`
+	Status                  AdGroupCriterionStatus  `xml:"Status,omitempty"`
+}
+
+// BiddableAdGroupCriterion 表示可出价的广告组条件，细分节点的 CriterionBid 为空
+type BiddableAdGroupCriterion struct {
+	AdGroupCriterionBase
+	CriterionBid        CriterionBid          `xml:"CriterionBid,omitempty"`
+	DestinationUrl      base.Nillable[string] `xml:"DestinationUrl"`
+	EditorialStatus     string                `xml:"EditorialStatus,omitempty"`
+	FinalUrlSuffix      base.Nillable[string] `xml:"FinalUrlSuffix"`
+	FinalUrls           common.StringArray    `xml:"FinalUrls"`
+	TrackingUrlTemplate base.Nillable[string] `xml:"TrackingUrlTemplate"`
+}
+
+// NegativeAdGroupCriterion 表示排除的广告组条件，在产品分组树中表示被排除的叶子节点
+type NegativeAdGroupCriterion struct {
+	AdGroupCriterionBase
+}
+
+// UnknownAdGroupCriterion 保存无法识别类型的广告组条件的原始 XML，用于向前兼容
+type UnknownAdGroupCriterion struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (BiddableAdGroupCriterion) isAdGroupCriterion() {}
+func (NegativeAdGroupCriterion) isAdGroupCriterion() {}
+func (UnknownAdGroupCriterion) isAdGroupCriterion()  {}
+
+// AdGroupCriterionType 返回广告组条件的 i:type 类型
+func (BiddableAdGroupCriterion) AdGroupCriterionType() string {
+	return "BiddableAdGroupCriterion"
+}
+
+// AdGroupCriterionType 返回广告组条件的 i:type 类型
+func (NegativeAdGroupCriterion) AdGroupCriterionType() string {
+	return "NegativeAdGroupCriterion"
+}
+
+// AdGroupCriterionType 返回广告组条件的 i:type 类型
+func (c UnknownAdGroupCriterion) AdGroupCriterionType() string {
+	return c.TypeName
+}
+
+// MarshalXML 自定义 BiddableAdGroupCriterion 的 XML 序列化
+func (c BiddableAdGroupCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type criterion BiddableAdGroupCriterion
+	return encodeTyped(e, start, c.AdGroupCriterionType(), criterion(c))
+}
+
+// MarshalXML 自定义 NegativeAdGroupCriterion 的 XML 序列化
+func (c NegativeAdGroupCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type criterion NegativeAdGroupCriterion
+	return encodeTyped(e, start, c.AdGroupCriterionType(), criterion(c))
+}
+
+// MarshalXML 自定义 UnknownAdGroupCriterion 的 XML 序列化，原样输出保存的 XML
+func (c UnknownAdGroupCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeRaw(e, start, c.TypeName, c.InnerXML)
+}
+
+// UnmarshalXML 自定义 BiddableAdGroupCriterion 的 XML 反序列化，按 i:type 解码条件和出价
+func (c *BiddableAdGroupCriterion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type criterion BiddableAdGroupCriterion
+	var v struct {
+		criterion
+		Criterion    criterionElement    `xml:"Criterion"`
+		CriterionBid criterionBidElement `xml:"CriterionBid"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*c = BiddableAdGroupCriterion(v.criterion)
+	c.Criterion = v.Criterion.criterion
+	c.CriterionBid = v.CriterionBid.bid
+	return nil
+}
+
+// UnmarshalXML 自定义 NegativeAdGroupCriterion 的 XML 反序列化，按 i:type 解码条件
+func (c *NegativeAdGroupCriterion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type criterion NegativeAdGroupCriterion
+	var v struct {
+		criterion
+		Criterion criterionElement `xml:"Criterion"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*c = NegativeAdGroupCriterion(v.criterion)
+	c.Criterion = v.Criterion.criterion
+	return nil
+}
+
+// DecodeAdGroupCriterion 根据 i:type 属性将 AdGroupCriterion 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownAdGroupCriterion，元素为 i:nil 时返回 nil。
+func DecodeAdGroupCriterion(d *xml.Decoder, start xml.StartElement) (AdGroupCriterion, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); typeName {
+	case "BiddableAdGroupCriterion":
+		var c BiddableAdGroupCriterion
+		if err := d.DecodeElement(&c, &start); err != nil {
+			return nil, err
+		}
+		return c, nil
+	case "NegativeAdGroupCriterion":
+		var c NegativeAdGroupCriterion
+		if err := d.DecodeElement(&c, &start); err != nil {
+			return nil, err
+		}
+		return c, nil
+	default:
+		c := UnknownAdGroupCriterion{TypeName: typeName}
+		if err := d.DecodeElement(&c, &start); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+}
+
+// adGroupCriterionElement 用于在结构体字段中解码多态的 AdGroupCriterion
+type adGroupCriterionElement struct {
+	criterion AdGroupCriterion
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *adGroupCriterionElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	criterion, err := DecodeAdGroupCriterion(d, start)
+	if err != nil {
+		return err
+	}
+	el.criterion = criterion
+	return nil
+}
+
+// encodeTyped 编码实体并加上 i:type 属性
+func encodeTyped(e *xml.Encoder, start xml.StartElement, typeName string, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: typeName})
+	return e.EncodeElement(v, start)
+}
+
+// encodeRaw 原样输出保存的 XML，typeName 不为空时加上 i:type 属性
+func encodeRaw(e *xml.Encoder, start xml.StartElement, typeName, innerXML string) error {
+	if typeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: typeName})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := common.CopyRawXML(e, innerXML); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// AdGroupCriterionAction 表示 ApplyProductPartitionActions 中的一个操作
+type AdGroupCriterionAction struct {
+	Action           ItemAction       `xml:"Action"`
+	AdGroupCriterion AdGroupCriterion `xml:"AdGroupCriterion"`
+}
+
+// GetAdGroupCriterionsByIdsRequest 请求结构体
+type GetAdGroupCriterionsByIdsRequest struct {
+	XMLName             xml.Name             `xml:"GetAdGroupCriterionsByIdsRequest"`
+	Namespace           string               `xml:"xmlns,attr"`
+	AdGroupCriterionIds common.LongArray     `xml:"AdGroupCriterionIds"`
+	AdGroupId           int64                `xml:"AdGroupId"`
+	CriterionType       AdGroupCriterionType `xml:"CriterionType"`
+}
+
+// GetAdGroupCriterionsByIdsResponse 响应结构体
+type GetAdGroupCriterionsByIdsResponse struct {
+	XMLName           xml.Name           `xml:"GetAdGroupCriterionsByIdsResponse"`
+	Namespace         string             `xml:"xmlns,attr"`
+	AdGroupCriterions []AdGroupCriterion `xml:"-"`
+	PartialErrors     []BatchError       `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UnmarshalXML 自定义 GetAdGroupCriterionsByIdsResponse 的 XML 反序列化，按 i:type 解码广告组条件
+func (r *GetAdGroupCriterionsByIdsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		AdGroupCriterions []adGroupCriterionElement `xml:"AdGroupCriterions>AdGroupCriterion"`
+		PartialErrors     []BatchError              `xml:"PartialErrors>BatchError"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	r.XMLName = start.Name
+	r.AdGroupCriterions = make([]AdGroupCriterion, len(v.AdGroupCriterions))
+	for i, el := range v.AdGroupCriterions {
+		r.AdGroupCriterions[i] = el.criterion
+	}
+	r.PartialErrors = v.PartialErrors
+	return nil
+}
+
+// ApplyProductPartitionActionsRequest 请求结构体
+type ApplyProductPartitionActionsRequest struct {
+	XMLName          xml.Name                 `xml:"ApplyProductPartitionActionsRequest"`
+	Namespace        string                   `xml:"xmlns,attr"`
+	CriterionActions []AdGroupCriterionAction `xml:"CriterionActions>AdGroupCriterionAction"`
+}
+
+// ApplyProductPartitionActionsResponse 响应结构体
+type ApplyProductPartitionActionsResponse struct {
+	XMLName             xml.Name     `xml:"ApplyProductPartitionActionsResponse"`
+	Namespace           string       `xml:"xmlns,attr"`
+	AdGroupCriterionIds []int64      `xml:"AdGroupCriterionIds>long,omitempty"`
+	PartialErrors       []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
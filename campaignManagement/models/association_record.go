@@ -0,0 +1,32 @@
+package models
+
+// AssociationRecord 是共享实体关联便于序列化的扁平表示
+type AssociationRecord struct {
+	EntityId               int64            `json:"entity_id"`
+	EntityType             EntityType       `json:"entity_type"`
+	SharedEntityCustomerId string           `json:"shared_entity_customer_id,omitempty"`
+	SharedEntityId         int64            `json:"shared_entity_id"`
+	SharedEntityType       SharedEntityType `json:"shared_entity_type"`
+}
+
+// NewAssociationRecord 将 SharedEntityAssociation 转换为 AssociationRecord
+func NewAssociationRecord(association SharedEntityAssociation) AssociationRecord {
+	return AssociationRecord{
+		EntityId:               association.EntityId,
+		EntityType:             association.EntityType,
+		SharedEntityCustomerId: association.SharedEntityCustomerId,
+		SharedEntityId:         association.SharedEntityId,
+		SharedEntityType:       association.SharedEntityType,
+	}
+}
+
+// SharedEntityAssociation 将 AssociationRecord 转换为 SharedEntityAssociation
+func (r AssociationRecord) SharedEntityAssociation() SharedEntityAssociation {
+	return SharedEntityAssociation{
+		EntityId:               r.EntityId,
+		EntityType:             r.EntityType,
+		SharedEntityCustomerId: r.SharedEntityCustomerId,
+		SharedEntityId:         r.SharedEntityId,
+		SharedEntityType:       r.SharedEntityType,
+	}
+}
@@ -0,0 +1,491 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"strings"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// AudienceType 表示受众的类型，GetAudiencesByIds 使用该值筛选受众
+type AudienceType string
+
+const (
+	AudienceTypeRemarketingList        AudienceType = "RemarketingList"
+	AudienceTypeCustom                 AudienceType = "Custom"
+	AudienceTypeInMarket               AudienceType = "InMarket"
+	AudienceTypeProduct                AudienceType = "Product"
+	AudienceTypeSimilarRemarketingList AudienceType = "SimilarRemarketingList"
+	AudienceTypeCombinedList           AudienceType = "CombinedList"
+	AudienceTypeCustomerList           AudienceType = "CustomerList"
+)
+
+// AudienceTypes 是 GetAudiencesByIds 未指定类型时查询的受众类型
+var AudienceTypes = []AudienceType{
+	AudienceTypeRemarketingList,
+	AudienceTypeCustom,
+	AudienceTypeInMarket,
+	AudienceTypeProduct,
+	AudienceTypeSimilarRemarketingList,
+	AudienceTypeCombinedList,
+	AudienceTypeCustomerList,
+}
+
+// AudienceXSIType 表示受众的 i:type 类型
+type AudienceXSIType string
+
+const (
+	AudienceXSITypeRemarketingList        AudienceXSIType = "RemarketingList"
+	AudienceXSITypeCustomAudience         AudienceXSIType = "CustomAudience"
+	AudienceXSITypeInMarketAudience       AudienceXSIType = "InMarketAudience"
+	AudienceXSITypeSimilarRemarketingList AudienceXSIType = "SimilarRemarketingList"
+	AudienceXSITypeCombinedList           AudienceXSIType = "CombinedList"
+	AudienceXSITypeCustomerList           AudienceXSIType = "CustomerList"
+	AudienceXSITypeProductAudience        AudienceXSIType = "ProductAudience"
+)
+
+// ProductAudienceType 表示产品受众的类型
+type ProductAudienceType string
+
+const (
+	ProductAudienceTypeGeneralVisitors        ProductAudienceType = "GeneralVisitors"
+	ProductAudienceTypeProductSearchers       ProductAudienceType = "ProductSearchers"
+	ProductAudienceTypeProductViewers         ProductAudienceType = "ProductViewers"
+	ProductAudienceTypeShoppingCartAbandoners ProductAudienceType = "ShoppingCartAbandoners"
+	ProductAudienceTypePastBuyers             ProductAudienceType = "PastBuyers"
+)
+
+// LogicalOperator 表示组合列表规则中受众之间的关系
+type LogicalOperator string
+
+const (
+	LogicalOperatorAnd LogicalOperator = "And"
+	LogicalOperatorOr  LogicalOperator = "Or"
+	LogicalOperatorNot LogicalOperator = "Not"
+)
+
+// CustomerListActionType 表示 ApplyCustomerListItems 对客户列表的操作
+type CustomerListActionType string
+
+const (
+	CustomerListActionTypeAdd     CustomerListActionType = "Add"
+	CustomerListActionTypeRemove  CustomerListActionType = "Remove"
+	CustomerListActionTypeReplace CustomerListActionType = "Replace"
+)
+
+// CustomerListItemSubType 表示客户列表项的类型
+type CustomerListItemSubType string
+
+const (
+	CustomerListItemSubTypeEmail CustomerListItemSubType = "Email"
+)
+
+// CustomerAccountShareAssociation 表示共享受众在账户中的使用情况
+type CustomerAccountShareAssociation struct {
+	AssociationCount base.Nillable[int64]  `xml:"AssociationCount"`
+	UsageType        base.Nillable[string] `xml:"UsageType"`
+}
+
+// CustomerAccountShare 表示受众共享给的账户
+type CustomerAccountShare struct {
+	AccountId    base.Nillable[int64]              `xml:"AccountId"`
+	Associations []CustomerAccountShareAssociation `xml:"Associations>CustomerAccountShareAssociation,omitempty"`
+}
+
+// CustomerShare 表示客户级受众的共享信息
+type CustomerShare struct {
+	CustomerAccountShares []CustomerAccountShare `xml:"CustomerAccountShares>CustomerAccountShare,omitempty"`
+	OwnerCustomerId       base.Nillable[int64]   `xml:"OwnerCustomerId"`
+}
+
+// Audience 表示一个具体类型的受众
+//
+// 该接口是封闭的，只能由本包中的受众类型和 UnknownAudience 实现。
+type Audience interface {
+	// AudienceXSIType 返回受众的 i:type 类型
+	AudienceXSIType() AudienceXSIType
+
+	isAudience()
+}
+
+// AudienceBase 受众的公共字段，字段顺序与 WSDL 保持一致
+type AudienceBase struct {
+	// 受众在 Microsoft Audience Network 中的规模，由服务端返回
+	AudienceNetworkSize     base.Nillable[int64]    `xml:"AudienceNetworkSize"`
+	CustomerShare           *CustomerShare          `xml:"CustomerShare,omitempty"`
+	Description             base.Nillable[string]   `xml:"Description"`
+	ForwardCompatibilityMap ForwardCompatibilityMap `xml:"ForwardCompatibilityMap"`
+	Id                      base.Nillable[int64]    `xml:"Id"`
+	// 用户在受众中保留的天数
+	MembershipDuration base.Nillable[int]         `xml:"MembershipDuration"`
+	Name               base.Nillable[string]      `xml:"Name"`
+	ParentId           base.Nillable[int64]       `xml:"ParentId"`
+	Scope              base.Nillable[EntityScope] `xml:"Scope"`
+	// 受众在搜索网络中的规模，由服务端返回
+	SearchSize             base.Nillable[int64] `xml:"SearchSize"`
+	SupportedCampaignTypes common.StringArray   `xml:"SupportedCampaignTypes"`
+	// Type 由服务端返回，添加或更新时不需要设置
+	Type AudienceType `xml:"Type,omitempty"`
+}
+
+// RemarketingList 表示根据 UET 标签规则收集访客的再营销列表
+type RemarketingList struct {
+	AudienceBase
+	Rule  RemarketingRule      `xml:"Rule"`
+	TagId base.Nillable[int64] `xml:"TagId"`
+}
+
+// CustomAudience 表示自定义受众，只能通过批量服务上传成员
+type CustomAudience struct {
+	AudienceBase
+}
+
+// InMarketAudience 表示系统预定义的购买意向受众，不能添加或删除
+type InMarketAudience struct {
+	AudienceBase
+}
+
+// SimilarRemarketingList 表示与源再营销列表相似的用户
+type SimilarRemarketingList struct {
+	AudienceBase
+	SourceId base.Nillable[int64] `xml:"SourceId"`
+}
+
+// CombinationRule 表示组合列表中的一条规则
+type CombinationRule struct {
+	AudienceIds common.LongArray `xml:"AudienceIds"`
+	Operator    LogicalOperator  `xml:"Operator"`
+}
+
+// CombinedList 表示由多个受众组合而成的列表
+type CombinedList struct {
+	AudienceBase
+	CombinationRules []CombinationRule `xml:"CombinationRules>CombinationRule,omitempty"`
+}
+
+// CustomerList 表示客户匹配列表，成员通过 ApplyCustomerListItems 上传
+type CustomerList struct {
+	AudienceBase
+}
+
+// ProductAudience 表示根据购物行为收集访客的产品受众
+type ProductAudience struct {
+	AudienceBase
+	ProductAudienceType base.Nillable[ProductAudienceType] `xml:"ProductAudienceType"`
+	TagId               base.Nillable[int64]               `xml:"TagId"`
+}
+
+// UnknownAudience 保存无法识别类型的受众的原始 XML，用于向前兼容
+type UnknownAudience struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (RemarketingList) isAudience()        {}
+func (CustomAudience) isAudience()         {}
+func (InMarketAudience) isAudience()       {}
+func (SimilarRemarketingList) isAudience() {}
+func (CombinedList) isAudience()           {}
+func (CustomerList) isAudience()           {}
+func (ProductAudience) isAudience()        {}
+func (UnknownAudience) isAudience()        {}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (RemarketingList) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeRemarketingList
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (CustomAudience) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeCustomAudience
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (InMarketAudience) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeInMarketAudience
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (SimilarRemarketingList) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeSimilarRemarketingList
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (CombinedList) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeCombinedList
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (CustomerList) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeCustomerList
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (ProductAudience) AudienceXSIType() AudienceXSIType {
+	return AudienceXSITypeProductAudience
+}
+
+// AudienceXSIType 返回受众的 i:type 类型
+func (a UnknownAudience) AudienceXSIType() AudienceXSIType {
+	return AudienceXSIType(a.TypeName)
+}
+
+// MarshalXML 自定义 RemarketingList 的 XML 序列化
+func (a RemarketingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience RemarketingList
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// UnmarshalXML 自定义 RemarketingList 的 XML 反序列化，按 i:type 解码 Rule
+func (a *RemarketingList) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type audience RemarketingList
+	var v struct {
+		audience
+		Rule remarketingRuleElement `xml:"Rule"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*a = RemarketingList(v.audience)
+	a.Rule = v.Rule.rule
+	return nil
+}
+
+// MarshalXML 自定义 CustomAudience 的 XML 序列化
+func (a CustomAudience) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience CustomAudience
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// MarshalXML 自定义 InMarketAudience 的 XML 序列化
+func (a InMarketAudience) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience InMarketAudience
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// MarshalXML 自定义 SimilarRemarketingList 的 XML 序列化
+func (a SimilarRemarketingList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience SimilarRemarketingList
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// MarshalXML 自定义 CombinedList 的 XML 序列化
+func (a CombinedList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience CombinedList
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// MarshalXML 自定义 CustomerList 的 XML 序列化
+func (a CustomerList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience CustomerList
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// MarshalXML 自定义 ProductAudience 的 XML 序列化
+func (a ProductAudience) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type audience ProductAudience
+	return encodeAudience(e, start, a.AudienceXSIType(), audience(a))
+}
+
+// MarshalXML 自定义 UnknownAudience 的 XML 序列化，原样输出保存的 XML
+func (a UnknownAudience) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a.TypeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: a.TypeName})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := common.CopyRawXML(e, a.InnerXML); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeAudience 编码受众并加上 i:type 属性
+func encodeAudience(e *xml.Encoder, start xml.StartElement, typeName AudienceXSIType, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: string(typeName)})
+	return e.EncodeElement(v, start)
+}
+
+// DecodeAudience 根据 i:type 属性将 Audience 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownAudience，元素为 i:nil 时返回 nil。
+func DecodeAudience(d *xml.Decoder, start xml.StartElement) (Audience, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); AudienceXSIType(typeName) {
+	case AudienceXSITypeRemarketingList:
+		return decodeAudience[RemarketingList](d, start)
+	case AudienceXSITypeCustomAudience:
+		return decodeAudience[CustomAudience](d, start)
+	case AudienceXSITypeInMarketAudience:
+		return decodeAudience[InMarketAudience](d, start)
+	case AudienceXSITypeSimilarRemarketingList:
+		return decodeAudience[SimilarRemarketingList](d, start)
+	case AudienceXSITypeCombinedList:
+		return decodeAudience[CombinedList](d, start)
+	case AudienceXSITypeCustomerList:
+		return decodeAudience[CustomerList](d, start)
+	case AudienceXSITypeProductAudience:
+		return decodeAudience[ProductAudience](d, start)
+	default:
+		unknown, err := decodeAudience[UnknownAudience](d, start)
+		if err != nil {
+			return nil, err
+		}
+		audience := unknown.(UnknownAudience)
+		audience.TypeName = typeName
+		return audience, nil
+	}
+}
+
+// decodeAudience 将元素解码为指定类型的受众
+func decodeAudience[T Audience](d *xml.Decoder, start xml.StartElement) (Audience, error) {
+	var audience T
+	if err := d.DecodeElement(&audience, &start); err != nil {
+		return nil, err
+	}
+	return audience, nil
+}
+
+// audienceElement 用于在结构体字段中解码多态的 Audience
+type audienceElement struct {
+	audience Audience
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *audienceElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	audience, err := DecodeAudience(d, start)
+	if err != nil {
+		return err
+	}
+	el.audience = audience
+	return nil
+}
+
+// CustomerListItems 表示一次客户列表成员上传
+type CustomerListItems struct {
+	ActionType              CustomerListActionType  `xml:"ActionType"`
+	CustomerListId          int64                   `xml:"CustomerListId"`
+	CustomerListItemSubType CustomerListItemSubType `xml:"CustomerListItemSubType"`
+	// 经过 SHA-256 哈希的成员
+	CustomerListItems common.StringArray `xml:"CustomerListItems"`
+}
+
+// GetAudiencesByIdsRequest 请求结构体
+type GetAudiencesByIdsRequest struct {
+	XMLName     xml.Name         `xml:"GetAudiencesByIdsRequest"`
+	Namespace   string           `xml:"xmlns,attr"`
+	AudienceIds common.LongArray `xml:"AudienceIds"`
+	// 以空格分隔的受众类型
+	Type string `xml:"Type"`
+}
+
+// GetAudiencesByIdsResponse 响应结构体
+type GetAudiencesByIdsResponse struct {
+	XMLName       xml.Name     `xml:"GetAudiencesByIdsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	Audiences     []Audience   `xml:"-"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UnmarshalXML 自定义 GetAudiencesByIdsResponse 的 XML 反序列化，按 i:type 解码受众
+func (r *GetAudiencesByIdsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		Audiences     []audienceElement `xml:"Audiences>Audience"`
+		PartialErrors []BatchError      `xml:"PartialErrors>BatchError"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	r.XMLName = start.Name
+	r.Audiences = make([]Audience, len(v.Audiences))
+	for i, el := range v.Audiences {
+		r.Audiences[i] = el.audience
+	}
+	r.PartialErrors = v.PartialErrors
+	return nil
+}
+
+// AddAudiencesRequest 请求结构体
+type AddAudiencesRequest struct {
+	XMLName   xml.Name   `xml:"AddAudiencesRequest"`
+	Namespace string     `xml:"xmlns,attr"`
+	Audiences []Audience `xml:"Audiences>Audience"`
+}
+
+// AddAudiencesResponse 响应结构体
+type AddAudiencesResponse struct {
+	XMLName       xml.Name     `xml:"AddAudiencesResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	AudienceIds   []int64      `xml:"AudienceIds>long,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UpdateAudiencesRequest 请求结构体
+type UpdateAudiencesRequest struct {
+	XMLName   xml.Name   `xml:"UpdateAudiencesRequest"`
+	Namespace string     `xml:"xmlns,attr"`
+	Audiences []Audience `xml:"Audiences>Audience"`
+}
+
+// UpdateAudiencesResponse 响应结构体
+type UpdateAudiencesResponse struct {
+	XMLName       xml.Name     `xml:"UpdateAudiencesResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteAudiencesRequest 请求结构体
+type DeleteAudiencesRequest struct {
+	XMLName     xml.Name         `xml:"DeleteAudiencesRequest"`
+	Namespace   string           `xml:"xmlns,attr"`
+	AudienceIds common.LongArray `xml:"AudienceIds"`
+}
+
+// DeleteAudiencesResponse 响应结构体
+type DeleteAudiencesResponse struct {
+	XMLName       xml.Name     `xml:"DeleteAudiencesResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// ApplyCustomerListItemsRequest 请求结构体
+type ApplyCustomerListItemsRequest struct {
+	XMLName           xml.Name          `xml:"ApplyCustomerListItemsRequest"`
+	Namespace         string            `xml:"xmlns,attr"`
+	CustomerListItems CustomerListItems `xml:"CustomerListItems"`
+}
+
+// ApplyCustomerListItemsResponse 响应结构体
+type ApplyCustomerListItemsResponse struct {
+	XMLName       xml.Name     `xml:"ApplyCustomerListItemsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// HashEmail 按客户匹配的要求规范化邮箱并返回 SHA-256 哈希的十六进制小写字符串
+//
+// 规范化会去掉首尾空白并转为小写。已经是 64 位十六进制的值视为已哈希，只转为小写后原样返回。
+func HashEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if isSHA256Hex(email) {
+		return email
+	}
+	sum := sha256.Sum256([]byte(email))
+	return hex.EncodeToString(sum[:])
+}
+
+// isSHA256Hex 检查字符串是否为 SHA-256 哈希的十六进制表示
+func isSHA256Hex(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -29,6 +29,32 @@ type KeyValuePairOfstringstring struct {
 	Value string `xml:"value"`
 }
 
+// ForwardCompatibilityMap 表示用于向前兼容的键值对列表，列表为空时不输出元素
+type ForwardCompatibilityMap []KeyValuePairOfstringstring
+
+// MarshalXML 自定义 ForwardCompatibilityMap 的 XML 序列化
+func (m ForwardCompatibilityMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(m) == 0 {
+		return nil
+	}
+	pairs := struct {
+		Pairs []KeyValuePairOfstringstring `xml:"KeyValuePairOfstringstring"`
+	}{m}
+	return e.EncodeElement(pairs, start)
+}
+
+// UnmarshalXML 自定义 ForwardCompatibilityMap 的 XML 反序列化
+func (m *ForwardCompatibilityMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var pairs struct {
+		Pairs []KeyValuePairOfstringstring `xml:"KeyValuePairOfstringstring"`
+	}
+	if err := d.DecodeElement(&pairs, &start); err != nil {
+		return err
+	}
+	*m = pairs.Pairs
+	return nil
+}
+
 // CampaignManagementBody 表示请求体
 type CampaignManagementBody struct {
 	XMLName                                             xml.Name                                             `xml:"s:Body"`
@@ -37,6 +63,58 @@ type CampaignManagementBody struct {
 	GetSharedEntityAssociationsBySharedEntityIdsRequest *GetSharedEntityAssociationsBySharedEntityIdsRequest `xml:"GetSharedEntityAssociationsBySharedEntityIdsRequest,omitempty"`
 	AddListItemsToSharedListRequest                     *AddListItemsToSharedListRequest                     `xml:"AddListItemsToSharedListRequest,omitempty"`
 	DeleteListItemsFromSharedListRequest                *DeleteListItemsFromSharedListRequest                `xml:"DeleteListItemsFromSharedListRequest,omitempty"`
+	GetBudgetsByIdsRequest                              *GetBudgetsByIdsRequest                              `xml:"GetBudgetsByIdsRequest,omitempty"`
+	AddBudgetsRequest                                   *AddBudgetsRequest                                   `xml:"AddBudgetsRequest,omitempty"`
+	UpdateBudgetsRequest                                *UpdateBudgetsRequest                                `xml:"UpdateBudgetsRequest,omitempty"`
+	DeleteBudgetsRequest                                *DeleteBudgetsRequest                                `xml:"DeleteBudgetsRequest,omitempty"`
+	GetCampaignIdsByBudgetIdsRequest                    *GetCampaignIdsByBudgetIdsRequest                    `xml:"GetCampaignIdsByBudgetIdsRequest,omitempty"`
+	GetBidStrategiesByIdsRequest                        *GetBidStrategiesByIdsRequest                        `xml:"GetBidStrategiesByIdsRequest,omitempty"`
+	AddBidStrategiesRequest                             *AddBidStrategiesRequest                             `xml:"AddBidStrategiesRequest,omitempty"`
+	UpdateBidStrategiesRequest                          *UpdateBidStrategiesRequest                          `xml:"UpdateBidStrategiesRequest,omitempty"`
+	DeleteBidStrategiesRequest                          *DeleteBidStrategiesRequest                          `xml:"DeleteBidStrategiesRequest,omitempty"`
+	GetCampaignIdsByBidStrategyIdsRequest               *GetCampaignIdsByBidStrategyIdsRequest               `xml:"GetCampaignIdsByBidStrategyIdsRequest,omitempty"`
+	AddLabelsRequest                                    *AddLabelsRequest                                    `xml:"AddLabelsRequest,omitempty"`
+	UpdateLabelsRequest                                 *UpdateLabelsRequest                                 `xml:"UpdateLabelsRequest,omitempty"`
+	DeleteLabelsRequest                                 *DeleteLabelsRequest                                 `xml:"DeleteLabelsRequest,omitempty"`
+	GetLabelsByIdsRequest                               *GetLabelsByIdsRequest                               `xml:"GetLabelsByIdsRequest,omitempty"`
+	SetLabelAssociationsRequest                         *SetLabelAssociationsRequest                         `xml:"SetLabelAssociationsRequest,omitempty"`
+	DeleteLabelAssociationsRequest                      *DeleteLabelAssociationsRequest                      `xml:"DeleteLabelAssociationsRequest,omitempty"`
+	GetLabelAssociationsByEntityIdsRequest              *GetLabelAssociationsByEntityIdsRequest              `xml:"GetLabelAssociationsByEntityIdsRequest,omitempty"`
+	GetLabelAssociationsByLabelIdsRequest               *GetLabelAssociationsByLabelIdsRequest               `xml:"GetLabelAssociationsByLabelIdsRequest,omitempty"`
+	GetAudiencesByIdsRequest                            *GetAudiencesByIdsRequest                            `xml:"GetAudiencesByIdsRequest,omitempty"`
+	AddAudiencesRequest                                 *AddAudiencesRequest                                 `xml:"AddAudiencesRequest,omitempty"`
+	UpdateAudiencesRequest                              *UpdateAudiencesRequest                              `xml:"UpdateAudiencesRequest,omitempty"`
+	DeleteAudiencesRequest                              *DeleteAudiencesRequest                              `xml:"DeleteAudiencesRequest,omitempty"`
+	ApplyCustomerListItemsRequest                       *ApplyCustomerListItemsRequest                       `xml:"ApplyCustomerListItemsRequest,omitempty"`
+	AddUetTagsRequest                                   *AddUetTagsRequest                                   `xml:"AddUetTagsRequest,omitempty"`
+	GetUetTagsByIdsRequest                              *GetUetTagsByIdsRequest                              `xml:"GetUetTagsByIdsRequest,omitempty"`
+	UpdateUetTagsRequest                                *UpdateUetTagsRequest                                `xml:"UpdateUetTagsRequest,omitempty"`
+	AddConversionGoalsRequest                           *AddConversionGoalsRequest                           `xml:"AddConversionGoalsRequest,omitempty"`
+	GetConversionGoalsByIdsRequest                      *GetConversionGoalsByIdsRequest                      `xml:"GetConversionGoalsByIdsRequest,omitempty"`
+	UpdateConversionGoalsRequest                        *UpdateConversionGoalsRequest                        `xml:"UpdateConversionGoalsRequest,omitempty"`
+	GetConversionGoalsByTagIdsRequest                   *GetConversionGoalsByTagIdsRequest                   `xml:"GetConversionGoalsByTagIdsRequest,omitempty"`
+	ApplyOfflineConversionsRequest                      *ApplyOfflineConversionsRequest                      `xml:"ApplyOfflineConversionsRequest,omitempty"`
+	ApplyOnlineConversionAdjustmentsRequest             *ApplyOnlineConversionAdjustmentsRequest             `xml:"ApplyOnlineConversionAdjustmentsRequest,omitempty"`
+	AddAdExtensionsRequest                              *AddAdExtensionsRequest                              `xml:"AddAdExtensionsRequest,omitempty"`
+	GetAdExtensionsByIdsRequest                         *GetAdExtensionsByIdsRequest                         `xml:"GetAdExtensionsByIdsRequest,omitempty"`
+	GetAdExtensionIdsByAccountIdRequest                 *GetAdExtensionIdsByAccountIdRequest                 `xml:"GetAdExtensionIdsByAccountIdRequest,omitempty"`
+	UpdateAdExtensionsRequest                           *UpdateAdExtensionsRequest                           `xml:"UpdateAdExtensionsRequest,omitempty"`
+	DeleteAdExtensionsRequest                           *DeleteAdExtensionsRequest                           `xml:"DeleteAdExtensionsRequest,omitempty"`
+	SetAdExtensionsAssociationsRequest                  *SetAdExtensionsAssociationsRequest                  `xml:"SetAdExtensionsAssociationsRequest,omitempty"`
+	GetAdExtensionsAssociationsRequest                  *GetAdExtensionsAssociationsRequest                  `xml:"GetAdExtensionsAssociationsRequest,omitempty"`
+	AddMediaRequest                                     *AddMediaRequest                                     `xml:"AddMediaRequest,omitempty"`
+	GetMediaMetaDataByAccountIdRequest                  *GetMediaMetaDataByAccountIdRequest                  `xml:"GetMediaMetaDataByAccountIdRequest,omitempty"`
+	GetNegativeKeywordsByEntityIdsRequest               *GetNegativeKeywordsByEntityIdsRequest               `xml:"GetNegativeKeywordsByEntityIdsRequest,omitempty"`
+	AddNegativeKeywordsToEntitiesRequest                *AddNegativeKeywordsToEntitiesRequest                `xml:"AddNegativeKeywordsToEntitiesRequest,omitempty"`
+	DeleteNegativeKeywordsFromEntitiesRequest           *DeleteNegativeKeywordsFromEntitiesRequest           `xml:"DeleteNegativeKeywordsFromEntitiesRequest,omitempty"`
+	AddExperimentsRequest                               *AddExperimentsRequest                               `xml:"AddExperimentsRequest,omitempty"`
+	GetExperimentsByIdsRequest                          *GetExperimentsByIdsRequest                          `xml:"GetExperimentsByIdsRequest,omitempty"`
+	UpdateExperimentsRequest                            *UpdateExperimentsRequest                            `xml:"UpdateExperimentsRequest,omitempty"`
+	DeleteExperimentsRequest                            *DeleteExperimentsRequest                            `xml:"DeleteExperimentsRequest,omitempty"`
+	GetAdGroupCriterionsByIdsRequest                    *GetAdGroupCriterionsByIdsRequest                    `xml:"GetAdGroupCriterionsByIdsRequest,omitempty"`
+	ApplyProductPartitionActionsRequest                 *ApplyProductPartitionActionsRequest                 `xml:"ApplyProductPartitionActionsRequest,omitempty"`
+	SetSharedEntityAssociationsRequest                  *SetSharedEntityAssociationsRequest                  `xml:"SetSharedEntityAssociationsRequest,omitempty"`
+	DeleteSharedEntityAssociationsRequest               *DeleteSharedEntityAssociationsRequest               `xml:"DeleteSharedEntityAssociationsRequest,omitempty"`
 }
 
 // CampaignManagementResponseBody 表示响应体
@@ -48,6 +126,58 @@ type CampaignManagementResponseBody struct {
 	GetSharedEntityAssociationsBySharedEntityIdsResponse *GetSharedEntityAssociationsBySharedEntityIdsResponse `xml:"GetSharedEntityAssociationsBySharedEntityIdsResponse,omitempty"`
 	AddListItemsToSharedListResponse                     *AddListItemsToSharedListResponse                     `xml:"AddListItemsToSharedListResponse,omitempty"`
 	DeleteListItemsFromSharedListResponse                *DeleteListItemsFromSharedListResponse                `xml:"DeleteListItemsFromSharedListResponse,omitempty"`
+	GetBudgetsByIdsResponse                              *GetBudgetsByIdsResponse                              `xml:"GetBudgetsByIdsResponse,omitempty"`
+	AddBudgetsResponse                                   *AddBudgetsResponse                                   `xml:"AddBudgetsResponse,omitempty"`
+	UpdateBudgetsResponse                                *UpdateBudgetsResponse                                `xml:"UpdateBudgetsResponse,omitempty"`
+	DeleteBudgetsResponse                                *DeleteBudgetsResponse                                `xml:"DeleteBudgetsResponse,omitempty"`
+	GetCampaignIdsByBudgetIdsResponse                    *GetCampaignIdsByBudgetIdsResponse                    `xml:"GetCampaignIdsByBudgetIdsResponse,omitempty"`
+	GetBidStrategiesByIdsResponse                        *GetBidStrategiesByIdsResponse                        `xml:"GetBidStrategiesByIdsResponse,omitempty"`
+	AddBidStrategiesResponse                             *AddBidStrategiesResponse                             `xml:"AddBidStrategiesResponse,omitempty"`
+	UpdateBidStrategiesResponse                          *UpdateBidStrategiesResponse                          `xml:"UpdateBidStrategiesResponse,omitempty"`
+	DeleteBidStrategiesResponse                          *DeleteBidStrategiesResponse                          `xml:"DeleteBidStrategiesResponse,omitempty"`
+	GetCampaignIdsByBidStrategyIdsResponse               *GetCampaignIdsByBidStrategyIdsResponse               `xml:"GetCampaignIdsByBidStrategyIdsResponse,omitempty"`
+	AddLabelsResponse                                    *AddLabelsResponse                                    `xml:"AddLabelsResponse,omitempty"`
+	UpdateLabelsResponse                                 *UpdateLabelsResponse                                 `xml:"UpdateLabelsResponse,omitempty"`
+	DeleteLabelsResponse                                 *DeleteLabelsResponse                                 `xml:"DeleteLabelsResponse,omitempty"`
+	GetLabelsByIdsResponse                               *GetLabelsByIdsResponse                               `xml:"GetLabelsByIdsResponse,omitempty"`
+	SetLabelAssociationsResponse                         *SetLabelAssociationsResponse                         `xml:"SetLabelAssociationsResponse,omitempty"`
+	DeleteLabelAssociationsResponse                      *DeleteLabelAssociationsResponse                      `xml:"DeleteLabelAssociationsResponse,omitempty"`
+	GetLabelAssociationsByEntityIdsResponse              *GetLabelAssociationsByEntityIdsResponse              `xml:"GetLabelAssociationsByEntityIdsResponse,omitempty"`
+	GetLabelAssociationsByLabelIdsResponse               *GetLabelAssociationsByLabelIdsResponse               `xml:"GetLabelAssociationsByLabelIdsResponse,omitempty"`
+	GetAudiencesByIdsResponse                            *GetAudiencesByIdsResponse                            `xml:"GetAudiencesByIdsResponse,omitempty"`
+	AddAudiencesResponse                                 *AddAudiencesResponse                                 `xml:"AddAudiencesResponse,omitempty"`
+	UpdateAudiencesResponse                              *UpdateAudiencesResponse                              `xml:"UpdateAudiencesResponse,omitempty"`
+	DeleteAudiencesResponse                              *DeleteAudiencesResponse                              `xml:"DeleteAudiencesResponse,omitempty"`
+	ApplyCustomerListItemsResponse                       *ApplyCustomerListItemsResponse                       `xml:"ApplyCustomerListItemsResponse,omitempty"`
+	AddUetTagsResponse                                   *AddUetTagsResponse                                   `xml:"AddUetTagsResponse,omitempty"`
+	GetUetTagsByIdsResponse                              *GetUetTagsByIdsResponse                              `xml:"GetUetTagsByIdsResponse,omitempty"`
+	UpdateUetTagsResponse                                *UpdateUetTagsResponse                                `xml:"UpdateUetTagsResponse,omitempty"`
+	AddConversionGoalsResponse                           *AddConversionGoalsResponse                           `xml:"AddConversionGoalsResponse,omitempty"`
+	GetConversionGoalsByIdsResponse                      *GetConversionGoalsByIdsResponse                      `xml:"GetConversionGoalsByIdsResponse,omitempty"`
+	UpdateConversionGoalsResponse                        *UpdateConversionGoalsResponse                        `xml:"UpdateConversionGoalsResponse,omitempty"`
+	GetConversionGoalsByTagIdsResponse                   *GetConversionGoalsByTagIdsResponse                   `xml:"GetConversionGoalsByTagIdsResponse,omitempty"`
+	ApplyOfflineConversionsResponse                      *ApplyOfflineConversionsResponse                      `xml:"ApplyOfflineConversionsResponse,omitempty"`
+	ApplyOnlineConversionAdjustmentsResponse             *ApplyOnlineConversionAdjustmentsResponse             `xml:"ApplyOnlineConversionAdjustmentsResponse,omitempty"`
+	AddAdExtensionsResponse                              *AddAdExtensionsResponse                              `xml:"AddAdExtensionsResponse,omitempty"`
+	GetAdExtensionsByIdsResponse                         *GetAdExtensionsByIdsResponse                         `xml:"GetAdExtensionsByIdsResponse,omitempty"`
+	GetAdExtensionIdsByAccountIdResponse                 *GetAdExtensionIdsByAccountIdResponse                 `xml:"GetAdExtensionIdsByAccountIdResponse,omitempty"`
+	UpdateAdExtensionsResponse                           *UpdateAdExtensionsResponse                           `xml:"UpdateAdExtensionsResponse,omitempty"`
+	DeleteAdExtensionsResponse                           *DeleteAdExtensionsResponse                           `xml:"DeleteAdExtensionsResponse,omitempty"`
+	SetAdExtensionsAssociationsResponse                  *SetAdExtensionsAssociationsResponse                  `xml:"SetAdExtensionsAssociationsResponse,omitempty"`
+	GetAdExtensionsAssociationsResponse                  *GetAdExtensionsAssociationsResponse                  `xml:"GetAdExtensionsAssociationsResponse,omitempty"`
+	AddMediaResponse                                     *AddMediaResponse                                     `xml:"AddMediaResponse,omitempty"`
+	GetMediaMetaDataByAccountIdResponse                  *GetMediaMetaDataByAccountIdResponse                  `xml:"GetMediaMetaDataByAccountIdResponse,omitempty"`
+	GetNegativeKeywordsByEntityIdsResponse               *GetNegativeKeywordsByEntityIdsResponse               `xml:"GetNegativeKeywordsByEntityIdsResponse,omitempty"`
+	AddNegativeKeywordsToEntitiesResponse                *AddNegativeKeywordsToEntitiesResponse                `xml:"AddNegativeKeywordsToEntitiesResponse,omitempty"`
+	DeleteNegativeKeywordsFromEntitiesResponse           *DeleteNegativeKeywordsFromEntitiesResponse           `xml:"DeleteNegativeKeywordsFromEntitiesResponse,omitempty"`
+	AddExperimentsResponse                               *AddExperimentsResponse                               `xml:"AddExperimentsResponse,omitempty"`
+	GetExperimentsByIdsResponse                          *GetExperimentsByIdsResponse                          `xml:"GetExperimentsByIdsResponse,omitempty"`
+	UpdateExperimentsResponse                            *UpdateExperimentsResponse                            `xml:"UpdateExperimentsResponse,omitempty"`
+	DeleteExperimentsResponse                            *DeleteExperimentsResponse                            `xml:"DeleteExperimentsResponse,omitempty"`
+	GetAdGroupCriterionsByIdsResponse                    *GetAdGroupCriterionsByIdsResponse                    `xml:"GetAdGroupCriterionsByIdsResponse,omitempty"`
+	ApplyProductPartitionActionsResponse                 *ApplyProductPartitionActionsResponse                 `xml:"ApplyProductPartitionActionsResponse,omitempty"`
+	SetSharedEntityAssociationsResponse                  *SetSharedEntityAssociationsResponse                  `xml:"SetSharedEntityAssociationsResponse,omitempty"`
+	DeleteSharedEntityAssociationsResponse               *DeleteSharedEntityAssociationsResponse               `xml:"DeleteSharedEntityAssociationsResponse,omitempty"`
 }
 
 // CampaignManagementEnvelope 表示完整的 SOAP 请求
@@ -391,6 +521,13 @@ func (b CampaignManagementBody) MarshalXML(enc *xml.Encoder, start xml.StartElem
 		}
 	}
 
+	// 其它请求的元素名称和命名空间由结构体标签描述，直接编码
+	for _, request := range b.taggedRequests() {
+		if err := enc.Encode(request); err != nil {
+			return err
+		}
+	}
+
 	// 结束 Body
 	if err := enc.EncodeToken(start.End()); err != nil {
 		return err
@@ -398,3 +535,165 @@ func (b CampaignManagementBody) MarshalXML(enc *xml.Encoder, start xml.StartElem
 
 	return nil
 }
+
+// taggedRequests 返回通过结构体标签编码的非空请求
+func (b CampaignManagementBody) taggedRequests() []any {
+	var requests []any
+	if b.GetBudgetsByIdsRequest != nil {
+		requests = append(requests, b.GetBudgetsByIdsRequest)
+	}
+	if b.AddBudgetsRequest != nil {
+		requests = append(requests, b.AddBudgetsRequest)
+	}
+	if b.UpdateBudgetsRequest != nil {
+		requests = append(requests, b.UpdateBudgetsRequest)
+	}
+	if b.DeleteBudgetsRequest != nil {
+		requests = append(requests, b.DeleteBudgetsRequest)
+	}
+	if b.GetCampaignIdsByBudgetIdsRequest != nil {
+		requests = append(requests, b.GetCampaignIdsByBudgetIdsRequest)
+	}
+	if b.GetBidStrategiesByIdsRequest != nil {
+		requests = append(requests, b.GetBidStrategiesByIdsRequest)
+	}
+	if b.AddBidStrategiesRequest != nil {
+		requests = append(requests, b.AddBidStrategiesRequest)
+	}
+	if b.UpdateBidStrategiesRequest != nil {
+		requests = append(requests, b.UpdateBidStrategiesRequest)
+	}
+	if b.DeleteBidStrategiesRequest != nil {
+		requests = append(requests, b.DeleteBidStrategiesRequest)
+	}
+	if b.GetCampaignIdsByBidStrategyIdsRequest != nil {
+		requests = append(requests, b.GetCampaignIdsByBidStrategyIdsRequest)
+	}
+	if b.AddLabelsRequest != nil {
+		requests = append(requests, b.AddLabelsRequest)
+	}
+	if b.UpdateLabelsRequest != nil {
+		requests = append(requests, b.UpdateLabelsRequest)
+	}
+	if b.DeleteLabelsRequest != nil {
+		requests = append(requests, b.DeleteLabelsRequest)
+	}
+	if b.GetLabelsByIdsRequest != nil {
+		requests = append(requests, b.GetLabelsByIdsRequest)
+	}
+	if b.SetLabelAssociationsRequest != nil {
+		requests = append(requests, b.SetLabelAssociationsRequest)
+	}
+	if b.DeleteLabelAssociationsRequest != nil {
+		requests = append(requests, b.DeleteLabelAssociationsRequest)
+	}
+	if b.GetLabelAssociationsByEntityIdsRequest != nil {
+		requests = append(requests, b.GetLabelAssociationsByEntityIdsRequest)
+	}
+	if b.GetLabelAssociationsByLabelIdsRequest != nil {
+		requests = append(requests, b.GetLabelAssociationsByLabelIdsRequest)
+	}
+	if b.GetAudiencesByIdsRequest != nil {
+		requests = append(requests, b.GetAudiencesByIdsRequest)
+	}
+	if b.AddAudiencesRequest != nil {
+		requests = append(requests, b.AddAudiencesRequest)
+	}
+	if b.UpdateAudiencesRequest != nil {
+		requests = append(requests, b.UpdateAudiencesRequest)
+	}
+	if b.DeleteAudiencesRequest != nil {
+		requests = append(requests, b.DeleteAudiencesRequest)
+	}
+	if b.ApplyCustomerListItemsRequest != nil {
+		requests = append(requests, b.ApplyCustomerListItemsRequest)
+	}
+	if b.AddUetTagsRequest != nil {
+		requests = append(requests, b.AddUetTagsRequest)
+	}
+	if b.GetUetTagsByIdsRequest != nil {
+		requests = append(requests, b.GetUetTagsByIdsRequest)
+	}
+	if b.UpdateUetTagsRequest != nil {
+		requests = append(requests, b.UpdateUetTagsRequest)
+	}
+	if b.AddConversionGoalsRequest != nil {
+		requests = append(requests, b.AddConversionGoalsRequest)
+	}
+	if b.GetConversionGoalsByIdsRequest != nil {
+		requests = append(requests, b.GetConversionGoalsByIdsRequest)
+	}
+	if b.UpdateConversionGoalsRequest != nil {
+		requests = append(requests, b.UpdateConversionGoalsRequest)
+	}
+	if b.GetConversionGoalsByTagIdsRequest != nil {
+		requests = append(requests, b.GetConversionGoalsByTagIdsRequest)
+	}
+	if b.ApplyOfflineConversionsRequest != nil {
+		requests = append(requests, b.ApplyOfflineConversionsRequest)
+	}
+	if b.ApplyOnlineConversionAdjustmentsRequest != nil {
+		requests = append(requests, b.ApplyOnlineConversionAdjustmentsRequest)
+	}
+	if b.AddAdExtensionsRequest != nil {
+		requests = append(requests, b.AddAdExtensionsRequest)
+	}
+	if b.GetAdExtensionsByIdsRequest != nil {
+		requests = append(requests, b.GetAdExtensionsByIdsRequest)
+	}
+	if b.GetAdExtensionIdsByAccountIdRequest != nil {
+		requests = append(requests, b.GetAdExtensionIdsByAccountIdRequest)
+	}
+	if b.UpdateAdExtensionsRequest != nil {
+		requests = append(requests, b.UpdateAdExtensionsRequest)
+	}
+	if b.DeleteAdExtensionsRequest != nil {
+		requests = append(requests, b.DeleteAdExtensionsRequest)
+	}
+	if b.SetAdExtensionsAssociationsRequest != nil {
+		requests = append(requests, b.SetAdExtensionsAssociationsRequest)
+	}
+	if b.GetAdExtensionsAssociationsRequest != nil {
+		requests = append(requests, b.GetAdExtensionsAssociationsRequest)
+	}
+	if b.AddMediaRequest != nil {
+		requests = append(requests, b.AddMediaRequest)
+	}
+	if b.GetMediaMetaDataByAccountIdRequest != nil {
+		requests = append(requests, b.GetMediaMetaDataByAccountIdRequest)
+	}
+	if b.GetNegativeKeywordsByEntityIdsRequest != nil {
+		requests = append(requests, b.GetNegativeKeywordsByEntityIdsRequest)
+	}
+	if b.AddNegativeKeywordsToEntitiesRequest != nil {
+		requests = append(requests, b.AddNegativeKeywordsToEntitiesRequest)
+	}
+	if b.DeleteNegativeKeywordsFromEntitiesRequest != nil {
+		requests = append(requests, b.DeleteNegativeKeywordsFromEntitiesRequest)
+	}
+	if b.AddExperimentsRequest != nil {
+		requests = append(requests, b.AddExperimentsRequest)
+	}
+	if b.GetExperimentsByIdsRequest != nil {
+		requests = append(requests, b.GetExperimentsByIdsRequest)
+	}
+	if b.UpdateExperimentsRequest != nil {
+		requests = append(requests, b.UpdateExperimentsRequest)
+	}
+	if b.DeleteExperimentsRequest != nil {
+		requests = append(requests, b.DeleteExperimentsRequest)
+	}
+	if b.GetAdGroupCriterionsByIdsRequest != nil {
+		requests = append(requests, b.GetAdGroupCriterionsByIdsRequest)
+	}
+	if b.ApplyProductPartitionActionsRequest != nil {
+		requests = append(requests, b.ApplyProductPartitionActionsRequest)
+	}
+	if b.SetSharedEntityAssociationsRequest != nil {
+		requests = append(requests, b.SetSharedEntityAssociationsRequest)
+	}
+	if b.DeleteSharedEntityAssociationsRequest != nil {
+		requests = append(requests, b.DeleteSharedEntityAssociationsRequest)
+	}
+	return requests
+}
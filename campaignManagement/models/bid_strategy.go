@@ -0,0 +1,121 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// BidStrategy 表示可以被多个活动共享的组合出价策略
+type BidStrategy struct {
+	AssociatedCampaignType base.Nillable[string] `xml:"AssociatedCampaignType"`
+	AssociationCount       base.Nillable[int]    `xml:"AssociationCount"`
+	BiddingScheme          BiddingScheme         `xml:"BiddingScheme"`
+	CurrencyCode           base.Nillable[string] `xml:"CurrencyCode"`
+	Id                     base.Nillable[int64]  `xml:"Id"`
+	Name                   base.Nillable[string] `xml:"Name"`
+}
+
+// UnmarshalXML 自定义 BidStrategy 的 XML 反序列化，按 i:type 解码 BiddingScheme
+func (s *BidStrategy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type bidStrategy BidStrategy
+	var v struct {
+		bidStrategy
+		BiddingScheme biddingSchemeElement `xml:"BiddingScheme"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*s = BidStrategy(v.bidStrategy)
+	s.BiddingScheme = v.BiddingScheme.scheme
+	return nil
+}
+
+// biddingSchemeElement 用于在结构体字段中解码多态的 BiddingScheme
+type biddingSchemeElement struct {
+	scheme BiddingScheme
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *biddingSchemeElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	scheme, err := DecodeBiddingScheme(d, start)
+	if err != nil {
+		return err
+	}
+	el.scheme = scheme
+	return nil
+}
+
+// GetBidStrategiesByIdsRequest 请求结构体
+type GetBidStrategiesByIdsRequest struct {
+	XMLName        xml.Name         `xml:"GetBidStrategiesByIdsRequest"`
+	Namespace      string           `xml:"xmlns,attr"`
+	BidStrategyIds common.LongArray `xml:"BidStrategyIds"`
+}
+
+// GetBidStrategiesByIdsResponse 响应结构体
+type GetBidStrategiesByIdsResponse struct {
+	XMLName       xml.Name      `xml:"GetBidStrategiesByIdsResponse"`
+	Namespace     string        `xml:"xmlns,attr"`
+	BidStrategies []BidStrategy `xml:"BidStrategies>BidStrategy,omitempty"`
+	PartialErrors []BatchError  `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// AddBidStrategiesRequest 请求结构体
+type AddBidStrategiesRequest struct {
+	XMLName       xml.Name      `xml:"AddBidStrategiesRequest"`
+	Namespace     string        `xml:"xmlns,attr"`
+	BidStrategies []BidStrategy `xml:"BidStrategies>BidStrategy"`
+}
+
+// AddBidStrategiesResponse 响应结构体
+type AddBidStrategiesResponse struct {
+	XMLName        xml.Name     `xml:"AddBidStrategiesResponse"`
+	Namespace      string       `xml:"xmlns,attr"`
+	BidStrategyIds []int64      `xml:"BidStrategyIds>long,omitempty"`
+	PartialErrors  []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UpdateBidStrategiesRequest 请求结构体
+type UpdateBidStrategiesRequest struct {
+	XMLName       xml.Name      `xml:"UpdateBidStrategiesRequest"`
+	Namespace     string        `xml:"xmlns,attr"`
+	BidStrategies []BidStrategy `xml:"BidStrategies>BidStrategy"`
+}
+
+// UpdateBidStrategiesResponse 响应结构体
+type UpdateBidStrategiesResponse struct {
+	XMLName       xml.Name     `xml:"UpdateBidStrategiesResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteBidStrategiesRequest 请求结构体
+type DeleteBidStrategiesRequest struct {
+	XMLName        xml.Name         `xml:"DeleteBidStrategiesRequest"`
+	Namespace      string           `xml:"xmlns,attr"`
+	BidStrategyIds common.LongArray `xml:"BidStrategyIds"`
+}
+
+// DeleteBidStrategiesResponse 响应结构体
+type DeleteBidStrategiesResponse struct {
+	XMLName       xml.Name     `xml:"DeleteBidStrategiesResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetCampaignIdsByBidStrategyIdsRequest 请求结构体
+type GetCampaignIdsByBidStrategyIdsRequest struct {
+	XMLName        xml.Name         `xml:"GetCampaignIdsByBidStrategyIdsRequest"`
+	Namespace      string           `xml:"xmlns,attr"`
+	BidStrategyIds common.LongArray `xml:"BidStrategyIds"`
+}
+
+// GetCampaignIdsByBidStrategyIdsResponse 响应结构体
+type GetCampaignIdsByBidStrategyIdsResponse struct {
+	XMLName              xml.Name       `xml:"GetCampaignIdsByBidStrategyIdsResponse"`
+	Namespace            string         `xml:"xmlns,attr"`
+	CampaignIdCollection []IdCollection `xml:"CampaignIdCollection>IdCollection,omitempty"`
+	PartialErrors        []BatchError   `xml:"PartialErrors>BatchError,omitempty"`
+}
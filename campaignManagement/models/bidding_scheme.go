@@ -0,0 +1,282 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// BiddingSchemeType 表示出价策略的 i:type 类型
+type BiddingSchemeType string
+
+const (
+	BiddingSchemeTypeManualCpc             BiddingSchemeType = "ManualCpcBiddingScheme"
+	BiddingSchemeTypeEnhancedCpc           BiddingSchemeType = "EnhancedCpcBiddingScheme"
+	BiddingSchemeTypeMaxClicks             BiddingSchemeType = "MaxClicksBiddingScheme"
+	BiddingSchemeTypeMaxConversions        BiddingSchemeType = "MaxConversionsBiddingScheme"
+	BiddingSchemeTypeTargetCpa             BiddingSchemeType = "TargetCpaBiddingScheme"
+	BiddingSchemeTypeTargetRoas            BiddingSchemeType = "TargetRoasBiddingScheme"
+	BiddingSchemeTypeMaxConversionValue    BiddingSchemeType = "MaxConversionValueBiddingScheme"
+	BiddingSchemeTypeTargetImpressionShare BiddingSchemeType = "TargetImpressionShareBiddingScheme"
+)
+
+// TargetAdPosition 表示目标展示份额策略的目标位置
+type TargetAdPosition string
+
+const (
+	TargetAdPositionAnywhere    TargetAdPosition = "Anywhere"
+	TargetAdPositionTop         TargetAdPosition = "Top"
+	TargetAdPositionAbsoluteTop TargetAdPosition = "AbsoluteTop"
+)
+
+// BiddingScheme 表示一个具体类型的出价策略
+//
+// 该接口是封闭的，只能由本包中的 *BiddingScheme 类型和 UnknownBiddingScheme 实现。
+type BiddingScheme interface {
+	// BiddingSchemeType 返回出价策略的 i:type 类型
+	BiddingSchemeType() BiddingSchemeType
+
+	isBiddingScheme()
+}
+
+// BiddingSchemeBase 出价策略的公共字段
+type BiddingSchemeBase struct {
+	// Type 由服务端返回，添加或更新时不需要设置
+	Type string `xml:"Type,omitempty"`
+}
+
+// Bid 表示出价
+type Bid struct {
+	Amount base.Nillable[float64] `xml:"Amount"`
+}
+
+// NewBid 创建指定金额的出价
+func NewBid(amount float64) *Bid {
+	return &Bid{Amount: base.NewNillable(amount)}
+}
+
+// ManualCpcBiddingScheme 表示手动每次点击费用出价
+type ManualCpcBiddingScheme struct {
+	BiddingSchemeBase
+}
+
+// EnhancedCpcBiddingScheme 表示智能点击费用出价
+type EnhancedCpcBiddingScheme struct {
+	BiddingSchemeBase
+}
+
+// MaxClicksBiddingScheme 表示最大化点击出价
+type MaxClicksBiddingScheme struct {
+	BiddingSchemeBase
+	MaxCpc *Bid `xml:"MaxCpc,omitempty"`
+}
+
+// MaxConversionsBiddingScheme 表示最大化转化出价
+type MaxConversionsBiddingScheme struct {
+	BiddingSchemeBase
+	MaxCpc    *Bid                   `xml:"MaxCpc,omitempty"`
+	TargetCpa base.Nillable[float64] `xml:"TargetCpa"`
+}
+
+// TargetCpaBiddingScheme 表示目标每次转化费用出价
+type TargetCpaBiddingScheme struct {
+	BiddingSchemeBase
+	MaxCpc    *Bid                   `xml:"MaxCpc,omitempty"`
+	TargetCpa base.Nillable[float64] `xml:"TargetCpa"`
+}
+
+// TargetRoasBiddingScheme 表示目标广告支出回报率出价
+type TargetRoasBiddingScheme struct {
+	BiddingSchemeBase
+	MaxCpc     *Bid                   `xml:"MaxCpc,omitempty"`
+	TargetRoas base.Nillable[float64] `xml:"TargetRoas"`
+}
+
+// MaxConversionValueBiddingScheme 表示最大化转化价值出价
+type MaxConversionValueBiddingScheme struct {
+	BiddingSchemeBase
+	TargetRoas base.Nillable[float64] `xml:"TargetRoas"`
+}
+
+// TargetImpressionShareBiddingScheme 表示目标展示份额出价
+type TargetImpressionShareBiddingScheme struct {
+	BiddingSchemeBase
+	MaxCpc                *Bid                   `xml:"MaxCpc,omitempty"`
+	TargetAdPosition      TargetAdPosition       `xml:"TargetAdPosition,omitempty"`
+	TargetImpressionShare base.Nillable[float64] `xml:"TargetImpressionShare"`
+}
+
+// UnknownBiddingScheme 保存无法识别类型的出价策略的原始 XML，用于向前兼容
+type UnknownBiddingScheme struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (ManualCpcBiddingScheme) isBiddingScheme()             {}
+func (EnhancedCpcBiddingScheme) isBiddingScheme()           {}
+func (MaxClicksBiddingScheme) isBiddingScheme()             {}
+func (MaxConversionsBiddingScheme) isBiddingScheme()        {}
+func (TargetCpaBiddingScheme) isBiddingScheme()             {}
+func (TargetRoasBiddingScheme) isBiddingScheme()            {}
+func (MaxConversionValueBiddingScheme) isBiddingScheme()    {}
+func (TargetImpressionShareBiddingScheme) isBiddingScheme() {}
+func (UnknownBiddingScheme) isBiddingScheme()               {}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (ManualCpcBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeManualCpc
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (EnhancedCpcBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeEnhancedCpc
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (MaxClicksBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeMaxClicks
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (MaxConversionsBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeMaxConversions
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (TargetCpaBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeTargetCpa
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (TargetRoasBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeTargetRoas
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (MaxConversionValueBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeMaxConversionValue
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (TargetImpressionShareBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeTypeTargetImpressionShare
+}
+
+// BiddingSchemeType 返回出价策略的 i:type 类型
+func (s UnknownBiddingScheme) BiddingSchemeType() BiddingSchemeType {
+	return BiddingSchemeType(s.TypeName)
+}
+
+// MarshalXML 自定义 ManualCpcBiddingScheme 的 XML 序列化
+func (s ManualCpcBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme ManualCpcBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 EnhancedCpcBiddingScheme 的 XML 序列化
+func (s EnhancedCpcBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme EnhancedCpcBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 MaxClicksBiddingScheme 的 XML 序列化
+func (s MaxClicksBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme MaxClicksBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 MaxConversionsBiddingScheme 的 XML 序列化
+func (s MaxConversionsBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme MaxConversionsBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 TargetCpaBiddingScheme 的 XML 序列化
+func (s TargetCpaBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme TargetCpaBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 TargetRoasBiddingScheme 的 XML 序列化
+func (s TargetRoasBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme TargetRoasBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 MaxConversionValueBiddingScheme 的 XML 序列化
+func (s MaxConversionValueBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme MaxConversionValueBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 TargetImpressionShareBiddingScheme 的 XML 序列化
+func (s TargetImpressionShareBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type scheme TargetImpressionShareBiddingScheme
+	return encodeBiddingScheme(e, start, s.BiddingSchemeType(), scheme(s))
+}
+
+// MarshalXML 自定义 UnknownBiddingScheme 的 XML 序列化，原样输出保存的 XML
+func (s UnknownBiddingScheme) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if s.TypeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: s.TypeName})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := common.CopyRawXML(e, s.InnerXML); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeBiddingScheme 编码出价策略并加上 i:type 属性
+func encodeBiddingScheme(e *xml.Encoder, start xml.StartElement, typeName BiddingSchemeType, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: string(typeName)})
+	return e.EncodeElement(v, start)
+}
+
+// DecodeBiddingScheme 根据 i:type 属性将 BiddingScheme 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownBiddingScheme，元素为 i:nil 时返回 nil。
+func DecodeBiddingScheme(d *xml.Decoder, start xml.StartElement) (BiddingScheme, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); BiddingSchemeType(typeName) {
+	case BiddingSchemeTypeManualCpc:
+		return decodeBiddingScheme[ManualCpcBiddingScheme](d, start)
+	case BiddingSchemeTypeEnhancedCpc:
+		return decodeBiddingScheme[EnhancedCpcBiddingScheme](d, start)
+	case BiddingSchemeTypeMaxClicks:
+		return decodeBiddingScheme[MaxClicksBiddingScheme](d, start)
+	case BiddingSchemeTypeMaxConversions:
+		return decodeBiddingScheme[MaxConversionsBiddingScheme](d, start)
+	case BiddingSchemeTypeTargetCpa:
+		return decodeBiddingScheme[TargetCpaBiddingScheme](d, start)
+	case BiddingSchemeTypeTargetRoas:
+		return decodeBiddingScheme[TargetRoasBiddingScheme](d, start)
+	case BiddingSchemeTypeMaxConversionValue:
+		return decodeBiddingScheme[MaxConversionValueBiddingScheme](d, start)
+	case BiddingSchemeTypeTargetImpressionShare:
+		return decodeBiddingScheme[TargetImpressionShareBiddingScheme](d, start)
+	default:
+		unknown, err := decodeBiddingScheme[UnknownBiddingScheme](d, start)
+		if err != nil {
+			return nil, err
+		}
+		scheme := unknown.(UnknownBiddingScheme)
+		scheme.TypeName = typeName
+		return scheme, nil
+	}
+}
+
+// decodeBiddingScheme 将元素解码为指定类型的出价策略
+func decodeBiddingScheme[T BiddingScheme](d *xml.Decoder, start xml.StartElement) (BiddingScheme, error) {
+	var scheme T
+	if err := d.DecodeElement(&scheme, &start); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
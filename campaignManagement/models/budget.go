@@ -0,0 +1,103 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// BudgetLimitType 表示预算的花费方式
+type BudgetLimitType string
+
+const (
+	BudgetLimitTypeDailyBudgetAccelerated BudgetLimitType = "DailyBudgetAccelerated"
+	BudgetLimitTypeDailyBudgetStandard    BudgetLimitType = "DailyBudgetStandard"
+)
+
+// Budget 表示可以被多个活动共享的预算
+type Budget struct {
+	Amount           base.Nillable[float64]         `xml:"Amount"`
+	AssociationCount base.Nillable[int]             `xml:"AssociationCount"`
+	BudgetType       base.Nillable[BudgetLimitType] `xml:"BudgetType"`
+	Id               base.Nillable[int64]           `xml:"Id"`
+	Name             base.Nillable[string]          `xml:"Name"`
+}
+
+// IdCollection 表示一组 ID
+type IdCollection struct {
+	Ids []int64 `xml:"Ids>long,omitempty"`
+}
+
+// GetBudgetsByIdsRequest 请求结构体
+type GetBudgetsByIdsRequest struct {
+	XMLName   xml.Name         `xml:"GetBudgetsByIdsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	BudgetIds common.LongArray `xml:"BudgetIds"`
+}
+
+// GetBudgetsByIdsResponse 响应结构体
+type GetBudgetsByIdsResponse struct {
+	XMLName       xml.Name     `xml:"GetBudgetsByIdsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	Budgets       []Budget     `xml:"Budgets>Budget,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// AddBudgetsRequest 请求结构体
+type AddBudgetsRequest struct {
+	XMLName   xml.Name `xml:"AddBudgetsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	Budgets   []Budget `xml:"Budgets>Budget"`
+}
+
+// AddBudgetsResponse 响应结构体
+type AddBudgetsResponse struct {
+	XMLName       xml.Name     `xml:"AddBudgetsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	BudgetIds     []int64      `xml:"BudgetIds>long,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UpdateBudgetsRequest 请求结构体
+type UpdateBudgetsRequest struct {
+	XMLName   xml.Name `xml:"UpdateBudgetsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	Budgets   []Budget `xml:"Budgets>Budget"`
+}
+
+// UpdateBudgetsResponse 响应结构体
+type UpdateBudgetsResponse struct {
+	XMLName       xml.Name     `xml:"UpdateBudgetsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteBudgetsRequest 请求结构体
+type DeleteBudgetsRequest struct {
+	XMLName   xml.Name         `xml:"DeleteBudgetsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	BudgetIds common.LongArray `xml:"BudgetIds"`
+}
+
+// DeleteBudgetsResponse 响应结构体
+type DeleteBudgetsResponse struct {
+	XMLName       xml.Name     `xml:"DeleteBudgetsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetCampaignIdsByBudgetIdsRequest 请求结构体
+type GetCampaignIdsByBudgetIdsRequest struct {
+	XMLName   xml.Name         `xml:"GetCampaignIdsByBudgetIdsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	BudgetIds common.LongArray `xml:"BudgetIds"`
+}
+
+// GetCampaignIdsByBudgetIdsResponse 响应结构体
+type GetCampaignIdsByBudgetIdsResponse struct {
+	XMLName              xml.Name       `xml:"GetCampaignIdsByBudgetIdsResponse"`
+	Namespace            string         `xml:"xmlns,attr"`
+	CampaignIdCollection []IdCollection `xml:"CampaignIdCollection>IdCollection,omitempty"`
+	PartialErrors        []BatchError   `xml:"PartialErrors>BatchError,omitempty"`
+}
@@ -0,0 +1,257 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// ChangeAction 表示变更集中一项修改的操作
+type ChangeAction string
+
+const (
+	ChangeActionCreate ChangeAction = "create"
+	ChangeActionDelete ChangeAction = "delete"
+)
+
+// ChangeResource 表示变更集中一项修改的资源类型
+type ChangeResource string
+
+const (
+	ChangeResourceSharedListItem          ChangeResource = "shared_list_item"
+	ChangeResourceSharedEntityAssociation ChangeResource = "shared_entity_association"
+)
+
+// ChangeSharedList 标识共享列表项修改所属的共享列表
+type ChangeSharedList struct {
+	Id   int64            `json:"id"`
+	Type SharedEntityType `json:"type"`
+}
+
+// AsSharedList 返回设置好 i:type 的 SharedList
+func (l ChangeSharedList) AsSharedList() SharedList {
+	return SharedList{SharedEntity: SharedEntity{Id: base.Int64(l.Id)}, ItemType: string(l.Type)}
+}
+
+// Change 表示变更集中的一项修改
+type Change struct {
+	Action   ChangeAction   `json:"action"`
+	Resource ChangeResource `json:"resource"`
+	Scope    EntityScope    `json:"scope,omitempty"`
+
+	// 共享列表项修改使用的字段
+	SharedList *ChangeSharedList `json:"shared_list,omitempty"`
+	ListItem   *ListItemRecord   `json:"list_item,omitempty"`
+
+	// 共享实体关联修改使用的字段
+	Association *AssociationRecord `json:"association,omitempty"`
+}
+
+// Validate 检查修改是否完整
+func (c Change) Validate() error {
+	if c.Action != ChangeActionCreate && c.Action != ChangeActionDelete {
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("未知的操作 %q", c.Action), nil)
+	}
+
+	switch c.Resource {
+	case ChangeResourceSharedListItem:
+		if c.SharedList == nil || c.SharedList.Id == 0 || c.ListItem == nil {
+			return base.NewError(base.ErrInvalidInput, "共享列表项修改缺少 shared_list 或 list_item", nil)
+		}
+		if !c.SharedList.Type.Valid() {
+			return base.NewError(base.ErrInvalidInput, fmt.Sprintf("未知的共享列表类型 %q", c.SharedList.Type), nil)
+		}
+		if c.Action == ChangeActionDelete && c.ListItem.Id == 0 {
+			return base.NewError(base.ErrInvalidInput, "删除共享列表项时必须设置 id", nil)
+		}
+		if _, err := SharedListItemKey(c.ListItem.SharedListItem()); err != nil {
+			return err
+		}
+	case ChangeResourceSharedEntityAssociation:
+		if c.Association == nil || c.Association.EntityId == 0 || c.Association.SharedEntityId == 0 {
+			return base.NewError(base.ErrInvalidInput, "共享实体关联修改缺少 association", nil)
+		}
+		if !c.Association.SharedEntityType.Valid() {
+			return base.NewError(base.ErrInvalidInput, fmt.Sprintf("未知的共享实体类型 %q", c.Association.SharedEntityType), nil)
+		}
+	default:
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("未知的资源类型 %q", c.Resource), nil)
+	}
+	return nil
+}
+
+// String 返回修改的单行描述，缺少 list_item 或 association 时只输出资源类型
+func (c Change) String() string {
+	symbol := "+"
+	if c.Action == ChangeActionDelete {
+		symbol = "-"
+	}
+
+	switch {
+	case c.Resource == ChangeResourceSharedListItem && c.ListItem != nil:
+		desc := describeListItem(c.ListItem.SharedListItem())
+		if c.Action == ChangeActionDelete {
+			desc += fmt.Sprintf(" (Id %d)", c.ListItem.Id)
+		}
+		return symbol + " " + desc
+	case c.Resource == ChangeResourceSharedEntityAssociation && c.Association != nil:
+		a := c.Association
+		return fmt.Sprintf("%s %s %d <-> %s %d", symbol, a.EntityType, a.EntityId, a.SharedEntityType, a.SharedEntityId)
+	default:
+		return fmt.Sprintf("%s %s", symbol, c.Resource)
+	}
+}
+
+// ChangeSet 收集待执行的修改，用于生成可审阅的计划并在确认后执行
+//
+// 变更集可以通过 encoding/json 序列化保存，审阅通过后再用 ParseChangeSet 读回执行。
+type ChangeSet struct {
+	Changes []Change `json:"changes"`
+}
+
+// AddListItems 计划向共享列表添加项目
+func (cs *ChangeSet) AddListItems(list ChangeSharedList, scope EntityScope, items ...SharedListItem) {
+	cs.addListItems(ChangeActionCreate, list, scope, items)
+}
+
+// DeleteListItems 计划从共享列表删除项目，项目必须带有 ID
+func (cs *ChangeSet) DeleteListItems(list ChangeSharedList, scope EntityScope, items ...SharedListItem) {
+	cs.addListItems(ChangeActionDelete, list, scope, items)
+}
+
+// addListItems 添加共享列表项修改
+func (cs *ChangeSet) addListItems(action ChangeAction, list ChangeSharedList, scope EntityScope, items []SharedListItem) {
+	for _, item := range items {
+		record := NewListItemRecord(item)
+		l := list
+		cs.Changes = append(cs.Changes, Change{
+			Action:     action,
+			Resource:   ChangeResourceSharedListItem,
+			Scope:      scope,
+			SharedList: &l,
+			ListItem:   &record,
+		})
+	}
+}
+
+// AddSyncPlan 将共享列表同步计划加入变更集，plan 为 nil 时不做任何修改
+func (cs *ChangeSet) AddSyncPlan(list ChangeSharedList, scope EntityScope, plan *SharedListSyncPlan) {
+	if plan == nil {
+		return
+	}
+	cs.DeleteListItems(list, scope, plan.Delete...)
+	cs.AddListItems(list, scope, plan.Add...)
+}
+
+// SetAssociations 计划将共享实体关联到活动或账户
+func (cs *ChangeSet) SetAssociations(scope EntityScope, associations ...SharedEntityAssociation) {
+	cs.addAssociations(ChangeActionCreate, scope, associations)
+}
+
+// DeleteAssociations 计划删除共享实体关联
+func (cs *ChangeSet) DeleteAssociations(scope EntityScope, associations ...SharedEntityAssociation) {
+	cs.addAssociations(ChangeActionDelete, scope, associations)
+}
+
+// addAssociations 添加共享实体关联修改
+func (cs *ChangeSet) addAssociations(action ChangeAction, scope EntityScope, associations []SharedEntityAssociation) {
+	for _, association := range associations {
+		a := NewAssociationRecord(association)
+		cs.Changes = append(cs.Changes, Change{
+			Action:      action,
+			Resource:    ChangeResourceSharedEntityAssociation,
+			Scope:       scope,
+			Association: &a,
+		})
+	}
+}
+
+// Validate 检查所有修改是否完整，返回的错误中包含修改的位置
+func (cs *ChangeSet) Validate() error {
+	for i, change := range cs.Changes {
+		if err := change.Validate(); err != nil {
+			return base.NewError(base.ErrInvalidInput, fmt.Sprintf("第 %d 项修改无效", i), err)
+		}
+	}
+	return nil
+}
+
+// Diff 返回类似 terraform plan 的文本计划
+//
+// 修改按共享列表和关联分组，组内按加入顺序列出，最后一行为汇总。未经 Validate 检查的
+// 变更集也可以输出，缺少的字段不会导致 panic。
+func (cs *ChangeSet) Diff() string {
+	var (
+		b      strings.Builder
+		groups []string
+		lines  = make(map[string][]string)
+	)
+	var creates, deletes int
+	for _, change := range cs.Changes {
+		header := "共享实体关联"
+		if change.Resource == ChangeResourceSharedListItem {
+			header = "共享列表"
+			if change.SharedList != nil {
+				header = fmt.Sprintf("共享列表 %s %d", change.SharedList.Type, change.SharedList.Id)
+			}
+		}
+		if change.Scope != "" {
+			header += fmt.Sprintf(" (%s)", change.Scope)
+		}
+		if _, ok := lines[header]; !ok {
+			groups = append(groups, header)
+		}
+		lines[header] = append(lines[header], change.String())
+
+		if change.Action == ChangeActionDelete {
+			deletes++
+		} else {
+			creates++
+		}
+	}
+
+	for _, header := range groups {
+		fmt.Fprintf(&b, "# %s\n", header)
+		for _, line := range lines[header] {
+			fmt.Fprintf(&b, "  %s\n", line)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "计划：添加 %d 项，删除 %d 项。\n", creates, deletes)
+	return b.String()
+}
+
+// ParseChangeSet 从 JSON 读取变更集并检查其有效性
+func ParseChangeSet(data []byte) (*ChangeSet, error) {
+	var cs ChangeSet
+	if err := json.Unmarshal(data, &cs); err != nil {
+		return nil, base.NewError(base.ErrDeserializationFail, "解析变更集失败", err)
+	}
+	if err := cs.Validate(); err != nil {
+		return nil, err
+	}
+	return &cs, nil
+}
+
+// ChangeSetResult 表示执行变更集的结果
+type ChangeSetResult struct {
+	// Ids 与 Changes 一一对应，为新增共享列表项的 ID，其余修改为 0
+	Ids []int64
+	// Errors 为执行失败的修改，Index 为 Changes 中的位置
+	Errors []BatchError
+	// NotApplied 为因请求失败而没有执行的修改在 Changes 中的位置
+	NotApplied []int
+}
+
+// ChangeErrors 返回第 index 项修改的错误
+func (r *ChangeSetResult) ChangeErrors(index int) []BatchError {
+	var errs []BatchError
+	for _, e := range r.Errors {
+		if e.Index == index {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
@@ -8,6 +8,58 @@ const (
 	SOAPActionGetSharedEntityAssociationsBySharedEntityIds SOAPAction = "GetSharedEntityAssociationsBySharedEntityIds"
 	SOAPActionAddListItemsToSharedList                     SOAPAction = "AddListItemsToSharedList"
 	SOAPActionDeleteListItemsFromSharedList                SOAPAction = "DeleteListItemsFromSharedList"
+	SOAPActionGetBudgetsByIds                              SOAPAction = "GetBudgetsByIds"
+	SOAPActionAddBudgets                                   SOAPAction = "AddBudgets"
+	SOAPActionUpdateBudgets                                SOAPAction = "UpdateBudgets"
+	SOAPActionDeleteBudgets                                SOAPAction = "DeleteBudgets"
+	SOAPActionGetCampaignIdsByBudgetIds                    SOAPAction = "GetCampaignIdsByBudgetIds"
+	SOAPActionGetBidStrategiesByIds                        SOAPAction = "GetBidStrategiesByIds"
+	SOAPActionAddBidStrategies                             SOAPAction = "AddBidStrategies"
+	SOAPActionUpdateBidStrategies                          SOAPAction = "UpdateBidStrategies"
+	SOAPActionDeleteBidStrategies                          SOAPAction = "DeleteBidStrategies"
+	SOAPActionGetCampaignIdsByBidStrategyIds               SOAPAction = "GetCampaignIdsByBidStrategyIds"
+	SOAPActionAddLabels                                    SOAPAction = "AddLabels"
+	SOAPActionUpdateLabels                                 SOAPAction = "UpdateLabels"
+	SOAPActionDeleteLabels                                 SOAPAction = "DeleteLabels"
+	SOAPActionGetLabelsByIds                               SOAPAction = "GetLabelsByIds"
+	SOAPActionSetLabelAssociations                         SOAPAction = "SetLabelAssociations"
+	SOAPActionDeleteLabelAssociations                      SOAPAction = "DeleteLabelAssociations"
+	SOAPActionGetLabelAssociationsByEntityIds              SOAPAction = "GetLabelAssociationsByEntityIds"
+	SOAPActionGetLabelAssociationsByLabelIds               SOAPAction = "GetLabelAssociationsByLabelIds"
+	SOAPActionGetAudiencesByIds                            SOAPAction = "GetAudiencesByIds"
+	SOAPActionAddAudiences                                 SOAPAction = "AddAudiences"
+	SOAPActionUpdateAudiences                              SOAPAction = "UpdateAudiences"
+	SOAPActionDeleteAudiences                              SOAPAction = "DeleteAudiences"
+	SOAPActionApplyCustomerListItems                       SOAPAction = "ApplyCustomerListItems"
+	SOAPActionAddUetTags                                   SOAPAction = "AddUetTags"
+	SOAPActionGetUetTagsByIds                              SOAPAction = "GetUetTagsByIds"
+	SOAPActionUpdateUetTags                                SOAPAction = "UpdateUetTags"
+	SOAPActionAddConversionGoals                           SOAPAction = "AddConversionGoals"
+	SOAPActionGetConversionGoalsByIds                      SOAPAction = "GetConversionGoalsByIds"
+	SOAPActionUpdateConversionGoals                        SOAPAction = "UpdateConversionGoals"
+	SOAPActionGetConversionGoalsByTagIds                   SOAPAction = "GetConversionGoalsByTagIds"
+	SOAPActionApplyOfflineConversions                      SOAPAction = "ApplyOfflineConversions"
+	SOAPActionApplyOnlineConversionAdjustments             SOAPAction = "ApplyOnlineConversionAdjustments"
+	SOAPActionAddAdExtensions                              SOAPAction = "AddAdExtensions"
+	SOAPActionGetAdExtensionsByIds                         SOAPAction = "GetAdExtensionsByIds"
+	SOAPActionGetAdExtensionIdsByAccountId                 SOAPAction = "GetAdExtensionIdsByAccountId"
+	SOAPActionUpdateAdExtensions                           SOAPAction = "UpdateAdExtensions"
+	SOAPActionDeleteAdExtensions                           SOAPAction = "DeleteAdExtensions"
+	SOAPActionSetAdExtensionsAssociations                  SOAPAction = "SetAdExtensionsAssociations"
+	SOAPActionGetAdExtensionsAssociations                  SOAPAction = "GetAdExtensionsAssociations"
+	SOAPActionAddMedia                                     SOAPAction = "AddMedia"
+	SOAPActionGetMediaMetaDataByAccountId                  SOAPAction = "GetMediaMetaDataByAccountId"
+	SOAPActionGetNegativeKeywordsByEntityIds               SOAPAction = "GetNegativeKeywordsByEntityIds"
+	SOAPActionAddNegativeKeywordsToEntities                SOAPAction = "AddNegativeKeywordsToEntities"
+	SOAPActionDeleteNegativeKeywordsFromEntities           SOAPAction = "DeleteNegativeKeywordsFromEntities"
+	SOAPActionAddExperiments                               SOAPAction = "AddExperiments"
+	SOAPActionGetExperimentsByIds                          SOAPAction = "GetExperimentsByIds"
+	SOAPActionUpdateExperiments                            SOAPAction = "UpdateExperiments"
+	SOAPActionDeleteExperiments                            SOAPAction = "DeleteExperiments"
+	SOAPActionGetAdGroupCriterionsByIds                    SOAPAction = "GetAdGroupCriterionsByIds"
+	SOAPActionApplyProductPartitionActions                 SOAPAction = "ApplyProductPartitionActions"
+	SOAPActionSetSharedEntityAssociations                  SOAPAction = "SetSharedEntityAssociations"
+	SOAPActionDeleteSharedEntityAssociations               SOAPAction = "DeleteSharedEntityAssociations"
 )
 
 type EntityScope string
@@ -25,3 +77,63 @@ const (
 	SharedListItemTypeNegativeKeyword SharedListItemType = "NegativeKeyword"
 	SharedListItemTypeBrandItem       SharedListItemType = "BrandItem"
 )
+
+// API 单次调用的数量限制
+const (
+	// 单次 AddListItemsToSharedList / DeleteListItemsFromSharedList 调用最多的列表项数
+	MaxListItemsPerCall = 5000
+
+	// 单次预算相关调用最多的预算数
+	MaxBudgetsPerCall = 100
+
+	// 单次出价策略相关调用最多的出价策略数
+	MaxBidStrategiesPerCall = 100
+
+	// 单次标签相关调用最多的标签数
+	MaxLabelsPerCall = 100
+
+	// 单次 SetLabelAssociations / DeleteLabelAssociations 调用最多的关联数
+	MaxLabelAssociationsPerCall = 10000
+
+	// 单次受众相关调用最多的受众数
+	MaxAudiencesPerCall = 100
+
+	// 单次 ApplyCustomerListItems 调用最多的客户列表项数
+	MaxCustomerListItemsPerCall = 5000
+
+	// 单次 UET 标签相关调用最多的标签数
+	MaxUetTagsPerCall = 100
+
+	// 单次转化目标相关调用最多的转化目标数
+	MaxConversionGoalsPerCall = 100
+
+	// 单次 ApplyOfflineConversions 调用最多的离线转化数
+	MaxOfflineConversionsPerCall = 1000
+
+	// 单次 ApplyOnlineConversionAdjustments 调用最多的转化调整数
+	MaxOnlineConversionAdjustmentsPerCall = 1000
+
+	// 单个活动或广告组在一次调用中最多添加或删除的负面关键词数
+	MaxNegativeKeywordsPerEntity = 20000
+
+	// 单次附加信息相关调用最多的附加信息数或关联数
+	MaxAdExtensionsPerCall = 100
+
+	// 单次 AddMedia 调用最多的媒体数
+	MaxMediaPerCall = 10
+
+	// GetMediaMetaDataByAccountId 每页最多的结果数
+	MaxMediaMetaDataPageSize = 100
+
+	// 单次设置或删除共享实体关联时最多的关联数
+	MaxSharedEntityAssociationsPerCall = 100
+
+	// 单次实验相关调用最多的实验数
+	MaxExperimentsPerCall = 100
+
+	// 单次 ApplyProductPartitionActions 调用最多的操作数
+	MaxProductPartitionActionsPerCall = 5000
+
+	// 分页查询时每页最多的结果数
+	MaxPageSize = 1000
+)
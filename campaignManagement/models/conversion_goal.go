@@ -0,0 +1,438 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// ConversionGoalType 表示转化目标的类型，GetConversionGoalsByIds 使用该值筛选转化目标
+type ConversionGoalType string
+
+const (
+	ConversionGoalTypeUrl                 ConversionGoalType = "Url"
+	ConversionGoalTypeDuration            ConversionGoalType = "Duration"
+	ConversionGoalTypePagesViewedPerVisit ConversionGoalType = "PagesViewedPerVisit"
+	ConversionGoalTypeEvent               ConversionGoalType = "Event"
+	ConversionGoalTypeAppInstall          ConversionGoalType = "AppInstall"
+	ConversionGoalTypeOfflineConversion   ConversionGoalType = "OfflineConversion"
+)
+
+// ConversionGoalTypes 是未指定类型时查询的转化目标类型
+var ConversionGoalTypes = []ConversionGoalType{
+	ConversionGoalTypeUrl,
+	ConversionGoalTypeDuration,
+	ConversionGoalTypePagesViewedPerVisit,
+	ConversionGoalTypeEvent,
+	ConversionGoalTypeAppInstall,
+	ConversionGoalTypeOfflineConversion,
+}
+
+// ConversionGoalXSIType 表示转化目标的 i:type 类型
+type ConversionGoalXSIType string
+
+const (
+	ConversionGoalXSITypeUrl                 ConversionGoalXSIType = "UrlGoal"
+	ConversionGoalXSITypeDuration            ConversionGoalXSIType = "DurationGoal"
+	ConversionGoalXSITypePagesViewedPerVisit ConversionGoalXSIType = "PagesViewedPerVisitGoal"
+	ConversionGoalXSITypeEvent               ConversionGoalXSIType = "EventGoal"
+	ConversionGoalXSITypeAppInstall          ConversionGoalXSIType = "AppInstallGoal"
+	ConversionGoalXSITypeOfflineConversion   ConversionGoalXSIType = "OfflineConversionGoal"
+)
+
+// ConversionGoalCountType 表示一次点击后的多次转化如何计数
+type ConversionGoalCountType string
+
+const (
+	ConversionGoalCountTypeAll    ConversionGoalCountType = "All"
+	ConversionGoalCountTypeUnique ConversionGoalCountType = "Unique"
+)
+
+// ConversionGoalStatus 表示转化目标的状态
+type ConversionGoalStatus string
+
+const (
+	ConversionGoalStatusActive   ConversionGoalStatus = "Active"
+	ConversionGoalStatusPaused   ConversionGoalStatus = "Paused"
+	ConversionGoalStatusDeleted  ConversionGoalStatus = "Deleted"
+	ConversionGoalStatusInActive ConversionGoalStatus = "InActive"
+)
+
+// ConversionGoalRevenueType 表示转化收入的计算方式
+type ConversionGoalRevenueType string
+
+const (
+	ConversionGoalRevenueTypeFixedValue    ConversionGoalRevenueType = "FixedValue"
+	ConversionGoalRevenueTypeVariableValue ConversionGoalRevenueType = "VariableValue"
+	ConversionGoalRevenueTypeNoValue       ConversionGoalRevenueType = "NoValue"
+)
+
+// ExpressionOperator 表示转化目标表达式的匹配方式
+type ExpressionOperator string
+
+const (
+	ExpressionOperatorEquals            ExpressionOperator = "Equals"
+	ExpressionOperatorBeginsWith        ExpressionOperator = "BeginsWith"
+	ExpressionOperatorRegularExpression ExpressionOperator = "RegularExpression"
+	ExpressionOperatorContains          ExpressionOperator = "Contains"
+)
+
+// ValueOperator 表示事件目标中事件值的比较方式
+type ValueOperator string
+
+const (
+	ValueOperatorEquals      ValueOperator = "Equals"
+	ValueOperatorLessThan    ValueOperator = "LessThan"
+	ValueOperatorGreaterThan ValueOperator = "GreaterThan"
+)
+
+// AppPlatform 表示应用安装目标的平台
+type AppPlatform string
+
+const (
+	AppPlatformAndroid AppPlatform = "Android"
+	AppPlatformIOS     AppPlatform = "iOS"
+)
+
+// ConversionGoalRevenue 表示转化收入
+type ConversionGoalRevenue struct {
+	CurrencyCode base.Nillable[string]                    `xml:"CurrencyCode"`
+	Type         base.Nillable[ConversionGoalRevenueType] `xml:"Type"`
+	Value        base.Nillable[float64]                   `xml:"Value"`
+}
+
+// ConversionGoal 表示一个具体类型的转化目标
+//
+// 该接口是封闭的，只能由本包中的 *Goal 类型和 UnknownConversionGoal 实现。
+type ConversionGoal interface {
+	// ConversionGoalXSIType 返回转化目标的 i:type 类型
+	ConversionGoalXSIType() ConversionGoalXSIType
+
+	isConversionGoal()
+}
+
+// ConversionGoalBase 转化目标的公共字段，字段顺序与 WSDL 保持一致
+type ConversionGoalBase struct {
+	ConversionWindowInMinutes base.Nillable[int]                     `xml:"ConversionWindowInMinutes"`
+	CountType                 base.Nillable[ConversionGoalCountType] `xml:"CountType"`
+	ExcludeFromBidding        base.Nillable[bool]                    `xml:"ExcludeFromBidding"`
+	GoalCategory              base.Nillable[string]                  `xml:"GoalCategory"`
+	Id                        base.Nillable[int64]                   `xml:"Id"`
+	Name                      base.Nillable[string]                  `xml:"Name"`
+	Revenue                   *ConversionGoalRevenue                 `xml:"Revenue,omitempty"`
+	Scope                     base.Nillable[EntityScope]             `xml:"Scope"`
+	Status                    base.Nillable[ConversionGoalStatus]    `xml:"Status"`
+	TagId                     base.Nillable[int64]                   `xml:"TagId"`
+	// TrackingStatus 和 Type 由服务端返回，添加或更新时不需要设置
+	TrackingStatus                       base.Nillable[string]             `xml:"TrackingStatus"`
+	Type                                 base.Nillable[ConversionGoalType] `xml:"Type"`
+	ViewThroughConversionWindowInMinutes base.Nillable[int]                `xml:"ViewThroughConversionWindowInMinutes"`
+}
+
+// UrlGoal 表示访问指定网址即计为转化的目标
+type UrlGoal struct {
+	ConversionGoalBase
+	UrlExpression base.Nillable[string]             `xml:"UrlExpression"`
+	UrlOperator   base.Nillable[ExpressionOperator] `xml:"UrlOperator"`
+}
+
+// DurationGoal 表示访问时长达到指定秒数即计为转化的目标
+type DurationGoal struct {
+	ConversionGoalBase
+	MinimumDurationInSeconds base.Nillable[int] `xml:"MinimumDurationInSeconds"`
+}
+
+// PagesViewedPerVisitGoal 表示单次访问浏览页数达到指定数量即计为转化的目标
+type PagesViewedPerVisitGoal struct {
+	ConversionGoalBase
+	MinimumPagesViewed base.Nillable[int] `xml:"MinimumPagesViewed"`
+}
+
+// EventGoal 表示触发指定自定义事件即计为转化的目标，未设置的条件不参与匹配
+type EventGoal struct {
+	ConversionGoalBase
+	ActionExpression   base.Nillable[string]             `xml:"ActionExpression"`
+	ActionOperator     base.Nillable[ExpressionOperator] `xml:"ActionOperator"`
+	CategoryExpression base.Nillable[string]             `xml:"CategoryExpression"`
+	CategoryOperator   base.Nillable[ExpressionOperator] `xml:"CategoryOperator"`
+	LabelExpression    base.Nillable[string]             `xml:"LabelExpression"`
+	LabelOperator      base.Nillable[ExpressionOperator] `xml:"LabelOperator"`
+	Value              base.Nillable[float64]            `xml:"Value"`
+	ValueOperator      base.Nillable[ValueOperator]      `xml:"ValueOperator"`
+}
+
+// AppInstallGoal 表示应用安装目标
+type AppInstallGoal struct {
+	ConversionGoalBase
+	AppPlatform base.Nillable[AppPlatform] `xml:"AppPlatform"`
+	AppStoreId  base.Nillable[string]      `xml:"AppStoreId"`
+}
+
+// OfflineConversionGoal 表示通过 ApplyOfflineConversions 上报转化的离线转化目标
+type OfflineConversionGoal struct {
+	ConversionGoalBase
+}
+
+// UnknownConversionGoal 保存无法识别类型的转化目标的原始 XML，用于向前兼容
+type UnknownConversionGoal struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (UrlGoal) isConversionGoal()                 {}
+func (DurationGoal) isConversionGoal()            {}
+func (PagesViewedPerVisitGoal) isConversionGoal() {}
+func (EventGoal) isConversionGoal()               {}
+func (AppInstallGoal) isConversionGoal()          {}
+func (OfflineConversionGoal) isConversionGoal()   {}
+func (UnknownConversionGoal) isConversionGoal()   {}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (UrlGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSITypeUrl
+}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (DurationGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSITypeDuration
+}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (PagesViewedPerVisitGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSITypePagesViewedPerVisit
+}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (EventGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSITypeEvent
+}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (AppInstallGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSITypeAppInstall
+}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (OfflineConversionGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSITypeOfflineConversion
+}
+
+// ConversionGoalXSIType 返回转化目标的 i:type 类型
+func (g UnknownConversionGoal) ConversionGoalXSIType() ConversionGoalXSIType {
+	return ConversionGoalXSIType(g.TypeName)
+}
+
+// MarshalXML 自定义 UrlGoal 的 XML 序列化
+func (g UrlGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type goal UrlGoal
+	return encodeConversionGoal(e, start, g.ConversionGoalXSIType(), goal(g))
+}
+
+// MarshalXML 自定义 DurationGoal 的 XML 序列化
+func (g DurationGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type goal DurationGoal
+	return encodeConversionGoal(e, start, g.ConversionGoalXSIType(), goal(g))
+}
+
+// MarshalXML 自定义 PagesViewedPerVisitGoal 的 XML 序列化
+func (g PagesViewedPerVisitGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type goal PagesViewedPerVisitGoal
+	return encodeConversionGoal(e, start, g.ConversionGoalXSIType(), goal(g))
+}
+
+// MarshalXML 自定义 EventGoal 的 XML 序列化
+func (g EventGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type goal EventGoal
+	return encodeConversionGoal(e, start, g.ConversionGoalXSIType(), goal(g))
+}
+
+// MarshalXML 自定义 AppInstallGoal 的 XML 序列化
+func (g AppInstallGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type goal AppInstallGoal
+	return encodeConversionGoal(e, start, g.ConversionGoalXSIType(), goal(g))
+}
+
+// MarshalXML 自定义 OfflineConversionGoal 的 XML 序列化
+func (g OfflineConversionGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type goal OfflineConversionGoal
+	return encodeConversionGoal(e, start, g.ConversionGoalXSIType(), goal(g))
+}
+
+// MarshalXML 自定义 UnknownConversionGoal 的 XML 序列化，原样输出保存的 XML
+func (g UnknownConversionGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if g.TypeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: g.TypeName})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := common.CopyRawXML(e, g.InnerXML); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeConversionGoal 编码转化目标并加上 i:type 属性
+func encodeConversionGoal(e *xml.Encoder, start xml.StartElement, typeName ConversionGoalXSIType, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: string(typeName)})
+	return e.EncodeElement(v, start)
+}
+
+// DecodeConversionGoal 根据 i:type 属性将 ConversionGoal 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownConversionGoal，元素为 i:nil 时返回 nil。
+func DecodeConversionGoal(d *xml.Decoder, start xml.StartElement) (ConversionGoal, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); ConversionGoalXSIType(typeName) {
+	case ConversionGoalXSITypeUrl:
+		return decodeConversionGoal[UrlGoal](d, start)
+	case ConversionGoalXSITypeDuration:
+		return decodeConversionGoal[DurationGoal](d, start)
+	case ConversionGoalXSITypePagesViewedPerVisit:
+		return decodeConversionGoal[PagesViewedPerVisitGoal](d, start)
+	case ConversionGoalXSITypeEvent:
+		return decodeConversionGoal[EventGoal](d, start)
+	case ConversionGoalXSITypeAppInstall:
+		return decodeConversionGoal[AppInstallGoal](d, start)
+	case ConversionGoalXSITypeOfflineConversion:
+		return decodeConversionGoal[OfflineConversionGoal](d, start)
+	default:
+		unknown, err := decodeConversionGoal[UnknownConversionGoal](d, start)
+		if err != nil {
+			return nil, err
+		}
+		goal := unknown.(UnknownConversionGoal)
+		goal.TypeName = typeName
+		return goal, nil
+	}
+}
+
+// decodeConversionGoal 将元素解码为指定类型的转化目标
+func decodeConversionGoal[T ConversionGoal](d *xml.Decoder, start xml.StartElement) (ConversionGoal, error) {
+	var goal T
+	if err := d.DecodeElement(&goal, &start); err != nil {
+		return nil, err
+	}
+	return goal, nil
+}
+
+// conversionGoalElement 用于在结构体字段中解码多态的 ConversionGoal
+type conversionGoalElement struct {
+	goal ConversionGoal
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *conversionGoalElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	goal, err := DecodeConversionGoal(d, start)
+	if err != nil {
+		return err
+	}
+	el.goal = goal
+	return nil
+}
+
+// conversionGoalsResponse 用于解码包含多态转化目标列表的响应
+type conversionGoalsResponse struct {
+	ConversionGoals []conversionGoalElement `xml:"ConversionGoals>ConversionGoal"`
+	PartialErrors   []BatchError            `xml:"PartialErrors>BatchError"`
+}
+
+// goals 返回解码后的转化目标，i:nil 的元素保留为 nil 以便与请求的 ID 一一对应
+func (r conversionGoalsResponse) goals() []ConversionGoal {
+	goals := make([]ConversionGoal, len(r.ConversionGoals))
+	for i, el := range r.ConversionGoals {
+		goals[i] = el.goal
+	}
+	return goals
+}
+
+// AddConversionGoalsRequest 请求结构体
+type AddConversionGoalsRequest struct {
+	XMLName         xml.Name         `xml:"AddConversionGoalsRequest"`
+	Namespace       string           `xml:"xmlns,attr"`
+	ConversionGoals []ConversionGoal `xml:"ConversionGoals>ConversionGoal"`
+}
+
+// AddConversionGoalsResponse 响应结构体
+type AddConversionGoalsResponse struct {
+	XMLName           xml.Name     `xml:"AddConversionGoalsResponse"`
+	Namespace         string       `xml:"xmlns,attr"`
+	ConversionGoalIds []int64      `xml:"ConversionGoalIds>long,omitempty"`
+	PartialErrors     []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetConversionGoalsByIdsRequest 请求结构体
+type GetConversionGoalsByIdsRequest struct {
+	XMLName           xml.Name         `xml:"GetConversionGoalsByIdsRequest"`
+	Namespace         string           `xml:"xmlns,attr"`
+	ConversionGoalIds common.LongArray `xml:"ConversionGoalIds"`
+	// 以空格分隔的转化目标类型
+	ConversionGoalTypes string `xml:"ConversionGoalTypes"`
+}
+
+// GetConversionGoalsByIdsResponse 响应结构体
+type GetConversionGoalsByIdsResponse struct {
+	XMLName         xml.Name         `xml:"GetConversionGoalsByIdsResponse"`
+	Namespace       string           `xml:"xmlns,attr"`
+	ConversionGoals []ConversionGoal `xml:"-"`
+	PartialErrors   []BatchError     `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UnmarshalXML 自定义 GetConversionGoalsByIdsResponse 的 XML 反序列化，按 i:type 解码转化目标
+func (r *GetConversionGoalsByIdsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v conversionGoalsResponse
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	r.XMLName = start.Name
+	r.ConversionGoals = v.goals()
+	r.PartialErrors = v.PartialErrors
+	return nil
+}
+
+// GetConversionGoalsByTagIdsRequest 请求结构体
+type GetConversionGoalsByTagIdsRequest struct {
+	XMLName   xml.Name         `xml:"GetConversionGoalsByTagIdsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	TagIds    common.LongArray `xml:"TagIds"`
+	// 以空格分隔的转化目标类型
+	ConversionGoalTypes string `xml:"ConversionGoalTypes"`
+}
+
+// GetConversionGoalsByTagIdsResponse 响应结构体
+type GetConversionGoalsByTagIdsResponse struct {
+	XMLName         xml.Name         `xml:"GetConversionGoalsByTagIdsResponse"`
+	Namespace       string           `xml:"xmlns,attr"`
+	ConversionGoals []ConversionGoal `xml:"-"`
+	PartialErrors   []BatchError     `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UnmarshalXML 自定义 GetConversionGoalsByTagIdsResponse 的 XML 反序列化，按 i:type 解码转化目标
+func (r *GetConversionGoalsByTagIdsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v conversionGoalsResponse
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	r.XMLName = start.Name
+	r.ConversionGoals = v.goals()
+	r.PartialErrors = v.PartialErrors
+	return nil
+}
+
+// UpdateConversionGoalsRequest 请求结构体
+type UpdateConversionGoalsRequest struct {
+	XMLName         xml.Name         `xml:"UpdateConversionGoalsRequest"`
+	Namespace       string           `xml:"xmlns,attr"`
+	ConversionGoals []ConversionGoal `xml:"ConversionGoals>ConversionGoal"`
+}
+
+// UpdateConversionGoalsResponse 响应结构体
+type UpdateConversionGoalsResponse struct {
+	XMLName       xml.Name     `xml:"UpdateConversionGoalsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// ExperimentStatus 表示实验的状态
+type ExperimentStatus string
+
+const (
+	ExperimentStatusActive    ExperimentStatus = "Active"
+	ExperimentStatusPaused    ExperimentStatus = "Paused"
+	ExperimentStatusScheduled ExperimentStatus = "Scheduled"
+	ExperimentStatusEnded     ExperimentStatus = "Ended"
+	ExperimentStatusGraduated ExperimentStatus = "Graduated"
+)
+
+// Experiment 表示活动实验，实验活动与基础活动按 TrafficSplitPercent 分配流量
+type Experiment struct {
+	// 基础活动 ID，添加后不能修改
+	BaseCampaignId base.Nillable[int64] `xml:"BaseCampaignId"`
+	EndDate        *Date                `xml:"EndDate,omitempty"`
+	// 实验活动 ID，由服务端在添加实验时创建
+	ExperimentCampaignId base.Nillable[int64]  `xml:"ExperimentCampaignId"`
+	ExperimentStatus     ExperimentStatus      `xml:"ExperimentStatus,omitempty"`
+	ExperimentType       base.Nillable[string] `xml:"ExperimentType"`
+	Id                   base.Nillable[int64]  `xml:"Id"`
+	Name                 base.Nillable[string] `xml:"Name"`
+	StartDate            *Date                 `xml:"StartDate,omitempty"`
+	// 分配给实验活动的流量百分比
+	TrafficSplitPercent base.Nillable[int] `xml:"TrafficSplitPercent"`
+}
+
+// AddExperimentsRequest 请求结构体
+type AddExperimentsRequest struct {
+	XMLName     xml.Name     `xml:"AddExperimentsRequest"`
+	Namespace   string       `xml:"xmlns,attr"`
+	Experiments []Experiment `xml:"Experiments>Experiment"`
+}
+
+// AddExperimentsResponse 响应结构体
+type AddExperimentsResponse struct {
+	XMLName       xml.Name     `xml:"AddExperimentsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	ExperimentIds []int64      `xml:"ExperimentIds>long,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetExperimentsByIdsRequest 请求结构体
+type GetExperimentsByIdsRequest struct {
+	XMLName       xml.Name         `xml:"GetExperimentsByIdsRequest"`
+	Namespace     string           `xml:"xmlns,attr"`
+	ExperimentIds common.LongArray `xml:"ExperimentIds"`
+	PageInfo      Paging           `xml:"PageInfo"`
+}
+
+// GetExperimentsByIdsResponse 响应结构体
+type GetExperimentsByIdsResponse struct {
+	XMLName       xml.Name     `xml:"GetExperimentsByIdsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	Experiments   []Experiment `xml:"Experiments>Experiment,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UpdateExperimentsRequest 请求结构体
+type UpdateExperimentsRequest struct {
+	XMLName     xml.Name     `xml:"UpdateExperimentsRequest"`
+	Namespace   string       `xml:"xmlns,attr"`
+	Experiments []Experiment `xml:"Experiments>Experiment"`
+}
+
+// UpdateExperimentsResponse 响应结构体
+type UpdateExperimentsResponse struct {
+	XMLName       xml.Name     `xml:"UpdateExperimentsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteExperimentsRequest 请求结构体
+type DeleteExperimentsRequest struct {
+	XMLName       xml.Name         `xml:"DeleteExperimentsRequest"`
+	Namespace     string           `xml:"xmlns,attr"`
+	ExperimentIds common.LongArray `xml:"ExperimentIds"`
+}
+
+// DeleteExperimentsResponse 响应结构体
+type DeleteExperimentsResponse struct {
+	XMLName       xml.Name     `xml:"DeleteExperimentsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
@@ -1,15 +1,60 @@
 package models
 
+import (
+	"context"
+
+	"github.com/vancevox/bingads-go/common"
+)
+
 // CampaignManagementAPI 定义Campaign Management API的操作
 type CampaignManagementAPI interface {
 	// SharedListService 返回共享列表服务
 	SharedListService() SharedListService
+
+	// BudgetService 返回共享预算服务
+	BudgetService() BudgetService
+
+	// BidStrategyService 返回组合出价策略服务
+	BidStrategyService() BidStrategyService
+
+	// LabelService 返回标签服务
+	LabelService() LabelService
+
+	// AudienceService 返回受众服务
+	AudienceService() AudienceService
+
+	// UetTagService 返回 UET 标签服务
+	UetTagService() UetTagService
+
+	// ConversionGoalService 返回转化目标服务
+	ConversionGoalService() ConversionGoalService
+
+	// AdExtensionService 返回附加信息服务
+	AdExtensionService() AdExtensionService
+
+	// MediaService 返回媒体服务
+	MediaService() MediaService
+
+	// NegativeKeywordService 返回活动和广告组负面关键词服务
+	NegativeKeywordService() NegativeKeywordService
+
+	// ExperimentService 返回活动实验服务
+	ExperimentService() ExperimentService
+
+	// AdGroupCriterionService 返回广告组条件服务
+	AdGroupCriterionService() AdGroupCriterionService
 }
 
 // SharedListService 定义共享列表相关的操作
+//
+// 共享列表参数由 any 改为 SharedListLike 并增加了新方法，这是不兼容的变更，
+// 自行实现该接口的类型需要同步更新，见 README 中的说明。
 type SharedListService interface {
 	// GetListItemsBySharedList 获取共享列表中的项目
-	GetListItemsBySharedList(sharedList any, scope EntityScope) ([]SharedListItem, error)
+	GetListItemsBySharedList(sharedList SharedListLike, scope EntityScope) ([]SharedListItem, error)
+
+	// GetTypedListItemsBySharedList 获取共享列表中的项目，并按 i:type 解码为具体类型
+	GetTypedListItemsBySharedList(sharedList SharedListLike, scope EntityScope, preserveUnknown bool) (ListItems, error)
 
 	// GetSharedEntities 获取共享实体
 	GetSharedEntities(entityType SharedEntityType, scope EntityScope) ([]SharedEntity, error)
@@ -17,11 +62,257 @@ type SharedListService interface {
 	// GetSharedEntityAssociationsBySharedEntityIds 根据共享实体ID获取共享实体关联
 	GetSharedEntityAssociationsBySharedEntityIds(entityType EntityType, sharedEntityIds []int64, sharedEntityType SharedEntityType, scope EntityScope) ([]SharedEntityAssociation, []BatchError, error)
 
+	// SetSharedEntityAssociations 将共享实体关联到活动或账户
+	SetSharedEntityAssociations(associations []SharedEntityAssociation, scope EntityScope) ([]BatchError, error)
+
+	// DeleteSharedEntityAssociations 删除共享实体与活动或账户的关联
+	DeleteSharedEntityAssociations(associations []SharedEntityAssociation, scope EntityScope) ([]BatchError, error)
+
 	// AddListItemsToSharedList 向共享列表添加项目
-	AddListItemsToSharedList(sharedList any, listItems []SharedListItem, scope EntityScope) ([]int64, []BatchError, error)
+	AddListItemsToSharedList(sharedList SharedListLike, listItems []SharedListItem, scope EntityScope) ([]int64, []BatchError, error)
 
 	// DeleteListItemsFromSharedList 从共享列表删除项目
-	DeleteListItemsFromSharedList(sharedList any, listItemIds []int64, scope EntityScope) ([]BatchError, error)
+	DeleteListItemsFromSharedList(sharedList SharedListLike, listItemIds []int64, scope EntityScope) ([]BatchError, error)
+
+	// AddListItemsToSharedListInBatches 分批向共享列表添加项目
+	AddListItemsToSharedListInBatches(sharedList SharedListLike, listItems []SharedListItem, scope EntityScope, opts BatchOptions) ([]int64, []BatchError, error)
+
+	// DeleteListItemsFromSharedListInBatches 分批从共享列表删除项目
+	DeleteListItemsFromSharedListInBatches(sharedList SharedListLike, listItemIds []int64, scope EntityScope, opts BatchOptions) ([]BatchError, error)
+
+	// PlanSharedListSync 计算将共享列表同步为 desired 所需的修改
+	PlanSharedListSync(sharedList SharedListLike, desired []SharedListItem, scope EntityScope) (*SharedListSyncPlan, error)
+
+	// ApplySharedListSync 分批执行同步计划
+	ApplySharedListSync(sharedList SharedListLike, plan *SharedListSyncPlan, scope EntityScope, opts BatchOptions) (*SharedListSyncResult, error)
+
+	// SyncSharedList 将共享列表同步为 desired，支持 dry-run
+	SyncSharedList(sharedList SharedListLike, desired []SharedListItem, scope EntityScope, opts SyncOptions) (*SharedListSyncPlan, *SharedListSyncResult, error)
+
+	// ExportSharedLists 获取多种类型的所有共享列表及其项目，用于导出为 CSV 或 JSON
+	ExportSharedLists(entityTypes []SharedEntityType, scope EntityScope) ([]SharedListExport, error)
+
+	// GetListItemsBySharedListAny 获取共享列表中的项目
+	//
+	// Deprecated: 使用 GetListItemsBySharedList。
+	GetListItemsBySharedListAny(sharedList any, scope EntityScope) ([]SharedListItem, error)
+
+	// AddListItemsToSharedListAny 向共享列表添加项目
+	//
+	// Deprecated: 使用 AddListItemsToSharedList。
+	AddListItemsToSharedListAny(sharedList any, listItems []SharedListItem, scope EntityScope) ([]int64, []BatchError, error)
+
+	// DeleteListItemsFromSharedListAny 从共享列表删除项目
+	//
+	// Deprecated: 使用 DeleteListItemsFromSharedList。
+	DeleteListItemsFromSharedListAny(sharedList any, listItemIds []int64, scope EntityScope) ([]BatchError, error)
+}
+
+// BudgetService 定义共享预算相关的操作
+type BudgetService interface {
+	// GetBudgetsByIds 根据 ID 获取预算，budgetIds 为空时获取账户下的所有预算
+	GetBudgetsByIds(budgetIds []int64) ([]Budget, []BatchError, error)
+
+	// AddBudgets 添加预算，返回与 budgets 一一对应的预算 ID
+	AddBudgets(budgets []Budget) ([]int64, []BatchError, error)
+
+	// UpdateBudgets 更新预算，未设置的字段不会被修改
+	UpdateBudgets(budgets []Budget) ([]BatchError, error)
+
+	// DeleteBudgets 删除预算
+	DeleteBudgets(budgetIds []int64) ([]BatchError, error)
+
+	// GetCampaignIdsByBudgetIds 获取使用各预算的活动 ID，结果与 budgetIds 一一对应
+	GetCampaignIdsByBudgetIds(budgetIds []int64) ([]IdCollection, []BatchError, error)
+}
+
+// BidStrategyService 定义组合出价策略相关的操作
+type BidStrategyService interface {
+	// GetBidStrategiesByIds 根据 ID 获取出价策略，bidStrategyIds 为空时获取账户下的所有出价策略
+	GetBidStrategiesByIds(bidStrategyIds []int64) ([]BidStrategy, []BatchError, error)
+
+	// AddBidStrategies 添加出价策略，返回与 bidStrategies 一一对应的出价策略 ID
+	AddBidStrategies(bidStrategies []BidStrategy) ([]int64, []BatchError, error)
+
+	// UpdateBidStrategies 更新出价策略，未设置的字段不会被修改
+	UpdateBidStrategies(bidStrategies []BidStrategy) ([]BatchError, error)
+
+	// DeleteBidStrategies 删除出价策略
+	DeleteBidStrategies(bidStrategyIds []int64) ([]BatchError, error)
+
+	// GetCampaignIdsByBidStrategyIds 获取使用各出价策略的活动 ID，结果与 bidStrategyIds 一一对应
+	GetCampaignIdsByBidStrategyIds(bidStrategyIds []int64) ([]IdCollection, []BatchError, error)
+}
+
+// LabelService 定义标签相关的操作
+type LabelService interface {
+	// AddLabels 添加标签，返回与 labels 一一对应的标签 ID
+	AddLabels(labels []Label) ([]int64, []BatchError, error)
+
+	// UpdateLabels 更新标签，未设置的字段不会被修改
+	UpdateLabels(labels []Label) ([]BatchError, error)
+
+	// DeleteLabels 删除标签，标签与实体的关联会一并删除
+	DeleteLabels(labelIds []int64) ([]BatchError, error)
+
+	// GetLabelsByIds 根据 ID 分页获取标签，labelIds 为空时获取账户下的所有标签
+	GetLabelsByIds(labelIds []int64, pageInfo Paging) ([]Label, []BatchError, error)
+
+	// SetLabelAssociations 将标签关联到指定类型的实体
+	SetLabelAssociations(entityType EntityType, labelAssociations []LabelAssociation) ([]BatchError, error)
+
+	// DeleteLabelAssociations 删除标签与实体的关联
+	DeleteLabelAssociations(entityType EntityType, labelAssociations []LabelAssociation) ([]BatchError, error)
+
+	// GetLabelAssociationsByEntityIds 获取实体关联的标签
+	GetLabelAssociationsByEntityIds(entityIds []int64, entityType EntityType) ([]LabelAssociation, []BatchError, error)
+
+	// GetLabelAssociationsByLabelIds 分页获取标签关联的实体
+	GetLabelAssociationsByLabelIds(entityType EntityType, labelIds []int64, pageInfo Paging) ([]LabelAssociation, []BatchError, error)
+
+	// LabelsPager 返回逐页获取标签的迭代器
+	LabelsPager(ctx context.Context, labelIds []int64, pageSize int) *common.Pager[Label]
+
+	// LabelAssociationsPager 返回逐页获取标签关联的迭代器
+	LabelAssociationsPager(ctx context.Context, entityType EntityType, labelIds []int64, pageSize int) *common.Pager[LabelAssociation]
+}
+
+// AudienceService 定义受众相关的操作
+type AudienceService interface {
+	// GetAudiencesByIds 获取指定类型的受众，audienceIds 为空时获取账户下的所有受众，audienceTypes 为空时查询 AudienceTypes
+	GetAudiencesByIds(audienceIds []int64, audienceTypes ...AudienceType) ([]Audience, []BatchError, error)
+
+	// AddAudiences 添加受众，返回与 audiences 一一对应的受众 ID
+	AddAudiences(audiences []Audience) ([]int64, []BatchError, error)
+
+	// UpdateAudiences 更新受众，未设置的字段不会被修改
+	UpdateAudiences(audiences []Audience) ([]BatchError, error)
+
+	// DeleteAudiences 删除受众
+	DeleteAudiences(audienceIds []int64) ([]BatchError, error)
+
+	// ApplyCustomerListItems 向客户列表添加、删除或替换成员，emails 会在上传前规范化并哈希
+	ApplyCustomerListItems(customerListId int64, actionType CustomerListActionType, emails []string) ([]BatchError, error)
+}
+
+// UetTagService 定义 UET 标签相关的操作
+type UetTagService interface {
+	// AddUetTags 添加 UET 标签，返回包含 ID 和跟踪代码的标签
+	AddUetTags(uetTags []UetTag) ([]UetTag, []BatchError, error)
+
+	// GetUetTagsByIds 获取 UET 标签，tagIds 为空时获取账户下的所有标签
+	GetUetTagsByIds(tagIds []int64) ([]UetTag, []BatchError, error)
+
+	// UpdateUetTags 更新 UET 标签，未设置的字段不会被修改
+	UpdateUetTags(uetTags []UetTag) ([]BatchError, error)
+}
+
+// ConversionGoalService 定义转化目标和转化上报相关的操作
+type ConversionGoalService interface {
+	// AddConversionGoals 添加转化目标，返回与 conversionGoals 一一对应的转化目标 ID
+	AddConversionGoals(conversionGoals []ConversionGoal) ([]int64, []BatchError, error)
+
+	// GetConversionGoalsByIds 获取指定类型的转化目标，conversionGoalIds 为空时获取账户下的所有转化目标
+	GetConversionGoalsByIds(conversionGoalIds []int64, goalTypes ...ConversionGoalType) ([]ConversionGoal, []BatchError, error)
+
+	// UpdateConversionGoals 更新转化目标，未设置的字段不会被修改
+	UpdateConversionGoals(conversionGoals []ConversionGoal) ([]BatchError, error)
+
+	// GetConversionGoalsByTagIds 获取使用指定 UET 标签的转化目标
+	GetConversionGoalsByTagIds(tagIds []int64, goalTypes ...ConversionGoalType) ([]ConversionGoal, []BatchError, error)
+
+	// ApplyOfflineConversions 上报离线转化
+	ApplyOfflineConversions(offlineConversions []OfflineConversion) ([]BatchError, error)
+
+	// ApplyOnlineConversionAdjustments 调整或撤销已经上报的在线转化
+	ApplyOnlineConversionAdjustments(adjustments []OnlineConversionAdjustment) ([]BatchError, error)
+}
+
+// AdExtensionService 定义广告附加信息相关的操作
+type AdExtensionService interface {
+	// AddAdExtensions 向账户添加附加信息，返回与 adExtensions 一一对应的 ID 和版本
+	AddAdExtensions(accountId int64, adExtensions []AdExtension) ([]AdExtensionIdentity, []BatchErrorCollection, error)
+
+	// GetAdExtensionsByIds 获取指定类型的附加信息，adExtensionTypes 为空时查询 AdExtensionTypes
+	GetAdExtensionsByIds(accountId int64, adExtensionIds []int64, adExtensionTypes ...AdExtensionType) ([]AdExtension, []BatchError, error)
+
+	// GetAdExtensionIdsByAccountId 获取账户下附加信息的 ID，associationType 为空时返回所有附加信息
+	GetAdExtensionIdsByAccountId(accountId int64, associationType AssociationType, adExtensionTypes ...AdExtensionType) ([]int64, error)
+
+	// UpdateAdExtensions 更新附加信息，未设置的字段不会被修改
+	UpdateAdExtensions(accountId int64, adExtensions []AdExtension) ([]BatchErrorCollection, error)
+
+	// DeleteAdExtensions 删除附加信息
+	DeleteAdExtensions(accountId int64, adExtensionIds []int64) ([]BatchError, error)
+
+	// SetAdExtensionsAssociations 将附加信息关联到账户、活动或广告组
+	SetAdExtensionsAssociations(accountId int64, associationType AssociationType, associations []AdExtensionIdToEntityIdAssociation) ([]BatchError, error)
+
+	// GetAdExtensionsAssociations 获取实体关联的附加信息
+	GetAdExtensionsAssociations(accountId int64, associationType AssociationType, entityIds []int64, adExtensionTypes ...AdExtensionType) ([]AdExtensionAssociationCollection, []BatchError, error)
+}
+
+// MediaService 定义媒体相关的操作
+type MediaService interface {
+	// AddMedia 向账户上传图片，返回与 images 一一对应的媒体 ID
+	AddMedia(accountId int64, images []Image) ([]int64, error)
+
+	// GetMediaMetaDataByAccountId 分页获取账户下可用于指定实体的媒体元数据
+	GetMediaMetaDataByAccountId(entities []MediaEnabledEntity, pageInfo Paging) ([]MediaMetaData, error)
+
+	// MediaMetaDataPager 返回逐页获取媒体元数据的迭代器
+	MediaMetaDataPager(ctx context.Context, entities []MediaEnabledEntity, pageSize int) *common.Pager[MediaMetaData]
+}
+
+// ExperimentService 定义活动实验相关的操作
+type ExperimentService interface {
+	// AddExperiments 添加实验，返回与 experiments 一一对应的实验 ID
+	AddExperiments(experiments []Experiment) ([]int64, []BatchError, error)
+
+	// GetExperimentsByIds 根据 ID 分页获取实验，experimentIds 为空时获取账户下的所有实验
+	GetExperimentsByIds(experimentIds []int64, pageInfo Paging) ([]Experiment, []BatchError, error)
+
+	// ExperimentsPager 返回逐页获取实验的迭代器
+	ExperimentsPager(ctx context.Context, experimentIds []int64, pageSize int) *common.Pager[Experiment]
+
+	// UpdateExperiments 更新实验，未设置的字段不会被修改
+	UpdateExperiments(experiments []Experiment) ([]BatchError, error)
+
+	// DeleteExperiments 删除实验
+	DeleteExperiments(experimentIds []int64) ([]BatchError, error)
+}
+
+// AdGroupCriterionService 定义广告组条件和产品分组相关的操作
+type AdGroupCriterionService interface {
+	// GetAdGroupCriterionsByIds 获取广告组条件，adGroupCriterionIds 为空时获取广告组下该类型的所有条件
+	GetAdGroupCriterionsByIds(adGroupCriterionIds []int64, adGroupId int64, criterionType AdGroupCriterionType) ([]AdGroupCriterion, []BatchError, error)
+
+	// ApplyProductPartitionActions 对产品分组执行一批新增、更新和删除操作
+	ApplyProductPartitionActions(criterionActions []AdGroupCriterionAction) ([]int64, []BatchError, error)
+
+	// GetProductPartitionTree 获取广告组的产品分组树
+	GetProductPartitionTree(adGroupId int64) (*ProductPartitionTree, error)
+
+	// ApplyProductPartitionTree 将产品分组树的修改提交到服务端
+	ApplyProductPartitionTree(tree *ProductPartitionTree) ([]BatchError, error)
+}
+
+// NegativeKeywordService 定义活动和广告组负面关键词相关的操作
+type NegativeKeywordService interface {
+	// GetNegativeKeywordsByEntityIds 获取活动或广告组上的负面关键词，parentEntityId 为账户 ID 或活动 ID
+	GetNegativeKeywordsByEntityIds(entityIds []int64, entityType EntityType, parentEntityId int64) ([]EntityNegativeKeyword, []BatchError, error)
+
+	// AddNegativeKeywordsToEntities 向活动或广告组添加负面关键词，返回与每个实体的关键词一一对应的 ID
+	AddNegativeKeywordsToEntities(entityNegativeKeywords []EntityNegativeKeyword) ([]IdCollection, []BatchErrorCollection, error)
+
+	// DeleteNegativeKeywordsFromEntities 从活动或广告组删除负面关键词，关键词必须设置 Id
+	DeleteNegativeKeywordsFromEntities(entityNegativeKeywords []EntityNegativeKeyword) ([]BatchErrorCollection, error)
+}
+
+// ChangeSetService 定义变更集相关的操作
+type ChangeSetService interface {
+	// ApplyChangeSet 按顺序执行变更集中的修改
+	ApplyChangeSet(cs *ChangeSet, opts BatchOptions) (*ChangeSetResult, error)
 }
 
 // CampaignService 定义广告系列相关的操作
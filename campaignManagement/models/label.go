@@ -0,0 +1,151 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// Paging 表示分页信息，Index 从 0 开始
+type Paging struct {
+	Index int `xml:"Index"`
+	Size  int `xml:"Size"`
+}
+
+// Label 表示标签
+type Label struct {
+	// 颜色代码，例如 #0078D4
+	ColorCode   base.Nillable[string] `xml:"ColorCode"`
+	Description base.Nillable[string] `xml:"Description"`
+	Id          base.Nillable[int64]  `xml:"Id"`
+	Name        base.Nillable[string] `xml:"Name"`
+}
+
+// LabelAssociation 表示标签与实体的关联
+type LabelAssociation struct {
+	EntityId int64 `xml:"EntityId"`
+	LabelId  int64 `xml:"LabelId"`
+}
+
+// AddLabelsRequest 请求结构体
+type AddLabelsRequest struct {
+	XMLName   xml.Name `xml:"AddLabelsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	Labels    []Label  `xml:"Labels>Label"`
+}
+
+// AddLabelsResponse 响应结构体
+type AddLabelsResponse struct {
+	XMLName       xml.Name     `xml:"AddLabelsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	LabelIds      []int64      `xml:"LabelIds>long,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UpdateLabelsRequest 请求结构体
+type UpdateLabelsRequest struct {
+	XMLName   xml.Name `xml:"UpdateLabelsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	Labels    []Label  `xml:"Labels>Label"`
+}
+
+// UpdateLabelsResponse 响应结构体
+type UpdateLabelsResponse struct {
+	XMLName       xml.Name     `xml:"UpdateLabelsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteLabelsRequest 请求结构体
+type DeleteLabelsRequest struct {
+	XMLName   xml.Name         `xml:"DeleteLabelsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	LabelIds  common.LongArray `xml:"LabelIds"`
+}
+
+// DeleteLabelsResponse 响应结构体
+type DeleteLabelsResponse struct {
+	XMLName       xml.Name     `xml:"DeleteLabelsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetLabelsByIdsRequest 请求结构体
+type GetLabelsByIdsRequest struct {
+	XMLName   xml.Name         `xml:"GetLabelsByIdsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	LabelIds  common.LongArray `xml:"LabelIds"`
+	PageInfo  Paging           `xml:"PageInfo"`
+}
+
+// GetLabelsByIdsResponse 响应结构体
+type GetLabelsByIdsResponse struct {
+	XMLName       xml.Name     `xml:"GetLabelsByIdsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	Labels        []Label      `xml:"Labels>Label,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// SetLabelAssociationsRequest 请求结构体
+type SetLabelAssociationsRequest struct {
+	XMLName           xml.Name           `xml:"SetLabelAssociationsRequest"`
+	Namespace         string             `xml:"xmlns,attr"`
+	EntityType        EntityType         `xml:"EntityType"`
+	LabelAssociations []LabelAssociation `xml:"LabelAssociations>LabelAssociation"`
+}
+
+// SetLabelAssociationsResponse 响应结构体
+type SetLabelAssociationsResponse struct {
+	XMLName       xml.Name     `xml:"SetLabelAssociationsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteLabelAssociationsRequest 请求结构体
+type DeleteLabelAssociationsRequest struct {
+	XMLName           xml.Name           `xml:"DeleteLabelAssociationsRequest"`
+	Namespace         string             `xml:"xmlns,attr"`
+	EntityType        EntityType         `xml:"EntityType"`
+	LabelAssociations []LabelAssociation `xml:"LabelAssociations>LabelAssociation"`
+}
+
+// DeleteLabelAssociationsResponse 响应结构体
+type DeleteLabelAssociationsResponse struct {
+	XMLName       xml.Name     `xml:"DeleteLabelAssociationsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetLabelAssociationsByEntityIdsRequest 请求结构体
+type GetLabelAssociationsByEntityIdsRequest struct {
+	XMLName    xml.Name         `xml:"GetLabelAssociationsByEntityIdsRequest"`
+	Namespace  string           `xml:"xmlns,attr"`
+	EntityIds  common.LongArray `xml:"EntityIds"`
+	EntityType EntityType       `xml:"EntityType"`
+}
+
+// GetLabelAssociationsByEntityIdsResponse 响应结构体
+type GetLabelAssociationsByEntityIdsResponse struct {
+	XMLName           xml.Name           `xml:"GetLabelAssociationsByEntityIdsResponse"`
+	Namespace         string             `xml:"xmlns,attr"`
+	LabelAssociations []LabelAssociation `xml:"LabelAssociations>LabelAssociation,omitempty"`
+	PartialErrors     []BatchError       `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetLabelAssociationsByLabelIdsRequest 请求结构体
+type GetLabelAssociationsByLabelIdsRequest struct {
+	XMLName    xml.Name         `xml:"GetLabelAssociationsByLabelIdsRequest"`
+	Namespace  string           `xml:"xmlns,attr"`
+	EntityType EntityType       `xml:"EntityType"`
+	LabelIds   common.LongArray `xml:"LabelIds"`
+	PageInfo   Paging           `xml:"PageInfo"`
+}
+
+// GetLabelAssociationsByLabelIdsResponse 响应结构体
+type GetLabelAssociationsByLabelIdsResponse struct {
+	XMLName           xml.Name           `xml:"GetLabelAssociationsByLabelIdsResponse"`
+	Namespace         string             `xml:"xmlns,attr"`
+	LabelAssociations []LabelAssociation `xml:"LabelAssociations>LabelAssociation,omitempty"`
+	PartialErrors     []BatchError       `xml:"PartialErrors>BatchError,omitempty"`
+}
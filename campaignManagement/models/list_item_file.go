@@ -0,0 +1,364 @@
+package models
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 共享列表项文件的列名，与 ListItemRecord 的 JSON 字段名相同
+const (
+	ListItemColumnType      = "type"
+	ListItemColumnId        = "id"
+	ListItemColumnText      = "text"
+	ListItemColumnMatchType = "match_type"
+	ListItemColumnUrl       = "url"
+	ListItemColumnBrandId   = "brand_id"
+)
+
+// 导出所有共享列表时额外的列名
+const (
+	SharedListColumnId   = "shared_list_id"
+	SharedListColumnName = "shared_list_name"
+	SharedListColumnType = "shared_list_type"
+)
+
+// ListItemColumns 为共享列表项 CSV 文件的列
+var ListItemColumns = []string{
+	ListItemColumnType,
+	ListItemColumnId,
+	ListItemColumnText,
+	ListItemColumnMatchType,
+	ListItemColumnUrl,
+	ListItemColumnBrandId,
+}
+
+// SharedListColumns 为导出所有共享列表时 CSV 文件的列，每行为一个列表项
+var SharedListColumns = append([]string{SharedListColumnId, SharedListColumnName, SharedListColumnType}, ListItemColumns...)
+
+// negativeKeywordMatchTypes 为共享列表中负面关键词支持的匹配方式
+var negativeKeywordMatchTypes = []string{"Exact", "Phrase"}
+
+// SharedListExport 表示导出的共享列表及其项目
+type SharedListExport struct {
+	Id    int64            `json:"id"`
+	Name  string           `json:"name"`
+	Type  SharedEntityType `json:"type"`
+	Items []ListItemRecord `json:"items"`
+}
+
+// ListItemFieldError 表示导入文件中某一行某一列的值无效
+type ListItemFieldError struct {
+	// 行号，从 1 开始；CSV 文件包含列名行，JSON 文件为项目的序号
+	Row int
+	// 列名，整行无法解析时为空
+	Column  string
+	Message string
+}
+
+// Error 实现 error 接口
+func (e ListItemFieldError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("第 %d 行: %s", e.Row, e.Message)
+	}
+	return fmt.Sprintf("第 %d 行 %s 列: %s", e.Row, e.Column, e.Message)
+}
+
+// ListItemFileError 汇总导入文件中的所有无效值
+type ListItemFileError struct {
+	Errors []ListItemFieldError
+}
+
+// Error 实现 error 接口
+func (e *ListItemFileError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "导入文件中有 %d 处错误", len(e.Errors))
+	for i, fe := range e.Errors {
+		if i == 10 {
+			fmt.Fprintf(&b, "; ...")
+			break
+		}
+		fmt.Fprintf(&b, "; %s", fe.Error())
+	}
+	return b.String()
+}
+
+// Validate 检查列表项记录，返回的错误中 Row 为 0
+//
+// 负面关键词的 MatchType 不区分大小写，校验通过后规范化为 Exact 或 Phrase。
+func (r *ListItemRecord) Validate() []ListItemFieldError {
+	var errs []ListItemFieldError
+	fail := func(column, format string, args ...any) {
+		errs = append(errs, ListItemFieldError{Column: column, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if r.Id < 0 {
+		fail(ListItemColumnId, "Id 不能为负数")
+	}
+
+	switch r.Type {
+	case SharedListItemTypeNegativeKeyword:
+		if strings.TrimSpace(r.Text) == "" {
+			fail(ListItemColumnText, "负面关键词的 Text 不能为空")
+		}
+		matchType := ""
+		for _, m := range negativeKeywordMatchTypes {
+			if strings.EqualFold(strings.TrimSpace(r.MatchType), m) {
+				matchType = m
+			}
+		}
+		if matchType == "" {
+			fail(ListItemColumnMatchType, "无效的匹配方式 %q，只支持 %s", r.MatchType, strings.Join(negativeKeywordMatchTypes, "、"))
+		} else {
+			r.MatchType = matchType
+		}
+	case SharedListItemTypeNegativeSite:
+		url := strings.TrimSpace(r.Url)
+		if url == "" {
+			fail(ListItemColumnUrl, "负面站点的 Url 不能为空")
+		} else if strings.ContainsAny(url, " \t") {
+			fail(ListItemColumnUrl, "无效的网址 %q", r.Url)
+		}
+	case SharedListItemTypeBrandItem:
+		if r.BrandId <= 0 {
+			fail(ListItemColumnBrandId, "品牌的 BrandId 必须为正整数")
+		}
+	case "":
+		fail(ListItemColumnType, "Type 不能为空")
+	default:
+		fail(ListItemColumnType, "不支持的类型 %q", r.Type)
+	}
+	return errs
+}
+
+// Values 返回记录在 ListItemColumns 中各列的值
+func (r ListItemRecord) Values() []string {
+	values := []string{string(r.Type), "", r.Text, r.MatchType, r.Url, ""}
+	if r.Id != 0 {
+		values[1] = strconv.FormatInt(r.Id, 10)
+	}
+	if r.BrandId != 0 {
+		values[5] = strconv.FormatInt(r.BrandId, 10)
+	}
+	return values
+}
+
+// WriteListItemsCSV 将共享列表项写为 CSV，第一行为 ListItemColumns
+func WriteListItemsCSV(w io.Writer, items []SharedListItem) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(ListItemColumns); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := writer.Write(NewListItemRecord(item).Values()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteSharedListsCSV 将导出的共享列表写为 CSV，第一行为 SharedListColumns，每行一个列表项
+//
+// 没有项目的共享列表也会输出一行，列表项的列为空。
+func WriteSharedListsCSV(w io.Writer, lists []SharedListExport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(SharedListColumns); err != nil {
+		return err
+	}
+	for _, list := range lists {
+		prefix := []string{strconv.FormatInt(list.Id, 10), list.Name, string(list.Type)}
+		if len(list.Items) == 0 {
+			if err := writer.Write(append(prefix, make([]string, len(ListItemColumns))...)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, record := range list.Items {
+			row := append(append([]string{}, prefix...), record.Values()...)
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteSharedListsJSON 将导出的共享列表写为缩进的 JSON 数组
+func WriteSharedListsJSON(w io.Writer, lists []SharedListExport) error {
+	if lists == nil {
+		lists = []SharedListExport{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(lists)
+}
+
+// normalizeColumn 规范化列名，忽略大小写、首尾空白，空格和连字符视为下划线
+func normalizeColumn(column string) string {
+	column = strings.ToLower(strings.TrimSpace(column))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(column)
+}
+
+// ReadListItemsCSV 从 CSV 读取共享列表项
+//
+// 第一行为列名，列名不区分大小写，"Match Type" 和 "match_type" 等价，未知的列会被忽略，
+// 因此 WriteSharedListsCSV 导出的文件也可以直接读取，没有项目的空行会被跳过。文件中有无效值时
+// 返回有效的项目以及包含所有无效行列的 *ListItemFileError。
+func ReadListItemsCSV(r io.Reader) ([]SharedListItem, error) {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && string(bom) == "\xef\xbb\xbf" {
+		br.Discard(3)
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, &ListItemFileError{Errors: []ListItemFieldError{{Row: 1, Message: "文件中没有列名"}}}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[normalizeColumn(column)] = i
+	}
+	if _, ok := columns[ListItemColumnType]; !ok {
+		return nil, &ListItemFileError{Errors: []ListItemFieldError{{Row: 1, Column: ListItemColumnType, Message: "缺少 type 列"}}}
+	}
+
+	var (
+		items []SharedListItem
+		errs  []ListItemFieldError
+	)
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if parseErr, ok := err.(*csv.ParseError); ok {
+				errs = append(errs, ListItemFieldError{Row: parseErr.Line, Message: parseErr.Err.Error()})
+				continue
+			}
+			return items, err
+		}
+		row, _ := reader.FieldPos(0)
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(values) {
+				return strings.TrimSpace(values[i])
+			}
+			return ""
+		}
+		if value(ListItemColumnType) == "" && value(ListItemColumnText) == "" && value(ListItemColumnUrl) == "" && value(ListItemColumnBrandId) == "" {
+			continue
+		}
+
+		record := ListItemRecord{
+			Type:      SharedListItemType(value(ListItemColumnType)),
+			Text:      value(ListItemColumnText),
+			MatchType: value(ListItemColumnMatchType),
+			Url:       value(ListItemColumnUrl),
+		}
+		var rowErrs []ListItemFieldError
+		parseInt := func(column string, dst *int64) {
+			if v := value(column); v != "" {
+				n, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					rowErrs = append(rowErrs, ListItemFieldError{Column: column, Message: fmt.Sprintf("%q 不是整数", v)})
+					return
+				}
+				*dst = n
+			}
+		}
+		parseInt(ListItemColumnId, &record.Id)
+		parseInt(ListItemColumnBrandId, &record.BrandId)
+		for _, e := range record.Validate() {
+			// 无法解析的整数列已经报告过，不再重复报告
+			if len(rowErrs) > 0 && (e.Column == ListItemColumnId || e.Column == ListItemColumnBrandId) && hasColumnError(rowErrs, e.Column) {
+				continue
+			}
+			rowErrs = append(rowErrs, e)
+		}
+
+		if len(rowErrs) > 0 {
+			for _, e := range rowErrs {
+				e.Row = row
+				errs = append(errs, e)
+			}
+			continue
+		}
+		items = append(items, record.SharedListItem())
+	}
+
+	if len(errs) > 0 {
+		return items, &ListItemFileError{Errors: errs}
+	}
+	return items, nil
+}
+
+// hasColumnError 检查 errs 中是否已有 column 列的错误
+func hasColumnError(errs []ListItemFieldError, column string) bool {
+	for _, e := range errs {
+		if e.Column == column {
+			return true
+		}
+	}
+	return false
+}
+
+// listItemFileEntry 为 JSON 文件中的一项，可以是列表项记录或带有 items 的共享列表
+type listItemFileEntry struct {
+	ListItemRecord
+	Items *[]ListItemRecord `json:"items"`
+}
+
+// ReadListItemsJSON 从 JSON 数组读取共享列表项
+//
+// 数组元素可以是 ListItemRecord，也可以是 WriteSharedListsJSON 导出的带有 items 的共享列表，
+// 后者的项目会按顺序展开。错误中的 Row 为展开后项目的序号，Column 为 JSON 字段名。
+// 文件中有无效值时返回有效的项目以及 *ListItemFileError。
+func ReadListItemsJSON(r io.Reader) ([]SharedListItem, error) {
+	var entries []listItemFileEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, &ListItemFileError{Errors: []ListItemFieldError{{Message: fmt.Sprintf("无法解析 JSON: %v", err)}}}
+	}
+
+	var records []ListItemRecord
+	for _, entry := range entries {
+		if entry.Items != nil {
+			records = append(records, *entry.Items...)
+			continue
+		}
+		records = append(records, entry.ListItemRecord)
+	}
+
+	var (
+		items []SharedListItem
+		errs  []ListItemFieldError
+	)
+	for i, record := range records {
+		rowErrs := record.Validate()
+		if len(rowErrs) > 0 {
+			for _, e := range rowErrs {
+				e.Row = i + 1
+				errs = append(errs, e)
+			}
+			continue
+		}
+		items = append(items, record.SharedListItem())
+	}
+
+	if len(errs) > 0 {
+		return items, &ListItemFileError{Errors: errs}
+	}
+	return items, nil
+}
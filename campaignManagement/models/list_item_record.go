@@ -0,0 +1,44 @@
+package models
+
+import "github.com/vancevox/bingads-go/base"
+
+// ListItemRecord 是共享列表项便于序列化的扁平表示，未使用的字段为零值
+type ListItemRecord struct {
+	Type      SharedListItemType `json:"type"`
+	Id        int64              `json:"id,omitempty"`
+	Text      string             `json:"text,omitempty"`
+	MatchType string             `json:"match_type,omitempty"`
+	Url       string             `json:"url,omitempty"`
+	BrandId   int64              `json:"brand_id,omitempty"`
+}
+
+// NewListItemRecord 将 SharedListItem 转换为 ListItemRecord
+func NewListItemRecord(item SharedListItem) ListItemRecord {
+	return ListItemRecord{
+		Type:      listItemType(item),
+		Id:        item.ID.Value(),
+		Text:      item.Text,
+		MatchType: item.MatchType,
+		Url:       item.Url,
+		BrandId:   item.BrandId.Value(),
+	}
+}
+
+// SharedListItem 将 ListItemRecord 转换为 SharedListItem，只设置该类型使用的字段
+func (r ListItemRecord) SharedListItem() SharedListItem {
+	item := SharedListItem{Type: r.Type, ItemType: string(r.Type)}
+	if r.Id != 0 {
+		item.ID = base.Int64(r.Id)
+	}
+
+	switch r.Type {
+	case SharedListItemTypeNegativeKeyword:
+		item.Text = r.Text
+		item.MatchType = r.MatchType
+	case SharedListItemTypeNegativeSite:
+		item.Url = r.Url
+	case SharedListItemTypeBrandItem:
+		item.BrandId = base.Int64(r.BrandId)
+	}
+	return item
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// MediaEnabledEntity 表示可以使用媒体的实体类型
+type MediaEnabledEntity string
+
+const (
+	MediaEnabledEntityImageAdExtension MediaEnabledEntity = "ImageAdExtension"
+	MediaEnabledEntityResponsiveAd     MediaEnabledEntity = "ResponsiveAd"
+)
+
+// Base64Data 表示 base64 编码的二进制数据，序列化时自动编码和解码
+type Base64Data []byte
+
+// MarshalText 实现 encoding.TextMarshaler 接口
+func (b Base64Data) MarshalText() ([]byte, error) {
+	return []byte(base64.StdEncoding.EncodeToString(b)), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler 接口
+func (b *Base64Data) UnmarshalText(text []byte) error {
+	data, err := base64.StdEncoding.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*b = data
+	return nil
+}
+
+// Image 表示上传的图片，MediaType 表示图片的宽高比，例如 Image191x100
+type Image struct {
+	Id        base.Nillable[int64] `xml:"Id"`
+	MediaType string               `xml:"MediaType"`
+	// Type 由服务端返回，添加时不需要设置
+	Type string     `xml:"Type,omitempty"`
+	Data Base64Data `xml:"Data"`
+}
+
+// MarshalXML 自定义 Image 的 XML 序列化，加上 i:type 属性
+func (img Image) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type image Image
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: "Image"})
+	return e.EncodeElement(image(img), start)
+}
+
+// MediaRepresentation 表示媒体的一种尺寸，Height 和 Width 只对图片有效
+type MediaRepresentation struct {
+	Name   base.Nillable[string] `xml:"Name"`
+	Type   base.Nillable[string] `xml:"Type"`
+	Url    base.Nillable[string] `xml:"Url"`
+	Height base.Nillable[int]    `xml:"Height"`
+	Width  base.Nillable[int]    `xml:"Width"`
+}
+
+// MediaMetaData 表示媒体的元数据
+type MediaMetaData struct {
+	Id              int64                 `xml:"Id"`
+	MediaType       base.Nillable[string] `xml:"MediaType"`
+	Representations []MediaRepresentation `xml:"Representations>MediaRepresentation,omitempty"`
+	Text            base.Nillable[string] `xml:"Text"`
+	Type            base.Nillable[string] `xml:"Type"`
+}
+
+// AddMediaRequest 请求结构体
+type AddMediaRequest struct {
+	XMLName   xml.Name `xml:"AddMediaRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	AccountId int64    `xml:"AccountId"`
+	Media     []Image  `xml:"Media>Media"`
+}
+
+// AddMediaResponse 响应结构体
+type AddMediaResponse struct {
+	XMLName   xml.Name `xml:"AddMediaResponse"`
+	Namespace string   `xml:"xmlns,attr"`
+	MediaIds  []int64  `xml:"MediaIds>long,omitempty"`
+}
+
+// GetMediaMetaDataByAccountIdRequest 请求结构体
+type GetMediaMetaDataByAccountIdRequest struct {
+	XMLName   xml.Name `xml:"GetMediaMetaDataByAccountIdRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	// 以空格分隔的实体类型
+	MediaEnabledEntities string `xml:"MediaEnabledEntities"`
+	PageInfo             Paging `xml:"PageInfo"`
+}
+
+// GetMediaMetaDataByAccountIdResponse 响应结构体
+type GetMediaMetaDataByAccountIdResponse struct {
+	XMLName       xml.Name        `xml:"GetMediaMetaDataByAccountIdResponse"`
+	Namespace     string          `xml:"xmlns,attr"`
+	MediaMetaData []MediaMetaData `xml:"MediaMetaData>MediaMetaData,omitempty"`
+}
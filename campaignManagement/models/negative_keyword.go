@@ -0,0 +1,61 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/common"
+)
+
+// EntityNegativeKeyword 表示活动或广告组上的负面关键词
+type EntityNegativeKeyword struct {
+	EntityId         int64             `xml:"EntityId"`
+	EntityType       EntityType        `xml:"EntityType"`
+	NegativeKeywords []NegativeKeyword `xml:"NegativeKeywords>NegativeKeyword"`
+}
+
+// GetNegativeKeywordsByEntityIdsRequest 请求结构体
+type GetNegativeKeywordsByEntityIdsRequest struct {
+	XMLName    xml.Name         `xml:"GetNegativeKeywordsByEntityIdsRequest"`
+	Namespace  string           `xml:"xmlns,attr"`
+	EntityIds  common.LongArray `xml:"EntityIds"`
+	EntityType EntityType       `xml:"EntityType"`
+	// 活动的父实体为账户，广告组的父实体为活动
+	ParentEntityId int64 `xml:"ParentEntityId"`
+}
+
+// GetNegativeKeywordsByEntityIdsResponse 响应结构体
+type GetNegativeKeywordsByEntityIdsResponse struct {
+	XMLName                xml.Name                `xml:"GetNegativeKeywordsByEntityIdsResponse"`
+	Namespace              string                  `xml:"xmlns,attr"`
+	EntityNegativeKeywords []EntityNegativeKeyword `xml:"EntityNegativeKeywords>EntityNegativeKeyword,omitempty"`
+	PartialErrors          []BatchError            `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// AddNegativeKeywordsToEntitiesRequest 请求结构体
+type AddNegativeKeywordsToEntitiesRequest struct {
+	XMLName                xml.Name                `xml:"AddNegativeKeywordsToEntitiesRequest"`
+	Namespace              string                  `xml:"xmlns,attr"`
+	EntityNegativeKeywords []EntityNegativeKeyword `xml:"EntityNegativeKeywords>EntityNegativeKeyword"`
+}
+
+// AddNegativeKeywordsToEntitiesResponse 响应结构体
+type AddNegativeKeywordsToEntitiesResponse struct {
+	XMLName             xml.Name               `xml:"AddNegativeKeywordsToEntitiesResponse"`
+	Namespace           string                 `xml:"xmlns,attr"`
+	NegativeKeywordIds  []IdCollection         `xml:"NegativeKeywordIds>IdCollection,omitempty"`
+	NestedPartialErrors []BatchErrorCollection `xml:"NestedPartialErrors>BatchErrorCollection,omitempty"`
+}
+
+// DeleteNegativeKeywordsFromEntitiesRequest 请求结构体
+type DeleteNegativeKeywordsFromEntitiesRequest struct {
+	XMLName                xml.Name                `xml:"DeleteNegativeKeywordsFromEntitiesRequest"`
+	Namespace              string                  `xml:"xmlns,attr"`
+	EntityNegativeKeywords []EntityNegativeKeyword `xml:"EntityNegativeKeywords>EntityNegativeKeyword"`
+}
+
+// DeleteNegativeKeywordsFromEntitiesResponse 响应结构体
+type DeleteNegativeKeywordsFromEntitiesResponse struct {
+	XMLName             xml.Name               `xml:"DeleteNegativeKeywordsFromEntitiesResponse"`
+	Namespace           string                 `xml:"xmlns,attr"`
+	NestedPartialErrors []BatchErrorCollection `xml:"NestedPartialErrors>BatchErrorCollection,omitempty"`
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// OnlineConversionAdjustmentType 表示在线转化调整的方式
+type OnlineConversionAdjustmentType string
+
+const (
+	// 重新设置转化价值
+	OnlineConversionAdjustmentTypeRestate OnlineConversionAdjustmentType = "Restate"
+
+	// 撤销转化
+	OnlineConversionAdjustmentTypeRetract OnlineConversionAdjustmentType = "Retract"
+)
+
+// OfflineConversion 表示一次离线转化，ConversionName 为离线转化目标的名称
+type OfflineConversion struct {
+	ConversionCurrencyCode base.Nillable[string]  `xml:"ConversionCurrencyCode"`
+	ConversionName         string                 `xml:"ConversionName"`
+	ConversionTime         time.Time              `xml:"ConversionTime"`
+	ConversionValue        base.Nillable[float64] `xml:"ConversionValue"`
+	// 点击广告时落地页网址中的 msclkid 参数
+	MicrosoftClickId string `xml:"MicrosoftClickId"`
+}
+
+// OnlineConversionAdjustment 表示对一次在线转化的调整，通过 TransactionId 匹配 UET 上报的转化
+type OnlineConversionAdjustment struct {
+	AdjustmentCurrencyCode base.Nillable[string]          `xml:"AdjustmentCurrencyCode"`
+	AdjustmentTime         time.Time                      `xml:"AdjustmentTime"`
+	AdjustmentType         OnlineConversionAdjustmentType `xml:"AdjustmentType"`
+	AdjustmentValue        base.Nillable[float64]         `xml:"AdjustmentValue"`
+	ConversionName         string                         `xml:"ConversionName"`
+	TransactionId          string                         `xml:"TransactionId"`
+}
+
+// ApplyOfflineConversionsRequest 请求结构体
+type ApplyOfflineConversionsRequest struct {
+	XMLName            xml.Name            `xml:"ApplyOfflineConversionsRequest"`
+	Namespace          string              `xml:"xmlns,attr"`
+	OfflineConversions []OfflineConversion `xml:"OfflineConversions>OfflineConversion"`
+}
+
+// ApplyOfflineConversionsResponse 响应结构体
+type ApplyOfflineConversionsResponse struct {
+	XMLName       xml.Name     `xml:"ApplyOfflineConversionsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// ApplyOnlineConversionAdjustmentsRequest 请求结构体
+type ApplyOnlineConversionAdjustmentsRequest struct {
+	XMLName                     xml.Name                     `xml:"ApplyOnlineConversionAdjustmentsRequest"`
+	Namespace                   string                       `xml:"xmlns,attr"`
+	OnlineConversionAdjustments []OnlineConversionAdjustment `xml:"OnlineConversionAdjustments>OnlineConversionAdjustment"`
+}
+
+// ApplyOnlineConversionAdjustmentsResponse 响应结构体
+type ApplyOnlineConversionAdjustmentsResponse struct {
+	XMLName       xml.Name     `xml:"ApplyOnlineConversionAdjustmentsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
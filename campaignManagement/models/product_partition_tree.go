@@ -0,0 +1,521 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// ProductPartitionNode 表示产品分组树中的一个节点
+//
+// 节点只能通过 Split、AddChild、SetBid、Exclude、Merge 和 Remove 修改，
+// 这些方法保证树在任何时候都可以转换为一组合法的 ApplyProductPartitionActions 操作。
+type ProductPartitionNode struct {
+	// Id 为服务端的条件 ID，尚未提交的新节点为 0
+	Id        int64
+	Condition ProductCondition
+
+	parent      *ProductPartitionNode
+	children    []*ProductPartitionNode
+	subdivision bool
+	excluded    bool
+	bid         float64
+}
+
+// Parent 返回父节点，根节点返回 nil
+func (n *ProductPartitionNode) Parent() *ProductPartitionNode {
+	return n.parent
+}
+
+// Children 返回子节点
+func (n *ProductPartitionNode) Children() []*ProductPartitionNode {
+	return append([]*ProductPartitionNode(nil), n.children...)
+}
+
+// IsSubdivision 检查节点是否为细分节点
+func (n *ProductPartitionNode) IsSubdivision() bool {
+	return n.subdivision
+}
+
+// IsExcluded 检查叶子节点是否被排除
+func (n *ProductPartitionNode) IsExcluded() bool {
+	return n.excluded
+}
+
+// Bid 返回叶子节点的出价，细分节点和被排除的节点返回 0
+func (n *ProductPartitionNode) Bid() float64 {
+	return n.bid
+}
+
+// IsEverythingElse 检查节点是否为细分节点下的"其他所有"节点
+func (n *ProductPartitionNode) IsEverythingElse() bool {
+	return n.parent != nil && n.Condition.Attribute == ""
+}
+
+// Child 返回 Attribute 为 attribute 的子节点，attribute 为空时返回"其他所有"节点
+func (n *ProductPartitionNode) Child(attribute string) *ProductPartitionNode {
+	for _, child := range n.children {
+		if child.Condition.Attribute == attribute {
+			return child
+		}
+	}
+	return nil
+}
+
+// EverythingElse 返回细分节点下的"其他所有"节点
+func (n *ProductPartitionNode) EverythingElse() *ProductPartitionNode {
+	return n.Child("")
+}
+
+// Split 将叶子节点按 operand 细分，例如 Brand、CategoryL1 或 CustomLabel0
+//
+// 细分后节点下只有一个"其他所有"子节点，它继承原节点的出价或排除状态，
+// 之后可以通过 AddChild 添加具体的子节点。
+func (n *ProductPartitionNode) Split(operand string) error {
+	if n.subdivision {
+		return base.NewError(base.ErrInvalidInput, "只能细分叶子节点", nil)
+	}
+	if operand == "" || operand == ProductConditionOperandAll {
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("无效的细分维度 %q", operand), nil)
+	}
+
+	n.children = []*ProductPartitionNode{{
+		Condition: ProductCondition{Operand: operand},
+		parent:    n,
+		excluded:  n.excluded,
+		bid:       n.bid,
+	}}
+	n.subdivision = true
+	n.excluded = false
+	n.bid = 0
+	return nil
+}
+
+// AddChild 在细分节点下添加一个出价为 bid 的叶子节点，维度与其他子节点相同
+func (n *ProductPartitionNode) AddChild(attribute string, bid float64) (*ProductPartitionNode, error) {
+	if !n.subdivision {
+		return nil, base.NewError(base.ErrInvalidInput, "只能在细分节点下添加子节点", nil)
+	}
+	if attribute == "" {
+		return nil, base.NewError(base.ErrInvalidInput, "子节点的 Attribute 不能为空", nil)
+	}
+	if n.Child(attribute) != nil {
+		return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("子节点 %q 已经存在", attribute), nil)
+	}
+	if err := checkBid(bid); err != nil {
+		return nil, err
+	}
+
+	child := &ProductPartitionNode{
+		Condition: ProductCondition{Attribute: attribute, Operand: n.EverythingElse().Condition.Operand},
+		parent:    n,
+		bid:       bid,
+	}
+	n.children = append(n.children, child)
+	return child, nil
+}
+
+// SetBid 设置叶子节点的出价，被排除的节点会恢复为可出价
+func (n *ProductPartitionNode) SetBid(bid float64) error {
+	if n.subdivision {
+		return base.NewError(base.ErrInvalidInput, "细分节点不能设置出价", nil)
+	}
+	if err := checkBid(bid); err != nil {
+		return err
+	}
+	n.excluded = false
+	n.bid = bid
+	return nil
+}
+
+// Exclude 排除叶子节点，匹配该节点的产品不再展示广告
+func (n *ProductPartitionNode) Exclude() error {
+	if n.subdivision {
+		return base.NewError(base.ErrInvalidInput, "细分节点不能被排除，请先调用 Merge", nil)
+	}
+	n.excluded = true
+	n.bid = 0
+	return nil
+}
+
+// Merge 删除细分节点的所有子节点，将其恢复为出价为 bid 的叶子节点
+func (n *ProductPartitionNode) Merge(bid float64) error {
+	if !n.subdivision {
+		return base.NewError(base.ErrInvalidInput, "只能合并细分节点", nil)
+	}
+	if err := checkBid(bid); err != nil {
+		return err
+	}
+	n.children = nil
+	n.subdivision = false
+	n.bid = bid
+	return nil
+}
+
+// Remove 从父节点中删除该节点，"其他所有"节点和根节点不能删除
+func (n *ProductPartitionNode) Remove() error {
+	if n.parent == nil {
+		return base.NewError(base.ErrInvalidInput, "不能删除根节点", nil)
+	}
+	if n.IsEverythingElse() {
+		return base.NewError(base.ErrInvalidInput, "不能删除\"其他所有\"节点", nil)
+	}
+	siblings := n.parent.children
+	for i, sibling := range siblings {
+		if sibling == n {
+			n.parent.children = append(siblings[:i:i], siblings[i+1:]...)
+			break
+		}
+	}
+	n.parent = nil
+	return nil
+}
+
+// checkBid 检查出价是否为正数
+func checkBid(bid float64) error {
+	if bid <= 0 {
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("出价必须大于 0，实际为 %v", bid), nil)
+	}
+	return nil
+}
+
+// productPartitionState 记录节点在服务端的状态，用于计算差异
+type productPartitionState struct {
+	parentId    int64
+	condition   ProductCondition
+	subdivision bool
+	excluded    bool
+	bid         float64
+}
+
+// ProductPartitionTree 表示一个广告组的产品分组树
+//
+// 通过 BuildProductPartitionTree 从 GetAdGroupCriterionsByIds 的结果构建，在内存中修改后
+// 调用 Plan 计算需要提交的最少操作。
+type ProductPartitionTree struct {
+	AdGroupId int64
+	// Root 为根节点，广告组还没有产品分组时为 nil
+	Root *ProductPartitionNode
+
+	original map[int64]productPartitionState
+}
+
+// NewProductPartitionTree 创建一个只有根节点的新树，所有产品使用同一出价
+func NewProductPartitionTree(adGroupId int64, bid float64) (*ProductPartitionTree, error) {
+	tree := &ProductPartitionTree{AdGroupId: adGroupId}
+	if err := tree.Reset(bid); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// Reset 用出价为 bid 的新根节点替换整棵树，提交时服务端已有的节点会被全部删除
+func (t *ProductPartitionTree) Reset(bid float64) error {
+	if err := checkBid(bid); err != nil {
+		return err
+	}
+	t.Root = &ProductPartitionNode{
+		Condition: ProductCondition{Operand: ProductConditionOperandAll},
+		bid:       bid,
+	}
+	return nil
+}
+
+// BuildProductPartitionTree 根据广告组的产品分组条件构建树
+//
+// criterions 通常为 GetAdGroupCriterionsByIds 按 ProductPartition 类型查询的结果，
+// 其中不是产品分组的条件会被忽略。
+func BuildProductPartitionTree(adGroupId int64, criterions []AdGroupCriterion) (*ProductPartitionTree, error) {
+	tree := &ProductPartitionTree{AdGroupId: adGroupId}
+	nodes := make(map[int64]*ProductPartitionNode)
+	parents := make(map[int64]int64)
+	var ids []int64
+
+	for _, criterion := range criterions {
+		var (
+			criterionBase AdGroupCriterionBase
+			excluded      bool
+			bid           float64
+		)
+		switch c := criterion.(type) {
+		case BiddableAdGroupCriterion:
+			criterionBase = c.AdGroupCriterionBase
+			if fixed, ok := c.CriterionBid.(FixedBid); ok {
+				bid = fixed.Amount
+			}
+		case NegativeAdGroupCriterion:
+			criterionBase = c.AdGroupCriterionBase
+			excluded = true
+		default:
+			continue
+		}
+
+		partition, ok := criterionBase.Criterion.(ProductPartition)
+		if !ok {
+			continue
+		}
+		id := criterionBase.Id.Value()
+		if id == 0 {
+			return nil, base.NewError(base.ErrInvalidInput, "产品分组缺少 Id", nil)
+		}
+
+		node := &ProductPartitionNode{
+			Id:          id,
+			Condition:   partition.Condition,
+			subdivision: partition.PartitionType == ProductPartitionTypeSubdivision,
+			excluded:    excluded,
+		}
+		if !node.subdivision && !excluded {
+			node.bid = bid
+		}
+		nodes[id] = node
+		parents[id] = partition.ParentCriterionId.Value()
+		ids = append(ids, id)
+	}
+
+	// 按 ID 排序，保证子节点顺序稳定
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		node := nodes[id]
+		parentId := parents[id]
+		if parentId == 0 {
+			if tree.Root != nil {
+				return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("产品分组树有多个根节点: %d 和 %d", tree.Root.Id, id), nil)
+			}
+			tree.Root = node
+			continue
+		}
+
+		parent, ok := nodes[parentId]
+		if !ok {
+			return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("产品分组 %d 的父节点 %d 不存在", id, parentId), nil)
+		}
+		node.parent = parent
+		parent.children = append(parent.children, node)
+	}
+
+	if len(ids) > 0 && tree.Root == nil {
+		return nil, base.NewError(base.ErrInvalidInput, "产品分组树缺少根节点", nil)
+	}
+	tree.snapshot()
+	return tree, nil
+}
+
+// snapshot 将当前树记录为服务端状态
+func (t *ProductPartitionTree) snapshot() {
+	t.original = make(map[int64]productPartitionState)
+	t.walk(func(node *ProductPartitionNode) {
+		var parentId int64
+		if node.parent != nil {
+			parentId = node.parent.Id
+		}
+		t.original[node.Id] = productPartitionState{
+			parentId:    parentId,
+			condition:   node.Condition,
+			subdivision: node.subdivision,
+			excluded:    node.excluded,
+			bid:         node.bid,
+		}
+	})
+}
+
+// walk 按先序遍历所有节点
+func (t *ProductPartitionTree) walk(fn func(node *ProductPartitionNode)) {
+	var visit func(node *ProductPartitionNode)
+	visit = func(node *ProductPartitionNode) {
+		fn(node)
+		for _, child := range node.children {
+			visit(child)
+		}
+	}
+	if t.Root != nil {
+		visit(t.Root)
+	}
+}
+
+// Validate 检查树是否满足产品分组的规则
+//
+// 每个细分节点必须有且只有一个"其他所有"子节点，所有子节点使用相同的维度且 Attribute 不重复。
+func (t *ProductPartitionTree) Validate() error {
+	if t.Root == nil {
+		return base.NewError(base.ErrInvalidInput, "产品分组树没有根节点", nil)
+	}
+	if t.Root.Condition.Operand != ProductConditionOperandAll || t.Root.Condition.Attribute != "" {
+		return base.NewError(base.ErrInvalidInput, "根节点的条件必须为 All", nil)
+	}
+
+	var err error
+	t.walk(func(node *ProductPartitionNode) {
+		if err != nil || !node.subdivision {
+			return
+		}
+		if node.EverythingElse() == nil {
+			err = base.NewError(base.ErrInvalidInput, fmt.Sprintf("细分节点 %s 缺少\"其他所有\"子节点", node.describe()), nil)
+			return
+		}
+		operand := node.EverythingElse().Condition.Operand
+		seen := make(map[string]bool)
+		for _, child := range node.children {
+			if child.Condition.Operand != operand {
+				err = base.NewError(base.ErrInvalidInput, fmt.Sprintf("细分节点 %s 的子节点维度不一致: %q 和 %q", node.describe(), operand, child.Condition.Operand), nil)
+				return
+			}
+			if seen[child.Condition.Attribute] {
+				err = base.NewError(base.ErrInvalidInput, fmt.Sprintf("细分节点 %s 下有重复的子节点 %q", node.describe(), child.Condition.Attribute), nil)
+				return
+			}
+			seen[child.Condition.Attribute] = true
+		}
+	})
+	return err
+}
+
+// describe 返回节点的描述，用于错误信息
+func (n *ProductPartitionNode) describe() string {
+	if n.Condition.Attribute == "" {
+		return n.Condition.Operand
+	}
+	return n.Condition.Operand + "=" + n.Condition.Attribute
+}
+
+// ProductPartitionPlan 表示将树同步到服务端所需的操作
+type ProductPartitionPlan struct {
+	// Actions 依次为删除、新增和更新操作，新增操作中父节点总是在子节点之前
+	Actions []AdGroupCriterionAction
+
+	tree  *ProductPartitionTree
+	nodes []*ProductPartitionNode
+}
+
+// Plan 计算将服务端的产品分组同步为当前树所需的最少操作
+//
+// 只修改出价的叶子节点生成 Update 操作；细分、合并或切换排除状态的节点无法原地修改，
+// 会删除原节点并新增替代节点，其下的子节点也一并重新创建。删除细分节点时服务端会级联
+// 删除其子节点，因此只为最上层被删除的节点生成 Delete 操作。新增的细分节点使用负数
+// 临时 ID，子节点通过 ParentCriterionId 引用。
+func (t *ProductPartitionTree) Plan() (*ProductPartitionPlan, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &ProductPartitionPlan{tree: t}
+	var (
+		adds, updates     []AdGroupCriterionAction
+		addNodes, updated []*ProductPartitionNode
+		kept              = make(map[int64]bool)
+		nextTempId        = int64(-1)
+	)
+
+	var visit func(node *ProductPartitionNode, parentId int64, parentReplaced bool)
+	visit = func(node *ProductPartitionNode, parentId int64, parentReplaced bool) {
+		state, existed := t.original[node.Id]
+		reuse := node.Id > 0 && existed && !parentReplaced &&
+			state.parentId == parentId &&
+			state.condition == node.Condition &&
+			state.subdivision == node.subdivision &&
+			state.excluded == node.excluded
+
+		id := node.Id
+		if reuse {
+			kept[id] = true
+			if !node.subdivision && !node.excluded && node.bid != state.bid {
+				updates = append(updates, AdGroupCriterionAction{
+					Action: ItemActionUpdate,
+					AdGroupCriterion: BiddableAdGroupCriterion{
+						AdGroupCriterionBase: AdGroupCriterionBase{AdGroupId: t.AdGroupId, Id: base.Int64(id)},
+						CriterionBid:         FixedBid{Amount: node.bid},
+					},
+				})
+				updated = append(updated, node)
+			}
+		} else {
+			id = 0
+			if node.subdivision {
+				id = nextTempId
+				nextTempId--
+			}
+			adds = append(adds, AdGroupCriterionAction{Action: ItemActionAdd, AdGroupCriterion: t.criterion(node, id, parentId)})
+			addNodes = append(addNodes, node)
+		}
+
+		for _, child := range node.children {
+			visit(child, id, !reuse)
+		}
+	}
+	visit(t.Root, 0, false)
+
+	// 只删除父节点被保留的节点，其余节点会被级联删除
+	var deleted []int64
+	for id, state := range t.original {
+		if !kept[id] && (state.parentId == 0 || kept[state.parentId]) {
+			deleted = append(deleted, id)
+		}
+	}
+	sort.Slice(deleted, func(i, j int) bool { return deleted[i] < deleted[j] })
+	for _, id := range deleted {
+		criterionBase := AdGroupCriterionBase{AdGroupId: t.AdGroupId, Id: base.Int64(id)}
+		var criterion AdGroupCriterion = BiddableAdGroupCriterion{AdGroupCriterionBase: criterionBase}
+		if t.original[id].excluded {
+			criterion = NegativeAdGroupCriterion{AdGroupCriterionBase: criterionBase}
+		}
+		plan.Actions = append(plan.Actions, AdGroupCriterionAction{Action: ItemActionDelete, AdGroupCriterion: criterion})
+		plan.nodes = append(plan.nodes, nil)
+	}
+
+	plan.Actions = append(plan.Actions, adds...)
+	plan.nodes = append(plan.nodes, addNodes...)
+	plan.Actions = append(plan.Actions, updates...)
+	plan.nodes = append(plan.nodes, updated...)
+	return plan, nil
+}
+
+// criterion 构造新增节点的广告组条件，parentId 为 0 表示根节点
+func (t *ProductPartitionTree) criterion(node *ProductPartitionNode, id, parentId int64) AdGroupCriterion {
+	partition := ProductPartition{
+		Condition:     node.Condition,
+		PartitionType: ProductPartitionTypeUnit,
+	}
+	if node.subdivision {
+		partition.PartitionType = ProductPartitionTypeSubdivision
+	}
+	if parentId != 0 {
+		partition.ParentCriterionId = base.Int64(parentId)
+	}
+
+	criterionBase := AdGroupCriterionBase{AdGroupId: t.AdGroupId, Criterion: partition}
+	if id != 0 {
+		criterionBase.Id = base.Int64(id)
+	}
+
+	switch {
+	case node.excluded:
+		return NegativeAdGroupCriterion{AdGroupCriterionBase: criterionBase}
+	case node.subdivision:
+		return BiddableAdGroupCriterion{AdGroupCriterionBase: criterionBase}
+	default:
+		return BiddableAdGroupCriterion{AdGroupCriterionBase: criterionBase, CriterionBid: FixedBid{Amount: node.bid}}
+	}
+}
+
+// Empty 检查是否没有需要提交的操作
+func (p *ProductPartitionPlan) Empty() bool {
+	return len(p.Actions) == 0
+}
+
+// Commit 在操作全部成功后写回新增节点的 ID，并将当前树记录为服务端状态
+//
+// criterionIds 为 ApplyProductPartitionActions 返回的 ID，与 Actions 一一对应。
+func (p *ProductPartitionPlan) Commit(criterionIds []int64) error {
+	if len(criterionIds) != len(p.Actions) {
+		return base.NewError(base.ErrInvalidResponse, fmt.Sprintf("返回了 %d 个 ID，期望 %d 个", len(criterionIds), len(p.Actions)), nil)
+	}
+	for i, action := range p.Actions {
+		if action.Action == ItemActionAdd {
+			p.nodes[i].Id = criterionIds[i]
+		}
+	}
+	p.tree.snapshot()
+	return nil
+}
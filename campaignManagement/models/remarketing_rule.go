@@ -0,0 +1,234 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// RemarketingRuleType 表示再营销规则的 i:type 类型
+type RemarketingRuleType string
+
+const (
+	RemarketingRuleTypePageVisitors                          RemarketingRuleType = "PageVisitorsRule"
+	RemarketingRuleTypePageVisitorsWhoVisitedAnotherPage     RemarketingRuleType = "PageVisitorsWhoVisitedAnotherPageRule"
+	RemarketingRuleTypePageVisitorsWhoDidNotVisitAnotherPage RemarketingRuleType = "PageVisitorsWhoDidNotVisitAnotherPageRule"
+	RemarketingRuleTypeCustomEvents                          RemarketingRuleType = "CustomEventsRule"
+)
+
+// StringOperator 表示字符串规则项的比较方式
+type StringOperator string
+
+const (
+	StringOperatorEquals           StringOperator = "Equals"
+	StringOperatorContains         StringOperator = "Contains"
+	StringOperatorBeginsWith       StringOperator = "BeginsWith"
+	StringOperatorEndsWith         StringOperator = "EndsWith"
+	StringOperatorNotEquals        StringOperator = "NotEquals"
+	StringOperatorDoesNotContain   StringOperator = "DoesNotContain"
+	StringOperatorDoesNotBeginWith StringOperator = "DoesNotBeginWith"
+	StringOperatorDoesNotEndWith   StringOperator = "DoesNotEndWith"
+)
+
+// NumberOperator 表示数值的比较方式
+type NumberOperator string
+
+const (
+	NumberOperatorEquals             NumberOperator = "Equals"
+	NumberOperatorGreaterThan        NumberOperator = "GreaterThan"
+	NumberOperatorLessThan           NumberOperator = "LessThan"
+	NumberOperatorGreaterThanEqualTo NumberOperator = "GreaterThanEqualTo"
+	NumberOperatorLessThanEqualTo    NumberOperator = "LessThanEqualTo"
+)
+
+// StringRuleItem 表示按字符串匹配的规则项，Operand 通常为 Url 或 ReferrerUrl
+type StringRuleItem struct {
+	Operand  string         `xml:"Operand"`
+	Operator StringOperator `xml:"Operator"`
+	Value    string         `xml:"Value"`
+}
+
+// MarshalXML 自定义 StringRuleItem 的 XML 序列化，加上 i:type 属性
+func (item StringRuleItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type ruleItem StringRuleItem
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: "StringRuleItem"})
+	return e.EncodeElement(ruleItem(item), start)
+}
+
+// RuleItemGroup 表示一组规则项，组内的规则项之间为"且"的关系，组之间为"或"的关系
+type RuleItemGroup struct {
+	Items []StringRuleItem `xml:"Items>RuleItem"`
+}
+
+// RemarketingRule 表示一个具体类型的再营销规则
+//
+// 该接口是封闭的，只能由本包中的 *Rule 类型和 UnknownRemarketingRule 实现。
+type RemarketingRule interface {
+	// RemarketingRuleType 返回再营销规则的 i:type 类型
+	RemarketingRuleType() RemarketingRuleType
+
+	isRemarketingRule()
+}
+
+// PageVisitorsRule 表示访问过指定页面的用户
+type PageVisitorsRule struct {
+	RuleItemGroups []RuleItemGroup `xml:"RuleItemGroups>RuleItemGroup"`
+}
+
+// PageVisitorsWhoVisitedAnotherPageRule 表示访问过指定页面且访问过另一页面的用户
+type PageVisitorsWhoVisitedAnotherPageRule struct {
+	AnotherRuleItemGroups []RuleItemGroup `xml:"AnotherRuleItemGroups>RuleItemGroup"`
+	RuleItemGroups        []RuleItemGroup `xml:"RuleItemGroups>RuleItemGroup"`
+}
+
+// PageVisitorsWhoDidNotVisitAnotherPageRule 表示访问过指定页面但没有访问另一页面的用户
+type PageVisitorsWhoDidNotVisitAnotherPageRule struct {
+	ExcludeRuleItemGroups []RuleItemGroup `xml:"ExcludeRuleItemGroups>RuleItemGroup"`
+	IncludeRuleItemGroups []RuleItemGroup `xml:"IncludeRuleItemGroups>RuleItemGroup"`
+}
+
+// CustomEventsRule 表示触发过指定自定义事件的用户，未设置的条件不参与匹配
+type CustomEventsRule struct {
+	Action           base.Nillable[string]         `xml:"Action"`
+	ActionOperator   base.Nillable[StringOperator] `xml:"ActionOperator"`
+	Category         base.Nillable[string]         `xml:"Category"`
+	CategoryOperator base.Nillable[StringOperator] `xml:"CategoryOperator"`
+	Label            base.Nillable[string]         `xml:"Label"`
+	LabelOperator    base.Nillable[StringOperator] `xml:"LabelOperator"`
+	Value            base.Nillable[float64]        `xml:"Value"`
+	ValueOperator    base.Nillable[NumberOperator] `xml:"ValueOperator"`
+}
+
+// UnknownRemarketingRule 保存无法识别类型的再营销规则的原始 XML，用于向前兼容
+type UnknownRemarketingRule struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (PageVisitorsRule) isRemarketingRule()                          {}
+func (PageVisitorsWhoVisitedAnotherPageRule) isRemarketingRule()     {}
+func (PageVisitorsWhoDidNotVisitAnotherPageRule) isRemarketingRule() {}
+func (CustomEventsRule) isRemarketingRule()                          {}
+func (UnknownRemarketingRule) isRemarketingRule()                    {}
+
+// RemarketingRuleType 返回再营销规则的 i:type 类型
+func (PageVisitorsRule) RemarketingRuleType() RemarketingRuleType {
+	return RemarketingRuleTypePageVisitors
+}
+
+// RemarketingRuleType 返回再营销规则的 i:type 类型
+func (PageVisitorsWhoVisitedAnotherPageRule) RemarketingRuleType() RemarketingRuleType {
+	return RemarketingRuleTypePageVisitorsWhoVisitedAnotherPage
+}
+
+// RemarketingRuleType 返回再营销规则的 i:type 类型
+func (PageVisitorsWhoDidNotVisitAnotherPageRule) RemarketingRuleType() RemarketingRuleType {
+	return RemarketingRuleTypePageVisitorsWhoDidNotVisitAnotherPage
+}
+
+// RemarketingRuleType 返回再营销规则的 i:type 类型
+func (CustomEventsRule) RemarketingRuleType() RemarketingRuleType {
+	return RemarketingRuleTypeCustomEvents
+}
+
+// RemarketingRuleType 返回再营销规则的 i:type 类型
+func (r UnknownRemarketingRule) RemarketingRuleType() RemarketingRuleType {
+	return RemarketingRuleType(r.TypeName)
+}
+
+// MarshalXML 自定义 PageVisitorsRule 的 XML 序列化
+func (r PageVisitorsRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type rule PageVisitorsRule
+	return encodeRemarketingRule(e, start, r.RemarketingRuleType(), rule(r))
+}
+
+// MarshalXML 自定义 PageVisitorsWhoVisitedAnotherPageRule 的 XML 序列化
+func (r PageVisitorsWhoVisitedAnotherPageRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type rule PageVisitorsWhoVisitedAnotherPageRule
+	return encodeRemarketingRule(e, start, r.RemarketingRuleType(), rule(r))
+}
+
+// MarshalXML 自定义 PageVisitorsWhoDidNotVisitAnotherPageRule 的 XML 序列化
+func (r PageVisitorsWhoDidNotVisitAnotherPageRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type rule PageVisitorsWhoDidNotVisitAnotherPageRule
+	return encodeRemarketingRule(e, start, r.RemarketingRuleType(), rule(r))
+}
+
+// MarshalXML 自定义 CustomEventsRule 的 XML 序列化
+func (r CustomEventsRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type rule CustomEventsRule
+	return encodeRemarketingRule(e, start, r.RemarketingRuleType(), rule(r))
+}
+
+// MarshalXML 自定义 UnknownRemarketingRule 的 XML 序列化，原样输出保存的 XML
+func (r UnknownRemarketingRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.TypeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: r.TypeName})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := common.CopyRawXML(e, r.InnerXML); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeRemarketingRule 编码再营销规则并加上 i:type 属性
+func encodeRemarketingRule(e *xml.Encoder, start xml.StartElement, typeName RemarketingRuleType, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: string(typeName)})
+	return e.EncodeElement(v, start)
+}
+
+// DecodeRemarketingRule 根据 i:type 属性将 Rule 元素解码为具体类型
+//
+// 无法识别的类型解码为 UnknownRemarketingRule，元素为 i:nil 时返回 nil。
+func DecodeRemarketingRule(d *xml.Decoder, start xml.StartElement) (RemarketingRule, error) {
+	if base.IsNilElement(start) {
+		return nil, d.Skip()
+	}
+
+	switch typeName := base.XSIType(start); RemarketingRuleType(typeName) {
+	case RemarketingRuleTypePageVisitors:
+		return decodeRemarketingRule[PageVisitorsRule](d, start)
+	case RemarketingRuleTypePageVisitorsWhoVisitedAnotherPage:
+		return decodeRemarketingRule[PageVisitorsWhoVisitedAnotherPageRule](d, start)
+	case RemarketingRuleTypePageVisitorsWhoDidNotVisitAnotherPage:
+		return decodeRemarketingRule[PageVisitorsWhoDidNotVisitAnotherPageRule](d, start)
+	case RemarketingRuleTypeCustomEvents:
+		return decodeRemarketingRule[CustomEventsRule](d, start)
+	default:
+		unknown, err := decodeRemarketingRule[UnknownRemarketingRule](d, start)
+		if err != nil {
+			return nil, err
+		}
+		rule := unknown.(UnknownRemarketingRule)
+		rule.TypeName = typeName
+		return rule, nil
+	}
+}
+
+// decodeRemarketingRule 将元素解码为指定类型的再营销规则
+func decodeRemarketingRule[T RemarketingRule](d *xml.Decoder, start xml.StartElement) (RemarketingRule, error) {
+	var rule T
+	if err := d.DecodeElement(&rule, &start); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// remarketingRuleElement 用于在结构体字段中解码多态的 RemarketingRule
+type remarketingRuleElement struct {
+	rule RemarketingRule
+}
+
+// UnmarshalXML 实现 xml.Unmarshaler 接口
+func (el *remarketingRuleElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	rule, err := DecodeRemarketingRule(d, start)
+	if err != nil {
+		return err
+	}
+	el.rule = rule
+	return nil
+}
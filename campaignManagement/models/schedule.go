@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// Date 表示不带时区的日期
+type Date struct {
+	Day   int `xml:"Day"`
+	Month int `xml:"Month"`
+	Year  int `xml:"Year"`
+}
+
+// NewDate 根据 time.Time 创建日期
+func NewDate(t time.Time) *Date {
+	return &Date{Day: t.Day(), Month: int(t.Month()), Year: t.Year()}
+}
+
+// Time 返回该日期零点的 UTC 时间
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Day 表示星期几
+type Day string
+
+const (
+	DayMonday    Day = "Monday"
+	DayTuesday   Day = "Tuesday"
+	DayWednesday Day = "Wednesday"
+	DayThursday  Day = "Thursday"
+	DayFriday    Day = "Friday"
+	DaySaturday  Day = "Saturday"
+	DaySunday    Day = "Sunday"
+)
+
+// Minute 表示整点之后的分钟数，只能为 0、15、30 或 45
+type Minute string
+
+const (
+	MinuteZero      Minute = "Zero"
+	MinuteFifteen   Minute = "Fifteen"
+	MinuteThirty    Minute = "Thirty"
+	MinuteFortyFive Minute = "FortyFive"
+)
+
+// DayTime 表示一周中某一天的投放时段
+type DayTime struct {
+	Day         Day    `xml:"Day"`
+	EndHour     int    `xml:"EndHour"`
+	EndMinute   Minute `xml:"EndMinute"`
+	StartHour   int    `xml:"StartHour"`
+	StartMinute Minute `xml:"StartMinute"`
+}
+
+// Schedule 表示投放排期，未设置的字段表示不限制
+type Schedule struct {
+	DayTimeRanges       []DayTime           `xml:"DayTimeRanges>DayTime,omitempty"`
+	EndDate             *Date               `xml:"EndDate,omitempty"`
+	StartDate           *Date               `xml:"StartDate,omitempty"`
+	UseSearcherTimeZone base.Nillable[bool] `xml:"UseSearcherTimeZone"`
+}
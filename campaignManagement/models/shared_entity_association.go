@@ -0,0 +1,33 @@
+package models
+
+import "encoding/xml"
+
+// SetSharedEntityAssociationsRequest 请求结构体
+type SetSharedEntityAssociationsRequest struct {
+	XMLName           xml.Name                  `xml:"SetSharedEntityAssociationsRequest"`
+	Namespace         string                    `xml:"xmlns,attr"`
+	Associations      []SharedEntityAssociation `xml:"Associations>SharedEntityAssociation"`
+	SharedEntityScope EntityScope               `xml:"SharedEntityScope,omitempty"`
+}
+
+// SetSharedEntityAssociationsResponse 响应结构体
+type SetSharedEntityAssociationsResponse struct {
+	XMLName       xml.Name     `xml:"SetSharedEntityAssociationsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// DeleteSharedEntityAssociationsRequest 请求结构体
+type DeleteSharedEntityAssociationsRequest struct {
+	XMLName           xml.Name                  `xml:"DeleteSharedEntityAssociationsRequest"`
+	Namespace         string                    `xml:"xmlns,attr"`
+	Associations      []SharedEntityAssociation `xml:"Associations>SharedEntityAssociation"`
+	SharedEntityScope EntityScope               `xml:"SharedEntityScope,omitempty"`
+}
+
+// DeleteSharedEntityAssociationsResponse 响应结构体
+type DeleteSharedEntityAssociationsResponse struct {
+	XMLName       xml.Name     `xml:"DeleteSharedEntityAssociationsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
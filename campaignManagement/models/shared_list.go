@@ -3,23 +3,27 @@ package models
 import (
 	"encoding/xml"
 	"fmt"
+
+	"github.com/vancevox/bingads-go/base"
 )
 
 // SharedEntity 表示共享实体的基础类型
+//
+// Name 和 Type 保持为 string：共享实体必须有名称和类型，API 不允许清空，空字符串只表示未设置。
 type SharedEntity struct {
-	AssociationCount        int                          `xml:"AssociationCount,omitempty"`
+	AssociationCount        base.Nillable[int]           `xml:"AssociationCount"`
 	ForwardCompatibilityMap []KeyValuePairOfstringstring `xml:"ForwardCompatibilityMap>KeyValuePairOfstringstring,omitempty"`
-	Id                      int64                        `xml:"Id,omitempty"`
+	Id                      base.Nillable[int64]         `xml:"Id"`
 	Name                    string                       `xml:"Name,omitempty"`
 	Type                    string                       `xml:"Type,omitempty"`
-	ItemCount               int                          `xml:"ItemCount,omitempty"`
+	ItemCount               base.Nillable[int]           `xml:"ItemCount"`
 }
 
 // SharedList 表示共享列表
 type SharedList struct {
 	SharedEntity
-	ItemCount int    `xml:"ItemCount,omitempty"`
-	ItemType  string `xml:"i:type,attr,omitempty"` // 用于指定具体类型
+	ItemCount base.Nillable[int] `xml:"ItemCount"`
+	ItemType  string             `xml:"i:type,attr,omitempty"` // 用于指定具体类型
 }
 
 // NegativeKeywordList 表示负面关键词列表
@@ -32,27 +36,64 @@ type PlacementExclusionList struct {
 	SharedList
 }
 
-// SharedListItem 表示共享列表项
-type SharedListItemBase struct {
-	XMLName                 xml.Name `xml:"SharedListItem"`
-	Type                    string   `xml:"i:type,attr,omitempty"`
-	ForwardCompatibilityMap string   `xml:"ForwardCompatibilityMap"`
-	ListItemType            string   `xml:"ListItemType"`
+// SharedListLike 表示可以作为共享列表参数传入的类型
+//
+// NegativeKeywordList、PlacementExclusionList、BrandList 以及各 Account* 列表类型
+// 都实现了该接口，直接使用 SharedList 时需要自行设置 ItemType。
+type SharedListLike interface {
+	// AsSharedList 返回设置好 i:type 的 SharedList
+	AsSharedList() SharedList
+}
+
+// AsSharedList 返回 SharedList 本身，i:type 由 ItemType 决定
+func (s SharedList) AsSharedList() SharedList {
+	return s
+}
+
+// AsSharedList 返回 i:type 为 NegativeKeywordList 的 SharedList
+func (l NegativeKeywordList) AsSharedList() SharedList {
+	return l.SharedList.withItemType(SharedEntityTypeNegativeKeywordList)
+}
+
+// AsSharedList 返回 i:type 为 PlacementExclusionList 的 SharedList
+func (l PlacementExclusionList) AsSharedList() SharedList {
+	return l.SharedList.withItemType(SharedEntityTypePlacementExclusionList)
 }
 
-// NegativeKeyword 表示负面关键词
-type NegativeKeyword struct {
-	SharedListItemBase
-	Id        int64  `xml:"Id"`
-	MatchType string `xml:"MatchType"`
-	Text      string `xml:"Text"`
+// AsSharedList 返回 i:type 为 AccountNegativeKeywordList 的 SharedList
+func (l AccountNegativeKeywordList) AsSharedList() SharedList {
+	return l.SharedList.withItemType(SharedEntityTypeAccountNegativeKeywordList)
 }
 
-// NegativeSite 表示负面站点
-type NegativeSite struct {
-	SharedListItemBase
-	Id  int64  `xml:"Id"`
-	Url string `xml:"Url"`
+// AsSharedList 返回 i:type 为 BrandList 的 SharedList
+func (l BrandList) AsSharedList() SharedList {
+	return l.SharedList.withItemType(SharedEntityTypeBrandList)
+}
+
+// AsSharedList 返回 i:type 为 AccountPlacementExclusionList 的 SharedList
+func (l AccountPlacementExclusionList) AsSharedList() SharedList {
+	return l.SharedList.withItemType(SharedEntityTypeAccountPlacementExclusionList)
+}
+
+// AsSharedList 返回 i:type 为 AccountPlacementInclusionList 的 SharedList
+func (l AccountPlacementInclusionList) AsSharedList() SharedList {
+	return l.SharedList.withItemType(SharedEntityTypeAccountPlacementInclusionList)
+}
+
+// withItemType 返回设置了指定 i:type 的副本
+func (s SharedList) withItemType(entityType SharedEntityType) SharedList {
+	s.ItemType = string(entityType)
+	return s
+}
+
+// SharedListFromAny 将任意类型的共享列表参数转换为 SharedListLike
+//
+// Deprecated: 直接使用实现了 SharedListLike 的类型，类型错误会在编译期发现。
+func SharedListFromAny(sharedList any) (SharedListLike, error) {
+	if list, ok := sharedList.(SharedListLike); ok {
+		return list, nil
+	}
+	return nil, fmt.Errorf("不支持的共享列表类型: %T", sharedList)
 }
 
 // GetListItemsBySharedListRequest 请求结构体
@@ -64,28 +105,31 @@ type GetListItemsBySharedListRequest struct {
 }
 
 // SharedListItem 共享列表项基础结构
+//
+// MatchType、Text 和 Url 保持为 string：列表项只能添加和删除，没有更新操作，这些字段不需要
+// 表示"清空"，空字符串只表示未设置。Id 和 BrandId 为 0 时仍有意义，因此使用 base.Nillable。
 type SharedListItem struct {
 	Type                    SharedListItemType           `xml:"Type"`
 	ForwardCompatibilityMap []KeyValuePairOfstringstring `xml:"ForwardCompatibilityMap>KeyValuePairOfstringstring,omitempty"`
 	ItemType                string                       `xml:"i:type,attr,omitempty"`
 
 	// NegativeKeyword 类型的字段
-	ID        int64  `xml:"Id,omitempty"`
-	MatchType string `xml:"MatchType,omitempty"`
-	Text      string `xml:"Text,omitempty"`
+	ID        base.Nillable[int64] `xml:"Id"`
+	MatchType string               `xml:"MatchType,omitempty"`
+	Text      string               `xml:"Text,omitempty"`
 
 	// NegativeSite 或 Site 类型的字段
 	Url string `xml:"Url,omitempty"`
 
 	// BrandItem 类型的字段
-	BrandId int64 `xml:"BrandId,omitempty"`
+	BrandId base.Nillable[int64] `xml:"BrandId"`
 }
 
 // GetListItemsBySharedListResponse 响应结构体
 type GetListItemsBySharedListResponse struct {
-	XMLName   xml.Name         `xml:"GetListItemsBySharedListResponse"`
-	Namespace string           `xml:"xmlns,attr"`
-	ListItems []SharedListItem `xml:"ListItems>SharedListItem,omitempty"`
+	XMLName   xml.Name  `xml:"GetListItemsBySharedListResponse"`
+	Namespace string    `xml:"xmlns,attr"`
+	ListItems ListItems `xml:"ListItems"`
 }
 
 // MarshalXML 自定义 SharedList 的 XML 序列化
@@ -158,19 +202,13 @@ func (s SharedList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		}
 	}
 
-	// 编码 Id 元素
+	// 编码 Id 元素（未设置时省略，置空时输出 i:nil）
 	idStart := xml.StartElement{Name: xml.Name{Local: "Id"}}
-	if err := e.EncodeToken(idStart); err != nil {
-		return err
-	}
-	if err := e.EncodeToken(xml.CharData(fmt.Sprintf("%d", s.Id))); err != nil {
-		return err
-	}
-	if err := e.EncodeToken(idStart.End()); err != nil {
+	if err := e.EncodeElement(s.Id, idStart); err != nil {
 		return err
 	}
 
-	// 编码 Name 元素 (如果存在)
+	// 编码 Name 元素（名称不能清空，为空时省略）
 	if s.Name != "" {
 		nameStart := xml.StartElement{Name: xml.Name{Local: "Name"}}
 		if err := e.EncodeToken(nameStart); err != nil {
@@ -279,17 +317,9 @@ func (item SharedListItem) MarshalXML(e *xml.Encoder, start xml.StartElement) er
 
 	// 根据不同类型编码不同字段
 	// 对于 NegativeKeyword 类型
-	if item.ID != 0 {
-		idStart := xml.StartElement{Name: xml.Name{Local: "Id"}}
-		if err := e.EncodeToken(idStart); err != nil {
-			return err
-		}
-		if err := e.EncodeToken(xml.CharData(fmt.Sprintf("%d", item.ID))); err != nil {
-			return err
-		}
-		if err := e.EncodeToken(idStart.End()); err != nil {
-			return err
-		}
+	idStart := xml.StartElement{Name: xml.Name{Local: "Id"}}
+	if err := e.EncodeElement(item.ID, idStart); err != nil {
+		return err
 	}
 
 	if item.MatchType != "" {
@@ -333,17 +363,9 @@ func (item SharedListItem) MarshalXML(e *xml.Encoder, start xml.StartElement) er
 	}
 
 	// 对于 BrandItem 类型
-	if item.BrandId != 0 {
-		brandIdStart := xml.StartElement{Name: xml.Name{Local: "BrandId"}}
-		if err := e.EncodeToken(brandIdStart); err != nil {
-			return err
-		}
-		if err := e.EncodeToken(xml.CharData(fmt.Sprintf("%d", item.BrandId))); err != nil {
-			return err
-		}
-		if err := e.EncodeToken(brandIdStart.End()); err != nil {
-			return err
-		}
+	brandIdStart := xml.StartElement{Name: xml.Name{Local: "BrandId"}}
+	if err := e.EncodeElement(item.BrandId, brandIdStart); err != nil {
+		return err
 	}
 
 	// 结束 SharedListItem 元素
@@ -410,6 +432,26 @@ const (
 	SharedEntityTypeBrandList                     SharedEntityType = "BrandList"
 )
 
+// SharedEntityTypes 为所有已知的共享实体类型
+var SharedEntityTypes = []SharedEntityType{
+	SharedEntityTypeNegativeKeywordList,
+	SharedEntityTypePlacementExclusionList,
+	SharedEntityTypeAccountNegativeKeywordList,
+	SharedEntityTypeAccountPlacementExclusionList,
+	SharedEntityTypeAccountPlacementInclusionList,
+	SharedEntityTypeBrandList,
+}
+
+// Valid 检查是否为已知的共享实体类型
+func (t SharedEntityType) Valid() bool {
+	for _, known := range SharedEntityTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSharedEntitiesRequest 请求结构体
 type GetSharedEntitiesRequest struct {
 	XMLName           xml.Name         `xml:"GetSharedEntitiesRequest"`
@@ -480,6 +522,7 @@ type EntityType string
 const (
 	EntityTypeCampaign EntityType = "Campaign"
 	EntityTypeAccount  EntityType = "Account"
+	EntityTypeAdGroup  EntityType = "AdGroup"
 )
 
 // SharedEntityAssociation 表示共享实体关联
@@ -494,15 +537,24 @@ type SharedEntityAssociation struct {
 // BatchError 表示批处理错误
 type BatchError struct {
 	Code                    int                           `xml:"Code"`
-	Details                 *string                       `xml:"Details,omitempty"`
+	Details                 base.Nillable[string]         `xml:"Details"`
 	ErrorCode               string                        `xml:"ErrorCode,omitempty"`
-	FieldPath               *string                       `xml:"FieldPath,omitempty"`
+	FieldPath               base.Nillable[string]         `xml:"FieldPath"`
 	ForwardCompatibilityMap *[]KeyValuePairOfstringstring `xml:"ForwardCompatibilityMap>KeyValuePairOfstringstring,omitempty"`
 	Index                   int                           `xml:"Index"`
 	Message                 string                        `xml:"Message,omitempty"`
 	Type                    string                        `xml:"Type,omitempty"`
 }
 
+// BatchOptions 分批请求选项
+type BatchOptions struct {
+	// 每批最多的项目数，<=0 或超过 API 上限时使用 API 上限
+	BatchSize int
+
+	// 最大并发请求数，<=0 时串行执行
+	Concurrency int
+}
+
 // GetSharedEntityAssociationsBySharedEntityIdsRequest 请求结构体
 type GetSharedEntityAssociationsBySharedEntityIdsRequest struct {
 	XMLName           xml.Name         `xml:"GetSharedEntityAssociationsBySharedEntityIdsRequest"`
@@ -628,7 +680,7 @@ type AddListItemsToSharedListRequest struct {
 type AddListItemsToSharedListResponse struct {
 	XMLName       xml.Name     `xml:"AddListItemsToSharedListResponse"`
 	Namespace     string       `xml:"xmlns,attr"`
-	ListItemIds   []int64      `xml:"ListItemIds>a:long,omitempty"`
+	ListItemIds   []int64      `xml:"ListItemIds>long,omitempty"`
 	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
 }
 
@@ -741,17 +793,9 @@ func (req AddListItemsToSharedListRequest) MarshalXML(e *xml.Encoder, start xml.
 
 			// 根据不同类型编码不同字段
 			// 对于 NegativeKeyword 类型
-			if item.ID != 0 {
-				idStart := xml.StartElement{Name: xml.Name{Local: "Id"}}
-				if err := e.EncodeToken(idStart); err != nil {
-					return err
-				}
-				if err := e.EncodeToken(xml.CharData(fmt.Sprintf("%d", item.ID))); err != nil {
-					return err
-				}
-				if err := e.EncodeToken(idStart.End()); err != nil {
-					return err
-				}
+			idStart := xml.StartElement{Name: xml.Name{Local: "Id"}}
+			if err := e.EncodeElement(item.ID, idStart); err != nil {
+				return err
 			}
 
 			if item.MatchType != "" {
@@ -795,17 +839,9 @@ func (req AddListItemsToSharedListRequest) MarshalXML(e *xml.Encoder, start xml.
 			}
 
 			// 对于 BrandItem 类型
-			if item.BrandId != 0 {
-				brandIdStart := xml.StartElement{Name: xml.Name{Local: "BrandId"}}
-				if err := e.EncodeToken(brandIdStart); err != nil {
-					return err
-				}
-				if err := e.EncodeToken(xml.CharData(fmt.Sprintf("%d", item.BrandId))); err != nil {
-					return err
-				}
-				if err := e.EncodeToken(brandIdStart.End()); err != nil {
-					return err
-				}
+			brandIdStart := xml.StartElement{Name: xml.Name{Local: "BrandId"}}
+			if err := e.EncodeElement(item.BrandId, brandIdStart); err != nil {
+				return err
 			}
 
 			// 结束 SharedListItem 元素
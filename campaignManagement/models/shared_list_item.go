@@ -0,0 +1,329 @@
+package models
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// ListItem 表示一个具体类型的共享列表项
+//
+// 该接口是封闭的，只能由本包中的 NegativeKeyword、NegativeSite、
+// BrandItem 和 UnknownListItem 实现。
+type ListItem interface {
+	// ListItemType 返回列表项的 i:type 类型
+	ListItemType() SharedListItemType
+
+	// ToSharedListItem 转换为扁平的 SharedListItem
+	ToSharedListItem() SharedListItem
+
+	isListItem()
+}
+
+// SharedListItemBase 共享列表项的公共字段
+type SharedListItemBase struct {
+	ForwardCompatibilityMap []KeyValuePairOfstringstring `xml:"ForwardCompatibilityMap>KeyValuePairOfstringstring,omitempty"`
+	Type                    SharedListItemType           `xml:"Type,omitempty"`
+}
+
+// NegativeKeyword 表示负面关键词
+type NegativeKeyword struct {
+	SharedListItemBase
+	Id        base.Nillable[int64] `xml:"Id"`
+	MatchType string               `xml:"MatchType,omitempty"`
+	Text      string               `xml:"Text,omitempty"`
+}
+
+// NegativeSite 表示负面站点
+type NegativeSite struct {
+	SharedListItemBase
+	Id  base.Nillable[int64] `xml:"Id"`
+	Url string               `xml:"Url,omitempty"`
+}
+
+// BrandItem 表示品牌列表中的品牌
+type BrandItem struct {
+	SharedListItemBase
+	BrandId base.Nillable[int64] `xml:"BrandId"`
+	Id      base.Nillable[int64] `xml:"Id"`
+}
+
+// UnknownListItem 保存无法识别类型的列表项的原始 XML，用于向前兼容
+type UnknownListItem struct {
+	TypeName string `xml:"-"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (NegativeKeyword) isListItem() {}
+func (NegativeSite) isListItem()    {}
+func (BrandItem) isListItem()       {}
+func (UnknownListItem) isListItem() {}
+
+// ListItemType 返回列表项的 i:type 类型
+func (NegativeKeyword) ListItemType() SharedListItemType {
+	return SharedListItemTypeNegativeKeyword
+}
+
+// ListItemType 返回列表项的 i:type 类型
+func (NegativeSite) ListItemType() SharedListItemType {
+	return SharedListItemTypeNegativeSite
+}
+
+// ListItemType 返回列表项的 i:type 类型
+func (BrandItem) ListItemType() SharedListItemType {
+	return SharedListItemTypeBrandItem
+}
+
+// ListItemType 返回列表项的 i:type 类型
+func (item UnknownListItem) ListItemType() SharedListItemType {
+	return SharedListItemType(item.TypeName)
+}
+
+// ToSharedListItem 转换为扁平的 SharedListItem
+func (item NegativeKeyword) ToSharedListItem() SharedListItem {
+	return SharedListItem{
+		Type:                    item.listType(SharedListItemTypeNegativeKeyword),
+		ForwardCompatibilityMap: item.ForwardCompatibilityMap,
+		ItemType:                string(SharedListItemTypeNegativeKeyword),
+		ID:                      item.Id,
+		MatchType:               item.MatchType,
+		Text:                    item.Text,
+	}
+}
+
+// ToSharedListItem 转换为扁平的 SharedListItem
+func (item NegativeSite) ToSharedListItem() SharedListItem {
+	return SharedListItem{
+		Type:                    item.listType(SharedListItemTypeNegativeSite),
+		ForwardCompatibilityMap: item.ForwardCompatibilityMap,
+		ItemType:                string(SharedListItemTypeNegativeSite),
+		ID:                      item.Id,
+		Url:                     item.Url,
+	}
+}
+
+// ToSharedListItem 转换为扁平的 SharedListItem
+func (item BrandItem) ToSharedListItem() SharedListItem {
+	return SharedListItem{
+		Type:                    item.listType(SharedListItemTypeBrandItem),
+		ForwardCompatibilityMap: item.ForwardCompatibilityMap,
+		ItemType:                string(SharedListItemTypeBrandItem),
+		ID:                      item.Id,
+		BrandId:                 item.BrandId,
+	}
+}
+
+// ToSharedListItem 转换为扁平的 SharedListItem
+//
+// 原始 XML 中 SharedListItem 已有的字段（如 Id、Text、Url）会被解析，其余字段会丢失，
+// 需要完整保留时应直接使用 UnknownListItem。
+func (item UnknownListItem) ToSharedListItem() SharedListItem {
+	var flat SharedListItem
+	// 原始 XML 来自已经成功解码的元素，无法解析时只保留类型
+	_ = xml.Unmarshal([]byte("<SharedListItem>"+item.InnerXML+"</SharedListItem>"), &flat)
+	flat.ItemType = item.TypeName
+	if flat.Type == "" {
+		flat.Type = SharedListItemType(item.TypeName)
+	}
+	return flat
+}
+
+// listType 返回 Type 元素的值，未设置时使用默认类型
+func (b SharedListItemBase) listType(def SharedListItemType) SharedListItemType {
+	if b.Type != "" {
+		return b.Type
+	}
+	return def
+}
+
+// Typed 将扁平的 SharedListItem 转换为具体类型的列表项
+func (item SharedListItem) Typed() (ListItem, error) {
+	typeName := item.ItemType
+	if typeName == "" {
+		typeName = string(item.Type)
+	}
+
+	itemBase := SharedListItemBase{
+		ForwardCompatibilityMap: item.ForwardCompatibilityMap,
+		Type:                    item.Type,
+	}
+
+	switch SharedListItemType(typeName) {
+	case SharedListItemTypeNegativeKeyword:
+		return NegativeKeyword{SharedListItemBase: itemBase, Id: item.ID, MatchType: item.MatchType, Text: item.Text}, nil
+	case SharedListItemTypeNegativeSite:
+		return NegativeSite{SharedListItemBase: itemBase, Id: item.ID, Url: item.Url}, nil
+	case SharedListItemTypeBrandItem:
+		return BrandItem{SharedListItemBase: itemBase, BrandId: item.BrandId, Id: item.ID}, nil
+	default:
+		return nil, &UnknownListItemTypeError{TypeName: typeName}
+	}
+}
+
+// UnknownListItemTypeError 表示遇到了无法识别的共享列表项类型
+type UnknownListItemTypeError struct {
+	TypeName string
+}
+
+// Error 实现 error 接口
+func (e *UnknownListItemTypeError) Error() string {
+	return fmt.Sprintf("未知的共享列表项类型: %q", e.TypeName)
+}
+
+// ListItemDecoder 根据 i:type 属性将共享列表项解码为具体类型
+type ListItemDecoder struct {
+	// PreserveUnknown 为 true 时将未知类型保留为 UnknownListItem，否则返回 UnknownListItemTypeError
+	PreserveUnknown bool
+}
+
+// DecodeElement 解码单个 SharedListItem 元素
+func (dec ListItemDecoder) DecodeElement(d *xml.Decoder, start xml.StartElement) (ListItem, error) {
+	typeName := base.XSIType(start)
+
+	switch SharedListItemType(typeName) {
+	case SharedListItemTypeNegativeKeyword:
+		var item NegativeKeyword
+		if err := d.DecodeElement(&item, &start); err != nil {
+			return nil, err
+		}
+		return item, nil
+	case SharedListItemTypeNegativeSite:
+		var item NegativeSite
+		if err := d.DecodeElement(&item, &start); err != nil {
+			return nil, err
+		}
+		return item, nil
+	case SharedListItemTypeBrandItem:
+		var item BrandItem
+		if err := d.DecodeElement(&item, &start); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+
+	if !dec.PreserveUnknown {
+		if err := d.Skip(); err != nil {
+			return nil, err
+		}
+		return nil, &UnknownListItemTypeError{TypeName: typeName}
+	}
+
+	var item UnknownListItem
+	if err := d.DecodeElement(&item, &start); err != nil {
+		return nil, err
+	}
+	item.TypeName = typeName
+	return item, nil
+}
+
+// Decode 解码 ListItems 元素下的所有 SharedListItem 子元素
+func (dec ListItemDecoder) Decode(d *xml.Decoder, start xml.StartElement) (ListItems, error) {
+	var items ListItems
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			item, err := dec.DecodeElement(d, t)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		case xml.EndElement:
+			// 子元素已经被完整解码，遇到的结束标签即为 start 的结束标签
+			return items, nil
+		}
+	}
+}
+
+// ListItems 表示具体类型的共享列表项集合
+//
+// 反序列化时未知类型会被保留为 UnknownListItem，可以通过 Validate 检查。
+type ListItems []ListItem
+
+// UnmarshalXML 自定义 ListItems 的 XML 反序列化
+func (l *ListItems) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	items, err := ListItemDecoder{PreserveUnknown: true}.Decode(d, start)
+	if err != nil {
+		return err
+	}
+	*l = append(*l, items...)
+	return nil
+}
+
+// Validate 检查集合中是否存在未知类型的列表项
+func (l ListItems) Validate() error {
+	for _, item := range l {
+		if unknown, ok := item.(UnknownListItem); ok {
+			return &UnknownListItemTypeError{TypeName: unknown.TypeName}
+		}
+	}
+	return nil
+}
+
+// Unknown 返回集合中所有未知类型的列表项
+func (l ListItems) Unknown() []UnknownListItem {
+	var unknown []UnknownListItem
+	for _, item := range l {
+		if u, ok := item.(UnknownListItem); ok {
+			unknown = append(unknown, u)
+		}
+	}
+	return unknown
+}
+
+// SharedListItems 将集合转换为扁平的 SharedListItem 列表
+//
+// 扁平结构无法保存未知类型的全部字段，集合中存在 UnknownListItem 时返回
+// UnknownListItemTypeError，此时应通过 Unknown 获取原始 XML。
+func (l ListItems) SharedListItems() ([]SharedListItem, error) {
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	items := make([]SharedListItem, 0, len(l))
+	for _, item := range l {
+		items = append(items, item.ToSharedListItem())
+	}
+	return items, nil
+}
+
+// MarshalXML 自定义 NegativeKeyword 的 XML 序列化
+func (item NegativeKeyword) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(item.ToSharedListItem(), start)
+}
+
+// MarshalXML 自定义 NegativeSite 的 XML 序列化
+func (item NegativeSite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(item.ToSharedListItem(), start)
+}
+
+// MarshalXML 自定义 BrandItem 的 XML 序列化
+func (item BrandItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(item.ToSharedListItem(), start)
+}
+
+// MarshalXML 自定义 UnknownListItem 的 XML 序列化，原样输出保存的 XML
+func (item UnknownListItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if item.TypeName != "" {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "i:type"},
+			Value: item.TypeName,
+		})
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	// 逐个重新编码原始 XML 中的标记，保留命名空间前缀
+	if err := common.CopyRawXML(e, item.InnerXML); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
@@ -0,0 +1,186 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// SyncOptions 控制共享列表同步的行为
+type SyncOptions struct {
+	BatchOptions
+
+	// DryRun 为 true 时只计算同步计划，不修改共享列表
+	DryRun bool
+}
+
+// SharedListSyncPlan 表示将共享列表同步为期望状态所需的修改
+type SharedListSyncPlan struct {
+	// Add 为需要添加的项目
+	Add []SharedListItem
+	// Delete 为需要删除的已有项目，均带有 ID
+	Delete []SharedListItem
+	// Unchanged 为已经存在且无需修改的项目数
+	Unchanged int
+}
+
+// Empty 检查计划中是否没有任何修改
+func (p *SharedListSyncPlan) Empty() bool {
+	return len(p.Add) == 0 && len(p.Delete) == 0
+}
+
+// DeleteIds 返回需要删除的项目 ID
+func (p *SharedListSyncPlan) DeleteIds() []int64 {
+	ids := make([]int64, len(p.Delete))
+	for i, item := range p.Delete {
+		ids[i] = item.ID.Value()
+	}
+	return ids
+}
+
+// String 返回计划的文本描述，每行一个修改，用于 dry-run 输出
+//
+// 添加的项目以 "+" 开头，删除的项目以 "-" 开头，最后一行为汇总。
+func (p *SharedListSyncPlan) String() string {
+	var b strings.Builder
+	for _, item := range p.Delete {
+		fmt.Fprintf(&b, "- %s (Id %d)\n", describeListItem(item), item.ID.Value())
+	}
+	for _, item := range p.Add {
+		fmt.Fprintf(&b, "+ %s\n", describeListItem(item))
+	}
+	fmt.Fprintf(&b, "添加 %d 个，删除 %d 个，保留 %d 个\n", len(p.Add), len(p.Delete), p.Unchanged)
+	return b.String()
+}
+
+// describeListItem 返回列表项的简短描述
+func describeListItem(item SharedListItem) string {
+	switch listItemType(item) {
+	case SharedListItemTypeNegativeKeyword:
+		return fmt.Sprintf("NegativeKeyword [%s] %s", item.MatchType, item.Text)
+	case SharedListItemTypeNegativeSite:
+		return "NegativeSite " + item.Url
+	case SharedListItemTypeBrandItem:
+		return "BrandItem " + strconv.FormatInt(item.BrandId.Value(), 10)
+	default:
+		return string(listItemType(item))
+	}
+}
+
+// listItemType 返回扁平列表项的类型
+func listItemType(item SharedListItem) SharedListItemType {
+	if item.ItemType != "" {
+		return SharedListItemType(item.ItemType)
+	}
+	return item.Type
+}
+
+// SharedListItemKey 返回比较列表项时使用的键
+//
+// 负面关键词按 Text 和 MatchType 比较，Text 忽略大小写和多余空白；负面站点按
+// NormalizeSiteURL 规范化后的网址比较；品牌按 BrandId 比较。
+func SharedListItemKey(item SharedListItem) (string, error) {
+	switch typ := listItemType(item); typ {
+	case SharedListItemTypeNegativeKeyword:
+		text := strings.Join(strings.Fields(strings.ToLower(item.Text)), " ")
+		if text == "" {
+			return "", base.NewError(base.ErrInvalidInput, "负面关键词的 Text 不能为空", nil)
+		}
+		return fmt.Sprintf("%s|%s|%s", typ, strings.ToLower(item.MatchType), text), nil
+	case SharedListItemTypeNegativeSite:
+		url := NormalizeSiteURL(item.Url)
+		if url == "" {
+			return "", base.NewError(base.ErrInvalidInput, "负面站点的 Url 不能为空", nil)
+		}
+		return fmt.Sprintf("%s|%s", typ, url), nil
+	case SharedListItemTypeBrandItem:
+		if !item.BrandId.HasValue() {
+			return "", base.NewError(base.ErrInvalidInput, "品牌的 BrandId 不能为空", nil)
+		}
+		return fmt.Sprintf("%s|%d", typ, item.BrandId.Value()), nil
+	default:
+		return "", &UnknownListItemTypeError{TypeName: string(typ)}
+	}
+}
+
+// NormalizeSiteURL 规范化站点网址
+//
+// 去掉首尾空白、协议以及末尾的斜杠，并将主机名转换为小写，
+// 例如 "https://WWW.Example.com/Path/" 规范化为 "www.example.com/Path"。
+// 开头的 www. 会保留：example.com 和 www.example.com 是不同的站点。
+func NormalizeSiteURL(url string) string {
+	url = strings.TrimSpace(url)
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+	}
+
+	host, path := url, ""
+	if i := strings.Index(url, "/"); i >= 0 {
+		host, path = url[:i], url[i:]
+	}
+	return strings.TrimRight(strings.ToLower(host)+path, "/")
+}
+
+// PlanSharedListSync 计算将共享列表从 current 同步为 desired 所需的修改
+//
+// desired 中重复的项目只添加一次；current 中重复的项目只保留一个，其余的会被删除。
+// 新增的项目不带 ID，删除的项目必须带有 ID。
+func PlanSharedListSync(current, desired []SharedListItem) (*SharedListSyncPlan, error) {
+	wanted := make(map[string]bool, len(desired))
+	var add []SharedListItem
+	for i, item := range desired {
+		key, err := SharedListItemKey(item)
+		if err != nil {
+			return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("第 %d 个期望的项目无效", i), err)
+		}
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+		item.ID = base.Nillable[int64]{}
+		add = append(add, item)
+	}
+
+	plan := &SharedListSyncPlan{}
+	existing := make(map[string]bool, len(current))
+	for i, item := range current {
+		key, err := SharedListItemKey(item)
+		if err != nil {
+			return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("共享列表中的第 %d 个项目无效", i), err)
+		}
+		if wanted[key] && !existing[key] {
+			existing[key] = true
+			plan.Unchanged++
+			continue
+		}
+		if !item.ID.HasValue() {
+			return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("需要删除的项目 %s 缺少 Id", describeListItem(item)), nil)
+		}
+		plan.Delete = append(plan.Delete, item)
+	}
+
+	for _, item := range add {
+		key, _ := SharedListItemKey(item)
+		if !existing[key] {
+			plan.Add = append(plan.Add, item)
+		}
+	}
+	return plan, nil
+}
+
+// SharedListSyncResult 表示执行同步计划的结果
+type SharedListSyncResult struct {
+	// AddedIds 与计划中的 Add 一一对应，添加失败的项目 ID 为 0
+	AddedIds []int64
+	// AddErrors 的 Index 为计划中 Add 的位置
+	AddErrors []BatchError
+	// DeleteErrors 的 Index 为计划中 Delete 的位置
+	DeleteErrors []BatchError
+}
+
+// HasErrors 检查是否有项目添加或删除失败
+func (r *SharedListSyncResult) HasErrors() bool {
+	return len(r.AddErrors) > 0 || len(r.DeleteErrors) > 0
+}
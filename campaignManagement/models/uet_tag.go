@@ -0,0 +1,74 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// UetTagTrackingStatus 表示 UET 标签的跟踪状态
+type UetTagTrackingStatus string
+
+const (
+	UetTagTrackingStatusUnverified  UetTagTrackingStatus = "Unverified"
+	UetTagTrackingStatusActive      UetTagTrackingStatus = "Active"
+	UetTagTrackingStatusInactive    UetTagTrackingStatus = "Inactive"
+	UetTagTrackingStatusTagNotFound UetTagTrackingStatus = "TagNotFound"
+)
+
+// UetTag 表示通用事件跟踪（UET）标签
+type UetTag struct {
+	CustomerShare *CustomerShare        `xml:"CustomerShare,omitempty"`
+	Description   base.Nillable[string] `xml:"Description"`
+	Id            base.Nillable[int64]  `xml:"Id"`
+	Name          base.Nillable[string] `xml:"Name"`
+	// 以下字段由服务端返回，添加或更新时不需要设置
+	TrackingNoScript base.Nillable[string]               `xml:"TrackingNoScript"`
+	TrackingScript   base.Nillable[string]               `xml:"TrackingScript"`
+	TrackingStatus   base.Nillable[UetTagTrackingStatus] `xml:"TrackingStatus"`
+}
+
+// AddUetTagsRequest 请求结构体
+type AddUetTagsRequest struct {
+	XMLName   xml.Name `xml:"AddUetTagsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	UetTags   []UetTag `xml:"UetTags>UetTag"`
+}
+
+// AddUetTagsResponse 响应结构体
+type AddUetTagsResponse struct {
+	XMLName       xml.Name     `xml:"AddUetTagsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	UetTags       []UetTag     `xml:"UetTags>UetTag,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// GetUetTagsByIdsRequest 请求结构体
+type GetUetTagsByIdsRequest struct {
+	XMLName   xml.Name         `xml:"GetUetTagsByIdsRequest"`
+	Namespace string           `xml:"xmlns,attr"`
+	TagIds    common.LongArray `xml:"TagIds"`
+}
+
+// GetUetTagsByIdsResponse 响应结构体
+type GetUetTagsByIdsResponse struct {
+	XMLName       xml.Name     `xml:"GetUetTagsByIdsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	UetTags       []UetTag     `xml:"UetTags>UetTag,omitempty"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
+
+// UpdateUetTagsRequest 请求结构体
+type UpdateUetTagsRequest struct {
+	XMLName   xml.Name `xml:"UpdateUetTagsRequest"`
+	Namespace string   `xml:"xmlns,attr"`
+	UetTags   []UetTag `xml:"UetTags>UetTag"`
+}
+
+// UpdateUetTagsResponse 响应结构体
+type UpdateUetTagsResponse struct {
+	XMLName       xml.Name     `xml:"UpdateUetTagsResponse"`
+	Namespace     string       `xml:"xmlns,attr"`
+	PartialErrors []BatchError `xml:"PartialErrors>BatchError,omitempty"`
+}
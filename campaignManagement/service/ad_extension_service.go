@@ -0,0 +1,237 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// AdExtensionService 实现广告附加信息服务
+type AdExtensionService struct {
+	client *Client
+}
+
+// NewAdExtensionService 创建一个新的附加信息服务
+func NewAdExtensionService(client *Client) *AdExtensionService {
+	return &AdExtensionService{
+		client: client,
+	}
+}
+
+// AddAdExtensions 向账户添加附加信息
+//
+// 返回与 adExtensions 一一对应的 ID 和版本，添加失败的 ID 为 0。一个附加信息可能有多个错误，
+// 因此错误以 models.BatchErrorCollection 返回，其 Index 对应 adExtensions 中的位置。
+func (s *AdExtensionService) AddAdExtensions(accountId int64, adExtensions []models.AdExtension) ([]models.AdExtensionIdentity, []models.BatchErrorCollection, error) {
+	if err := checkBatchSize("附加信息", len(adExtensions), models.MaxAdExtensionsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddAdExtensionsRequest{
+		Namespace:    config.CampaignManagementNamespace,
+		AccountId:    accountId,
+		AdExtensions: adExtensions,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddAdExtensions, &models.CampaignManagementBody{
+		AddAdExtensionsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddAdExtensionsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddAdExtensions)
+	}
+	return resp.AdExtensionIdentities, resp.NestedPartialErrors, nil
+}
+
+// GetAdExtensionsByIds 获取指定类型的附加信息
+//
+// adExtensionTypes 为空时查询 models.AdExtensionTypes 中的所有类型。返回的附加信息为具体类型，
+// 例如 models.SitelinkAdExtension，SDK 尚不支持的类型为 models.UnknownAdExtension。
+func (s *AdExtensionService) GetAdExtensionsByIds(accountId int64, adExtensionIds []int64, adExtensionTypes ...models.AdExtensionType) ([]models.AdExtension, []models.BatchError, error) {
+	if err := checkBatchSize("附加信息", len(adExtensionIds), models.MaxAdExtensionsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetAdExtensionsByIdsRequest{
+		Namespace:       config.CampaignManagementNamespace,
+		AccountId:       accountId,
+		AdExtensionIds:  adExtensionIds,
+		AdExtensionType: joinAdExtensionTypes(adExtensionTypes),
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAdExtensionsByIds, &models.CampaignManagementBody{
+		GetAdExtensionsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetAdExtensionsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetAdExtensionsByIds)
+	}
+	return resp.AdExtensions, resp.PartialErrors, nil
+}
+
+// GetAdExtensionIdsByAccountId 获取账户下附加信息的 ID
+//
+// associationType 为空时返回所有附加信息，否则只返回已关联到该层级的附加信息。
+func (s *AdExtensionService) GetAdExtensionIdsByAccountId(accountId int64, associationType models.AssociationType, adExtensionTypes ...models.AdExtensionType) ([]int64, error) {
+	// 创建请求
+	request := models.GetAdExtensionIdsByAccountIdRequest{
+		Namespace:       config.CampaignManagementNamespace,
+		AccountId:       accountId,
+		AdExtensionType: joinAdExtensionTypes(adExtensionTypes),
+		AssociationType: associationType,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAdExtensionIdsByAccountId, &models.CampaignManagementBody{
+		GetAdExtensionIdsByAccountIdRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.GetAdExtensionIdsByAccountIdResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionGetAdExtensionIdsByAccountId)
+	}
+	return resp.AdExtensionIds, nil
+}
+
+// UpdateAdExtensions 更新附加信息，adExtensions 必须设置 Id，未设置的字段不会被修改
+func (s *AdExtensionService) UpdateAdExtensions(accountId int64, adExtensions []models.AdExtension) ([]models.BatchErrorCollection, error) {
+	if err := checkBatchSize("附加信息", len(adExtensions), models.MaxAdExtensionsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateAdExtensionsRequest{
+		Namespace:    config.CampaignManagementNamespace,
+		AccountId:    accountId,
+		AdExtensions: adExtensions,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateAdExtensions, &models.CampaignManagementBody{
+		UpdateAdExtensionsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateAdExtensionsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateAdExtensions)
+	}
+	return resp.NestedPartialErrors, nil
+}
+
+// DeleteAdExtensions 删除附加信息，附加信息与实体的关联会一并删除
+func (s *AdExtensionService) DeleteAdExtensions(accountId int64, adExtensionIds []int64) ([]models.BatchError, error) {
+	if err := checkBatchSize("附加信息", len(adExtensionIds), models.MaxAdExtensionsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteAdExtensionsRequest{
+		Namespace:      config.CampaignManagementNamespace,
+		AccountId:      accountId,
+		AdExtensionIds: adExtensionIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteAdExtensions, &models.CampaignManagementBody{
+		DeleteAdExtensionsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteAdExtensionsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteAdExtensions)
+	}
+	return resp.PartialErrors, nil
+}
+
+// SetAdExtensionsAssociations 将附加信息关联到账户、活动或广告组，EntityId 为对应层级实体的 ID
+func (s *AdExtensionService) SetAdExtensionsAssociations(accountId int64, associationType models.AssociationType, associations []models.AdExtensionIdToEntityIdAssociation) ([]models.BatchError, error) {
+	if err := checkBatchSize("附加信息关联", len(associations), models.MaxAdExtensionsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.SetAdExtensionsAssociationsRequest{
+		Namespace:                           config.CampaignManagementNamespace,
+		AccountId:                           accountId,
+		AdExtensionIdToEntityIdAssociations: associations,
+		AssociationType:                     associationType,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSetAdExtensionsAssociations, &models.CampaignManagementBody{
+		SetAdExtensionsAssociationsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.SetAdExtensionsAssociationsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionSetAdExtensionsAssociations)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetAdExtensionsAssociations 获取实体关联的附加信息，返回的集合与 entityIds 一一对应
+func (s *AdExtensionService) GetAdExtensionsAssociations(accountId int64, associationType models.AssociationType, entityIds []int64, adExtensionTypes ...models.AdExtensionType) ([]models.AdExtensionAssociationCollection, []models.BatchError, error) {
+	if err := checkBatchSize("实体", len(entityIds), models.MaxAdExtensionsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetAdExtensionsAssociationsRequest{
+		Namespace:       config.CampaignManagementNamespace,
+		AccountId:       accountId,
+		AdExtensionType: joinAdExtensionTypes(adExtensionTypes),
+		AssociationType: associationType,
+		EntityIds:       entityIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAdExtensionsAssociations, &models.CampaignManagementBody{
+		GetAdExtensionsAssociationsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetAdExtensionsAssociationsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetAdExtensionsAssociations)
+	}
+	return resp.AdExtensionAssociationCollection, resp.PartialErrors, nil
+}
+
+// joinAdExtensionTypes 将附加信息类型拼接为以空格分隔的列表，为空时使用 models.AdExtensionTypes
+func joinAdExtensionTypes(adExtensionTypes []models.AdExtensionType) string {
+	if len(adExtensionTypes) == 0 {
+		adExtensionTypes = models.AdExtensionTypes
+	}
+	types := make([]string, len(adExtensionTypes))
+	for i, adExtensionType := range adExtensionTypes {
+		types[i] = string(adExtensionType)
+	}
+	return strings.Join(types, " ")
+}
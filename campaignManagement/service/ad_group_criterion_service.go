@@ -0,0 +1,109 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// AdGroupCriterionService 实现广告组条件服务
+type AdGroupCriterionService struct {
+	client *Client
+}
+
+// NewAdGroupCriterionService 创建一个新的广告组条件服务
+func NewAdGroupCriterionService(client *Client) *AdGroupCriterionService {
+	return &AdGroupCriterionService{
+		client: client,
+	}
+}
+
+// GetAdGroupCriterionsByIds 获取广告组条件，adGroupCriterionIds 为空时获取广告组下该类型的所有条件
+//
+// 返回的条件按 i:type 解码为 models.BiddableAdGroupCriterion 或 models.NegativeAdGroupCriterion。
+func (s *AdGroupCriterionService) GetAdGroupCriterionsByIds(adGroupCriterionIds []int64, adGroupId int64, criterionType models.AdGroupCriterionType) ([]models.AdGroupCriterion, []models.BatchError, error) {
+	// 创建请求
+	request := models.GetAdGroupCriterionsByIdsRequest{
+		Namespace:           config.CampaignManagementNamespace,
+		AdGroupCriterionIds: adGroupCriterionIds,
+		AdGroupId:           adGroupId,
+		CriterionType:       criterionType,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAdGroupCriterionsByIds, &models.CampaignManagementBody{
+		GetAdGroupCriterionsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetAdGroupCriterionsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetAdGroupCriterionsByIds)
+	}
+	return resp.AdGroupCriterions, resp.PartialErrors, nil
+}
+
+// ApplyProductPartitionActions 对产品分组执行一批新增、更新和删除操作
+//
+// 返回的 ID 与 criterionActions 一一对应。任何一个操作失败时整批操作都不会生效。
+func (s *AdGroupCriterionService) ApplyProductPartitionActions(criterionActions []models.AdGroupCriterionAction) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("产品分组操作", len(criterionActions), models.MaxProductPartitionActionsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.ApplyProductPartitionActionsRequest{
+		Namespace:        config.CampaignManagementNamespace,
+		CriterionActions: criterionActions,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionApplyProductPartitionActions, &models.CampaignManagementBody{
+		ApplyProductPartitionActionsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.ApplyProductPartitionActionsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionApplyProductPartitionActions)
+	}
+	return resp.AdGroupCriterionIds, resp.PartialErrors, nil
+}
+
+// GetProductPartitionTree 获取广告组的产品分组树，广告组还没有产品分组时树的 Root 为 nil
+func (s *AdGroupCriterionService) GetProductPartitionTree(adGroupId int64) (*models.ProductPartitionTree, error) {
+	criterions, partialErrors, err := s.GetAdGroupCriterionsByIds(nil, adGroupId, models.AdGroupCriterionTypeProductPartition)
+	if err != nil {
+		return nil, err
+	}
+	if err := partialError(models.SOAPActionGetAdGroupCriterionsByIds, partialErrors); err != nil {
+		return nil, err
+	}
+	return models.BuildProductPartitionTree(adGroupId, criterions)
+}
+
+// ApplyProductPartitionTree 将产品分组树的修改提交到服务端
+//
+// 操作由 tree.Plan 计算并在一次调用中提交，没有修改时不发送请求。全部成功后新节点的 ID
+// 会写回树中，之后可以继续修改并再次提交；返回部分错误时树保持未提交的状态。
+func (s *AdGroupCriterionService) ApplyProductPartitionTree(tree *models.ProductPartitionTree) ([]models.BatchError, error) {
+	plan, err := tree.Plan()
+	if err != nil {
+		return nil, err
+	}
+	if plan.Empty() {
+		return nil, nil
+	}
+
+	ids, partialErrors, err := s.ApplyProductPartitionActions(plan.Actions)
+	if err != nil {
+		return nil, err
+	}
+	if len(partialErrors) > 0 {
+		return partialErrors, nil
+	}
+	return nil, plan.Commit(ids)
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// AudienceService 实现受众服务
+type AudienceService struct {
+	client *Client
+}
+
+// NewAudienceService 创建一个新的受众服务
+func NewAudienceService(client *Client) *AudienceService {
+	return &AudienceService{
+		client: client,
+	}
+}
+
+// GetAudiencesByIds 获取指定类型的受众，audienceIds 为空时获取账户下的所有受众
+//
+// audienceTypes 为空时查询 models.AudienceTypes 中的所有类型。返回的受众为具体类型，
+// 例如 models.RemarketingList，SDK 尚不支持的类型为 models.UnknownAudience。
+func (s *AudienceService) GetAudiencesByIds(audienceIds []int64, audienceTypes ...models.AudienceType) ([]models.Audience, []models.BatchError, error) {
+	if len(audienceTypes) == 0 {
+		audienceTypes = models.AudienceTypes
+	}
+	types := make([]string, len(audienceTypes))
+	for i, audienceType := range audienceTypes {
+		types[i] = string(audienceType)
+	}
+
+	// 创建请求
+	request := models.GetAudiencesByIdsRequest{
+		Namespace:   config.CampaignManagementNamespace,
+		AudienceIds: audienceIds,
+		Type:        strings.Join(types, " "),
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAudiencesByIds, &models.CampaignManagementBody{
+		GetAudiencesByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetAudiencesByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetAudiencesByIds)
+	}
+	return resp.Audiences, resp.PartialErrors, nil
+}
+
+// AddAudiences 添加受众，返回与 audiences 一一对应的受众 ID，添加失败的受众 ID 为 0
+func (s *AudienceService) AddAudiences(audiences []models.Audience) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("受众", len(audiences), models.MaxAudiencesPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddAudiencesRequest{
+		Namespace: config.CampaignManagementNamespace,
+		Audiences: audiences,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddAudiences, &models.CampaignManagementBody{
+		AddAudiencesRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddAudiencesResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddAudiences)
+	}
+	return resp.AudienceIds, resp.PartialErrors, nil
+}
+
+// UpdateAudiences 更新受众，audiences 必须设置 Id，未设置的字段不会被修改
+func (s *AudienceService) UpdateAudiences(audiences []models.Audience) ([]models.BatchError, error) {
+	if err := checkBatchSize("受众", len(audiences), models.MaxAudiencesPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateAudiencesRequest{
+		Namespace: config.CampaignManagementNamespace,
+		Audiences: audiences,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateAudiences, &models.CampaignManagementBody{
+		UpdateAudiencesRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateAudiencesResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateAudiences)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteAudiences 删除受众
+func (s *AudienceService) DeleteAudiences(audienceIds []int64) ([]models.BatchError, error) {
+	if err := checkBatchSize("受众", len(audienceIds), models.MaxAudiencesPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteAudiencesRequest{
+		Namespace:   config.CampaignManagementNamespace,
+		AudienceIds: audienceIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteAudiences, &models.CampaignManagementBody{
+		DeleteAudiencesRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteAudiencesResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteAudiences)
+	}
+	return resp.PartialErrors, nil
+}
+
+// ApplyCustomerListItems 向客户列表添加、删除或替换成员
+//
+// emails 在上传前通过 models.HashEmail 去掉首尾空白、转为小写并计算 SHA-256，明文邮箱不会离开本机。
+// 已经哈希过的值原样上传。空邮箱返回 INVALID_INPUT 错误。
+func (s *AudienceService) ApplyCustomerListItems(customerListId int64, actionType models.CustomerListActionType, emails []string) ([]models.BatchError, error) {
+	if err := checkBatchSize("客户列表项", len(emails), models.MaxCustomerListItemsPerCall); err != nil {
+		return nil, err
+	}
+
+	items := make([]string, len(emails))
+	for i, email := range emails {
+		if strings.TrimSpace(email) == "" {
+			return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("第 %d 个邮箱为空", i), nil)
+		}
+		items[i] = models.HashEmail(email)
+	}
+
+	// 创建请求
+	request := models.ApplyCustomerListItemsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		CustomerListItems: models.CustomerListItems{
+			ActionType:              actionType,
+			CustomerListId:          customerListId,
+			CustomerListItemSubType: models.CustomerListItemSubTypeEmail,
+			CustomerListItems:       items,
+		},
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionApplyCustomerListItems, &models.CampaignManagementBody{
+		ApplyCustomerListItemsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.ApplyCustomerListItemsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionApplyCustomerListItems)
+	}
+	return resp.PartialErrors, nil
+}
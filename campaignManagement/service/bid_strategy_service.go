@@ -0,0 +1,149 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// BidStrategyService 实现组合出价策略服务
+type BidStrategyService struct {
+	client *Client
+}
+
+// NewBidStrategyService 创建一个新的组合出价策略服务
+func NewBidStrategyService(client *Client) *BidStrategyService {
+	return &BidStrategyService{
+		client: client,
+	}
+}
+
+// GetBidStrategiesByIds 根据 ID 获取出价策略，bidStrategyIds 为空时获取账户下的所有出价策略
+func (s *BidStrategyService) GetBidStrategiesByIds(bidStrategyIds []int64) ([]models.BidStrategy, []models.BatchError, error) {
+	// 创建请求
+	request := models.GetBidStrategiesByIdsRequest{
+		Namespace:      config.CampaignManagementNamespace,
+		BidStrategyIds: bidStrategyIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBidStrategiesByIds, &models.CampaignManagementBody{
+		GetBidStrategiesByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetBidStrategiesByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetBidStrategiesByIds)
+	}
+	return resp.BidStrategies, resp.PartialErrors, nil
+}
+
+// AddBidStrategies 添加出价策略，返回与 bidStrategies 一一对应的出价策略 ID，添加失败的出价策略 ID 为 0
+func (s *BidStrategyService) AddBidStrategies(bidStrategies []models.BidStrategy) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("出价策略", len(bidStrategies), models.MaxBidStrategiesPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddBidStrategiesRequest{
+		Namespace:     config.CampaignManagementNamespace,
+		BidStrategies: bidStrategies,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddBidStrategies, &models.CampaignManagementBody{
+		AddBidStrategiesRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddBidStrategiesResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddBidStrategies)
+	}
+	return resp.BidStrategyIds, resp.PartialErrors, nil
+}
+
+// UpdateBidStrategies 更新出价策略，bidStrategies 必须设置 Id，未设置的字段不会被修改
+func (s *BidStrategyService) UpdateBidStrategies(bidStrategies []models.BidStrategy) ([]models.BatchError, error) {
+	if err := checkBatchSize("出价策略", len(bidStrategies), models.MaxBidStrategiesPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateBidStrategiesRequest{
+		Namespace:     config.CampaignManagementNamespace,
+		BidStrategies: bidStrategies,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateBidStrategies, &models.CampaignManagementBody{
+		UpdateBidStrategiesRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateBidStrategiesResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateBidStrategies)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteBidStrategies 删除出价策略
+func (s *BidStrategyService) DeleteBidStrategies(bidStrategyIds []int64) ([]models.BatchError, error) {
+	if err := checkBatchSize("出价策略", len(bidStrategyIds), models.MaxBidStrategiesPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteBidStrategiesRequest{
+		Namespace:      config.CampaignManagementNamespace,
+		BidStrategyIds: bidStrategyIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteBidStrategies, &models.CampaignManagementBody{
+		DeleteBidStrategiesRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteBidStrategiesResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteBidStrategies)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetCampaignIdsByBidStrategyIds 获取使用各出价策略的活动 ID，结果与 bidStrategyIds 一一对应
+func (s *BidStrategyService) GetCampaignIdsByBidStrategyIds(bidStrategyIds []int64) ([]models.IdCollection, []models.BatchError, error) {
+	if err := checkBatchSize("出价策略", len(bidStrategyIds), models.MaxBidStrategiesPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetCampaignIdsByBidStrategyIdsRequest{
+		Namespace:      config.CampaignManagementNamespace,
+		BidStrategyIds: bidStrategyIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetCampaignIdsByBidStrategyIds, &models.CampaignManagementBody{
+		GetCampaignIdsByBidStrategyIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetCampaignIdsByBidStrategyIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetCampaignIdsByBidStrategyIds)
+	}
+	return resp.CampaignIdCollection, resp.PartialErrors, nil
+}
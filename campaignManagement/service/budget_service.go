@@ -0,0 +1,149 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// BudgetService 实现共享预算服务
+type BudgetService struct {
+	client *Client
+}
+
+// NewBudgetService 创建一个新的共享预算服务
+func NewBudgetService(client *Client) *BudgetService {
+	return &BudgetService{
+		client: client,
+	}
+}
+
+// GetBudgetsByIds 根据 ID 获取预算，budgetIds 为空时获取账户下的所有预算
+func (s *BudgetService) GetBudgetsByIds(budgetIds []int64) ([]models.Budget, []models.BatchError, error) {
+	// 创建请求
+	request := models.GetBudgetsByIdsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		BudgetIds: budgetIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBudgetsByIds, &models.CampaignManagementBody{
+		GetBudgetsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetBudgetsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetBudgetsByIds)
+	}
+	return resp.Budgets, resp.PartialErrors, nil
+}
+
+// AddBudgets 添加预算，返回与 budgets 一一对应的预算 ID，添加失败的预算 ID 为 0
+func (s *BudgetService) AddBudgets(budgets []models.Budget) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("预算", len(budgets), models.MaxBudgetsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddBudgetsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		Budgets:   budgets,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddBudgets, &models.CampaignManagementBody{
+		AddBudgetsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddBudgetsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddBudgets)
+	}
+	return resp.BudgetIds, resp.PartialErrors, nil
+}
+
+// UpdateBudgets 更新预算，budgets 必须设置 Id，未设置的字段不会被修改
+func (s *BudgetService) UpdateBudgets(budgets []models.Budget) ([]models.BatchError, error) {
+	if err := checkBatchSize("预算", len(budgets), models.MaxBudgetsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateBudgetsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		Budgets:   budgets,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateBudgets, &models.CampaignManagementBody{
+		UpdateBudgetsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateBudgetsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateBudgets)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteBudgets 删除预算
+func (s *BudgetService) DeleteBudgets(budgetIds []int64) ([]models.BatchError, error) {
+	if err := checkBatchSize("预算", len(budgetIds), models.MaxBudgetsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteBudgetsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		BudgetIds: budgetIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteBudgets, &models.CampaignManagementBody{
+		DeleteBudgetsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteBudgetsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteBudgets)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetCampaignIdsByBudgetIds 获取使用各预算的活动 ID，结果与 budgetIds 一一对应
+func (s *BudgetService) GetCampaignIdsByBudgetIds(budgetIds []int64) ([]models.IdCollection, []models.BatchError, error) {
+	if err := checkBatchSize("预算", len(budgetIds), models.MaxBudgetsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetCampaignIdsByBudgetIdsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		BudgetIds: budgetIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetCampaignIdsByBudgetIds, &models.CampaignManagementBody{
+		GetCampaignIdsByBudgetIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetCampaignIdsByBudgetIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetCampaignIdsByBudgetIds)
+	}
+	return resp.CampaignIdCollection, resp.PartialErrors, nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// ChangeSetService 实现变更集服务
+type ChangeSetService struct {
+	client *Client
+}
+
+// NewChangeSetService 创建一个新的变更集服务
+func NewChangeSetService(client *Client) *ChangeSetService {
+	return &ChangeSetService{
+		client: client,
+	}
+}
+
+// changeGroupKey 标识可以用同一个请求发送的修改
+type changeGroupKey struct {
+	action   models.ChangeAction
+	resource models.ChangeResource
+	scope    models.EntityScope
+	list     models.ChangeSharedList
+}
+
+// changeGroup 表示可以用同一个请求发送的一组修改
+type changeGroup struct {
+	changeGroupKey
+	indexes []int
+}
+
+// changePhases 为执行变更集时各类修改的顺序
+//
+// 先删除关联和列表项，再添加列表项，最后建立关联，使新关联的列表已经包含新项目。
+var changePhases = []struct {
+	action   models.ChangeAction
+	resource models.ChangeResource
+}{
+	{models.ChangeActionDelete, models.ChangeResourceSharedEntityAssociation},
+	{models.ChangeActionDelete, models.ChangeResourceSharedListItem},
+	{models.ChangeActionCreate, models.ChangeResourceSharedListItem},
+	{models.ChangeActionCreate, models.ChangeResourceSharedEntityAssociation},
+}
+
+// groupChanges 按执行顺序将修改分组，组内保持修改在变更集中的顺序
+func groupChanges(cs *models.ChangeSet) []*changeGroup {
+	var groups []*changeGroup
+	for _, phase := range changePhases {
+		byKey := make(map[changeGroupKey]*changeGroup)
+		for i, change := range cs.Changes {
+			if change.Action != phase.action || change.Resource != phase.resource {
+				continue
+			}
+
+			key := changeGroupKey{action: change.Action, resource: change.Resource, scope: change.Scope}
+			if change.SharedList != nil {
+				key.list = *change.SharedList
+			}
+			group, ok := byKey[key]
+			if !ok {
+				group = &changeGroup{changeGroupKey: key}
+				byKey[key] = group
+				groups = append(groups, group)
+			}
+			group.indexes = append(group.indexes, i)
+		}
+	}
+	return groups
+}
+
+// ApplyChangeSet 执行变更集
+//
+// 修改按删除关联、删除列表项、添加列表项、建立关联的顺序执行，同一共享列表和范围的修改
+// 合并为一组。不超过单次请求上限的组通过一个请求发送，超过上限的组按 opts.BatchSize 分批
+// 依次发送，opts.Concurrency 被忽略。
+//
+// 变更集的执行不是原子的：每个请求中的项目可能部分失败，已经执行的修改在后续请求失败时
+// 也不会回滚。
+//
+// 部分修改失败时继续执行，BatchError.Index 已经换算为修改在 cs.Changes 中的位置。某个
+// 请求失败时停止执行，返回 common.ChunkError 以及已经执行的结果，没有执行的修改记录在
+// ChangeSetResult.NotApplied 中。
+func (s *ChangeSetService) ApplyChangeSet(cs *models.ChangeSet, opts models.BatchOptions) (*models.ChangeSetResult, error) {
+	if err := cs.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &models.ChangeSetResult{Ids: make([]int64, len(cs.Changes))}
+	sharedLists := NewSharedListService(s.client)
+	groups := groupChanges(cs)
+
+	for g, group := range groups {
+		size := batchSize(opts)
+		if group.resource == models.ChangeResourceSharedEntityAssociation && size > models.MaxSharedEntityAssociationsPerCall {
+			size = models.MaxSharedEntityAssociationsPerCall
+		}
+
+		err := common.RunChunks(len(group.indexes), size, 1, func(r common.ChunkRange) error {
+			indexes := group.indexes[r.Start:r.End]
+			partialErrors, err := s.applyChunk(sharedLists, cs, group, indexes, result.Ids)
+			if err != nil {
+				return err
+			}
+
+			for _, e := range partialErrors {
+				if e.Index >= 0 && e.Index < len(indexes) {
+					e.Index = indexes[e.Index]
+				}
+				result.Errors = append(result.Errors, e)
+			}
+			return nil
+		})
+		if err != nil {
+			var chunkErr *common.ChunkError
+			if errors.As(err, &chunkErr) {
+				result.NotApplied = append(result.NotApplied, group.indexes[chunkErr.Range.Start:]...)
+			}
+			for _, rest := range groups[g+1:] {
+				result.NotApplied = append(result.NotApplied, rest.indexes...)
+			}
+			sort.Ints(result.NotApplied)
+			sortChangeErrors(result.Errors)
+			return result, err
+		}
+	}
+
+	sortChangeErrors(result.Errors)
+	return result, nil
+}
+
+// applyChunk 通过一个请求执行一组修改中的一批，返回的 BatchError.Index 为批次内的位置
+func (s *ChangeSetService) applyChunk(sharedLists *SharedListService, cs *models.ChangeSet, group *changeGroup, indexes []int, ids []int64) ([]models.BatchError, error) {
+	switch group.resource {
+	case models.ChangeResourceSharedListItem:
+		list := group.list.AsSharedList()
+		if group.action == models.ChangeActionDelete {
+			itemIds := make([]int64, len(indexes))
+			for i, index := range indexes {
+				itemIds[i] = cs.Changes[index].ListItem.Id
+			}
+			return sharedLists.DeleteListItemsFromSharedList(list, itemIds, group.scope)
+		}
+
+		items := make([]models.SharedListItem, len(indexes))
+		for i, index := range indexes {
+			items[i] = cs.Changes[index].ListItem.SharedListItem()
+		}
+		addedIds, partialErrors, err := sharedLists.AddListItemsToSharedList(list, items, group.scope)
+		if err != nil {
+			return nil, err
+		}
+		for i, id := range addedIds {
+			if i < len(indexes) {
+				ids[indexes[i]] = id
+			}
+		}
+		return partialErrors, nil
+	default:
+		associations := make([]models.SharedEntityAssociation, len(indexes))
+		for i, index := range indexes {
+			associations[i] = cs.Changes[index].Association.SharedEntityAssociation()
+		}
+		if group.action == models.ChangeActionDelete {
+			return sharedLists.DeleteSharedEntityAssociations(associations, group.scope)
+		}
+		return sharedLists.SetSharedEntityAssociations(associations, group.scope)
+	}
+}
+
+// sortChangeErrors 按修改位置排序错误
+func sortChangeErrors(errs []models.BatchError) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+}
@@ -31,28 +31,74 @@ func (c *Client) SharedListService() models.SharedListService {
 	return NewSharedListService(c)
 }
 
+// BudgetService 返回共享预算服务
+func (c *Client) BudgetService() models.BudgetService {
+	return NewBudgetService(c)
+}
+
+// BidStrategyService 返回组合出价策略服务
+func (c *Client) BidStrategyService() models.BidStrategyService {
+	return NewBidStrategyService(c)
+}
+
+// LabelService 返回标签服务
+func (c *Client) LabelService() models.LabelService {
+	return NewLabelService(c)
+}
+
+// AudienceService 返回受众服务
+func (c *Client) AudienceService() models.AudienceService {
+	return NewAudienceService(c)
+}
+
+// UetTagService 返回 UET 标签服务
+func (c *Client) UetTagService() models.UetTagService {
+	return NewUetTagService(c)
+}
+
+// ConversionGoalService 返回转化目标服务
+func (c *Client) ConversionGoalService() models.ConversionGoalService {
+	return NewConversionGoalService(c)
+}
+
+// AdExtensionService 返回附加信息服务
+func (c *Client) AdExtensionService() models.AdExtensionService {
+	return NewAdExtensionService(c)
+}
+
+// MediaService 返回媒体服务
+func (c *Client) MediaService() models.MediaService {
+	return NewMediaService(c)
+}
+
+// NegativeKeywordService 返回活动和广告组负面关键词服务
+func (c *Client) NegativeKeywordService() models.NegativeKeywordService {
+	return NewNegativeKeywordService(c)
+}
+
+// ExperimentService 返回活动实验服务
+func (c *Client) ExperimentService() models.ExperimentService {
+	return NewExperimentService(c)
+}
+
+// AdGroupCriterionService 返回广告组条件服务
+func (c *Client) AdGroupCriterionService() models.AdGroupCriterionService {
+	return NewAdGroupCriterionService(c)
+}
+
+// ChangeSetService 返回变更集服务
+func (c *Client) ChangeSetService() models.ChangeSetService {
+	return NewChangeSetService(c)
+}
+
 // 创建 SOAP 请求头
 func (c *Client) createRequestHeader(action models.SOAPAction, mustUnderstand string) base.RequestHeader {
-	return base.RequestHeader{
-		Namespace:           config.CampaignManagementNamespace,
-		Action:              string(action),
-		MustUnderstand:      mustUnderstand,
-		AuthenticationToken: c.Config.Auth.AuthenticationToken,
-		CustomerAccountId:   c.Config.Auth.CustomerAccountID,
-		CustomerId:          c.Config.Auth.CustomerID,
-		DeveloperToken:      c.Config.Auth.DeveloperToken,
-	}
+	return common.NewRequestHeader(c.Config, config.CampaignManagementNamespace, string(action), mustUnderstand)
 }
 
 // 创建 SOAP 信封
 func (c *Client) createEnvelope(action models.SOAPAction, mustUnderstand string) base.Envelope {
-	return base.Envelope{
-		XMLName: xml.Name{},
-		XmlnsI:  config.XSINamespace,
-		XmlnsS:  config.SOAPEnvelopeNamespace,
-		Header:  c.createRequestHeader(action, mustUnderstand),
-		Body:    nil,
-	}
+	return common.NewEnvelope(c.createRequestHeader(action, mustUnderstand))
 }
 
 // 发送请求
@@ -94,21 +140,7 @@ func (c *Client) processResponse(respBody []byte, respObj any) error {
 
 	// 检查是否有SOAP故障
 	if genericResp.Body.Fault != nil {
-		fault := genericResp.Body.Fault
-		errorMsg := fmt.Sprintf("SOAP错误: %s - %s", fault.FaultCode, fault.FaultString)
-
-		// 检查是否有详细错误信息
-		if fault.Detail.AdApiFaultDetail.Errors.AdApiError != nil && len(fault.Detail.AdApiFaultDetail.Errors.AdApiError) > 0 {
-			apiError := fault.Detail.AdApiFaultDetail.Errors.AdApiError[0]
-			errorMsg = fmt.Sprintf("BingAds API错误 [%s]: %s", apiError.ErrorCode, apiError.Message)
-		}
-
-		// 记录跟踪ID（如果有）
-		if genericResp.Header.TrackingId != "" {
-			errorMsg += fmt.Sprintf(" (TrackingId: %s)", genericResp.Header.TrackingId)
-		}
-
-		return fmt.Errorf(errorMsg)
+		return common.FaultError(genericResp.Body.Fault, genericResp.Header.TrackingId)
 	}
 
 	// 尝试将响应直接解析到目标对象
@@ -118,3 +150,60 @@ func (c *Client) processResponse(respBody []byte, respObj any) error {
 
 	return nil
 }
+
+// call 发送请求并解析响应
+func (c *Client) call(action models.SOAPAction, body *models.CampaignManagementBody) (*models.CampaignManagementResponseBody, error) {
+	// 创建信封
+	envelope := c.createEnvelope(action, "1")
+	envelope.Body = body
+
+	// 发送请求
+	respBody, err := c.sendRequest(envelope, action)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析响应
+	var response models.CampaignManagementResponseEnvelope
+	if err := c.processResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Body, nil
+}
+
+// missingResponse 返回响应中缺少预期元素时的错误
+func missingResponse(action models.SOAPAction) error {
+	return base.NewError(base.ErrInvalidResponse, fmt.Sprintf("响应中缺少 %sResponse", action), nil)
+}
+
+// checkBatchSize 检查批量操作的数量是否在 1 到 max 之间
+func checkBatchSize(name string, n, max int) error {
+	if n == 0 {
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("%s不能为空", name), nil)
+	}
+	if n > max {
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("单次最多 %d 个%s，实际 %d 个", max, name, n), nil)
+	}
+	return nil
+}
+
+// normalizePaging 返回分页信息，Size 为 0 时使用 maxSize
+func normalizePaging(paging models.Paging, maxSize int) (models.Paging, error) {
+	if paging.Size == 0 {
+		paging.Size = maxSize
+	}
+	if paging.Index < 0 || paging.Size < 0 || paging.Size > maxSize {
+		return paging, base.NewError(base.ErrInvalidInput, fmt.Sprintf("分页参数无效: Index=%d Size=%d", paging.Index, paging.Size), nil)
+	}
+	return paging, nil
+}
+
+// partialError 将部分错误转换为 error，用于无法逐个返回部分错误的场景
+func partialError(action models.SOAPAction, partialErrors []models.BatchError) error {
+	if len(partialErrors) == 0 {
+		return nil
+	}
+	e := partialErrors[0]
+	return base.NewError(base.ErrAPIError, fmt.Sprintf("%s 返回了 %d 个部分错误，第一个为 %s (%d): %s", action, len(partialErrors), e.ErrorCode, e.Code, e.Message), nil)
+}
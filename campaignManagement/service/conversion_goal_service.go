@@ -0,0 +1,210 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// ConversionGoalService 实现转化目标服务
+type ConversionGoalService struct {
+	client *Client
+}
+
+// NewConversionGoalService 创建一个新的转化目标服务
+func NewConversionGoalService(client *Client) *ConversionGoalService {
+	return &ConversionGoalService{
+		client: client,
+	}
+}
+
+// AddConversionGoals 添加转化目标，返回与 conversionGoals 一一对应的转化目标 ID，添加失败的 ID 为 0
+func (s *ConversionGoalService) AddConversionGoals(conversionGoals []models.ConversionGoal) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("转化目标", len(conversionGoals), models.MaxConversionGoalsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddConversionGoalsRequest{
+		Namespace:       config.CampaignManagementNamespace,
+		ConversionGoals: conversionGoals,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddConversionGoals, &models.CampaignManagementBody{
+		AddConversionGoalsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddConversionGoalsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddConversionGoals)
+	}
+	return resp.ConversionGoalIds, resp.PartialErrors, nil
+}
+
+// GetConversionGoalsByIds 获取指定类型的转化目标，conversionGoalIds 为空时获取账户下的所有转化目标
+//
+// goalTypes 为空时查询 models.ConversionGoalTypes 中的所有类型。返回的转化目标为具体类型，
+// 例如 models.UrlGoal，SDK 尚不支持的类型为 models.UnknownConversionGoal。
+func (s *ConversionGoalService) GetConversionGoalsByIds(conversionGoalIds []int64, goalTypes ...models.ConversionGoalType) ([]models.ConversionGoal, []models.BatchError, error) {
+	// 创建请求
+	request := models.GetConversionGoalsByIdsRequest{
+		Namespace:           config.CampaignManagementNamespace,
+		ConversionGoalIds:   conversionGoalIds,
+		ConversionGoalTypes: joinConversionGoalTypes(goalTypes),
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetConversionGoalsByIds, &models.CampaignManagementBody{
+		GetConversionGoalsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetConversionGoalsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetConversionGoalsByIds)
+	}
+	return resp.ConversionGoals, resp.PartialErrors, nil
+}
+
+// UpdateConversionGoals 更新转化目标，conversionGoals 必须设置 Id，未设置的字段不会被修改
+func (s *ConversionGoalService) UpdateConversionGoals(conversionGoals []models.ConversionGoal) ([]models.BatchError, error) {
+	if err := checkBatchSize("转化目标", len(conversionGoals), models.MaxConversionGoalsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateConversionGoalsRequest{
+		Namespace:       config.CampaignManagementNamespace,
+		ConversionGoals: conversionGoals,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateConversionGoals, &models.CampaignManagementBody{
+		UpdateConversionGoalsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateConversionGoalsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateConversionGoals)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetConversionGoalsByTagIds 获取使用指定 UET 标签的转化目标，goalTypes 为空时查询所有类型
+func (s *ConversionGoalService) GetConversionGoalsByTagIds(tagIds []int64, goalTypes ...models.ConversionGoalType) ([]models.ConversionGoal, []models.BatchError, error) {
+	if err := checkBatchSize("UET 标签", len(tagIds), models.MaxUetTagsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetConversionGoalsByTagIdsRequest{
+		Namespace:           config.CampaignManagementNamespace,
+		TagIds:              tagIds,
+		ConversionGoalTypes: joinConversionGoalTypes(goalTypes),
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetConversionGoalsByTagIds, &models.CampaignManagementBody{
+		GetConversionGoalsByTagIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetConversionGoalsByTagIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetConversionGoalsByTagIds)
+	}
+	return resp.ConversionGoals, resp.PartialErrors, nil
+}
+
+// ApplyOfflineConversions 上报离线转化
+//
+// ConversionTime 会转换为 UTC 后发送。转化时间必须在点击之后且不早于 90 天前，
+// 离线转化目标创建后需要等待约 2 小时才能接收转化。
+func (s *ConversionGoalService) ApplyOfflineConversions(offlineConversions []models.OfflineConversion) ([]models.BatchError, error) {
+	if err := checkBatchSize("离线转化", len(offlineConversions), models.MaxOfflineConversionsPerCall); err != nil {
+		return nil, err
+	}
+
+	conversions := make([]models.OfflineConversion, len(offlineConversions))
+	for i, conversion := range offlineConversions {
+		conversion.ConversionTime = conversion.ConversionTime.UTC()
+		conversions[i] = conversion
+	}
+
+	// 创建请求
+	request := models.ApplyOfflineConversionsRequest{
+		Namespace:          config.CampaignManagementNamespace,
+		OfflineConversions: conversions,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionApplyOfflineConversions, &models.CampaignManagementBody{
+		ApplyOfflineConversionsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.ApplyOfflineConversionsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionApplyOfflineConversions)
+	}
+	return resp.PartialErrors, nil
+}
+
+// ApplyOnlineConversionAdjustments 调整或撤销已经通过 UET 上报的在线转化，AdjustmentTime 会转换为 UTC 后发送
+func (s *ConversionGoalService) ApplyOnlineConversionAdjustments(adjustments []models.OnlineConversionAdjustment) ([]models.BatchError, error) {
+	if err := checkBatchSize("转化调整", len(adjustments), models.MaxOnlineConversionAdjustmentsPerCall); err != nil {
+		return nil, err
+	}
+
+	items := make([]models.OnlineConversionAdjustment, len(adjustments))
+	for i, adjustment := range adjustments {
+		adjustment.AdjustmentTime = adjustment.AdjustmentTime.UTC()
+		items[i] = adjustment
+	}
+
+	// 创建请求
+	request := models.ApplyOnlineConversionAdjustmentsRequest{
+		Namespace:                   config.CampaignManagementNamespace,
+		OnlineConversionAdjustments: items,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionApplyOnlineConversionAdjustments, &models.CampaignManagementBody{
+		ApplyOnlineConversionAdjustmentsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.ApplyOnlineConversionAdjustmentsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionApplyOnlineConversionAdjustments)
+	}
+	return resp.PartialErrors, nil
+}
+
+// joinConversionGoalTypes 将转化目标类型拼接为以空格分隔的列表，为空时使用 models.ConversionGoalTypes
+func joinConversionGoalTypes(goalTypes []models.ConversionGoalType) string {
+	if len(goalTypes) == 0 {
+		goalTypes = models.ConversionGoalTypes
+	}
+	types := make([]string, len(goalTypes))
+	for i, goalType := range goalTypes {
+		types[i] = string(goalType)
+	}
+	return strings.Join(types, " ")
+}
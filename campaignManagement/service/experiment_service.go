@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// ExperimentService 实现活动实验服务
+type ExperimentService struct {
+	client *Client
+}
+
+// NewExperimentService 创建一个新的活动实验服务
+func NewExperimentService(client *Client) *ExperimentService {
+	return &ExperimentService{
+		client: client,
+	}
+}
+
+// AddExperiments 添加实验，返回与 experiments 一一对应的实验 ID，添加失败的实验 ID 为 0
+//
+// 添加实验时服务端会复制基础活动创建实验活动，可以通过 GetExperimentsByIds 获取 ExperimentCampaignId。
+func (s *ExperimentService) AddExperiments(experiments []models.Experiment) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("实验", len(experiments), models.MaxExperimentsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddExperimentsRequest{
+		Namespace:   config.CampaignManagementNamespace,
+		Experiments: experiments,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddExperiments, &models.CampaignManagementBody{
+		AddExperimentsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddExperimentsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddExperiments)
+	}
+	return resp.ExperimentIds, resp.PartialErrors, nil
+}
+
+// GetExperimentsByIds 根据 ID 分页获取实验，experimentIds 为空时获取账户下的所有实验
+//
+// pageInfo.Size 为 0 时使用 models.MaxPageSize，返回的实验数小于 Size 时表示已经是最后一页。
+func (s *ExperimentService) GetExperimentsByIds(experimentIds []int64, pageInfo models.Paging) ([]models.Experiment, []models.BatchError, error) {
+	paging, err := normalizePaging(pageInfo, models.MaxPageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetExperimentsByIdsRequest{
+		Namespace:     config.CampaignManagementNamespace,
+		ExperimentIds: experimentIds,
+		PageInfo:      paging,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetExperimentsByIds, &models.CampaignManagementBody{
+		GetExperimentsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetExperimentsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetExperimentsByIds)
+	}
+	return resp.Experiments, resp.PartialErrors, nil
+}
+
+// UpdateExperiments 更新实验，experiments 必须设置 Id，未设置的字段不会被修改
+func (s *ExperimentService) UpdateExperiments(experiments []models.Experiment) ([]models.BatchError, error) {
+	if err := checkBatchSize("实验", len(experiments), models.MaxExperimentsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateExperimentsRequest{
+		Namespace:   config.CampaignManagementNamespace,
+		Experiments: experiments,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateExperiments, &models.CampaignManagementBody{
+		UpdateExperimentsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateExperimentsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateExperiments)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteExperiments 删除实验，基础活动不受影响
+func (s *ExperimentService) DeleteExperiments(experimentIds []int64) ([]models.BatchError, error) {
+	if err := checkBatchSize("实验", len(experimentIds), models.MaxExperimentsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteExperimentsRequest{
+		Namespace:     config.CampaignManagementNamespace,
+		ExperimentIds: experimentIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteExperiments, &models.CampaignManagementBody{
+		DeleteExperimentsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteExperimentsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteExperiments)
+	}
+	return resp.PartialErrors, nil
+}
+
+// ExperimentsPager 返回逐页获取实验的迭代器，pageSize 为 0 时使用 models.MaxPageSize
+//
+// 响应中包含部分错误时迭代终止，Err 返回 base.ErrAPIError 错误。
+func (s *ExperimentService) ExperimentsPager(ctx context.Context, experimentIds []int64, pageSize int) *common.Pager[models.Experiment] {
+	return common.NewPager(ctx, common.PageSizeOrMax(pageSize, models.MaxPageSize), func(index, size int) ([]models.Experiment, error) {
+		experiments, partialErrors, err := s.GetExperimentsByIds(experimentIds, models.Paging{Index: index, Size: size})
+		if err != nil {
+			return nil, err
+		}
+		return experiments, partialError(models.SOAPActionGetExperimentsByIds, partialErrors)
+	})
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// LabelService 实现标签服务
+type LabelService struct {
+	client *Client
+}
+
+// NewLabelService 创建一个新的标签服务
+func NewLabelService(client *Client) *LabelService {
+	return &LabelService{
+		client: client,
+	}
+}
+
+// AddLabels 添加标签，返回与 labels 一一对应的标签 ID，添加失败的标签 ID 为 0
+func (s *LabelService) AddLabels(labels []models.Label) ([]int64, []models.BatchError, error) {
+	if err := checkBatchSize("标签", len(labels), models.MaxLabelsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddLabelsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		Labels:    labels,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddLabels, &models.CampaignManagementBody{
+		AddLabelsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddLabelsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddLabels)
+	}
+	return resp.LabelIds, resp.PartialErrors, nil
+}
+
+// UpdateLabels 更新标签，labels 必须设置 Id，未设置的字段不会被修改
+func (s *LabelService) UpdateLabels(labels []models.Label) ([]models.BatchError, error) {
+	if err := checkBatchSize("标签", len(labels), models.MaxLabelsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateLabelsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		Labels:    labels,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateLabels, &models.CampaignManagementBody{
+		UpdateLabelsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateLabelsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateLabels)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteLabels 删除标签，标签与实体的关联会一并删除
+func (s *LabelService) DeleteLabels(labelIds []int64) ([]models.BatchError, error) {
+	if err := checkBatchSize("标签", len(labelIds), models.MaxLabelsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteLabelsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		LabelIds:  labelIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteLabels, &models.CampaignManagementBody{
+		DeleteLabelsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteLabelsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteLabels)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetLabelsByIds 根据 ID 分页获取标签，labelIds 为空时获取账户下的所有标签
+//
+// pageInfo.Size 为 0 时使用 models.MaxPageSize，返回的标签数小于 Size 时表示已经是最后一页。
+func (s *LabelService) GetLabelsByIds(labelIds []int64, pageInfo models.Paging) ([]models.Label, []models.BatchError, error) {
+	paging, err := normalizePaging(pageInfo, models.MaxPageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetLabelsByIdsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		LabelIds:  labelIds,
+		PageInfo:  paging,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetLabelsByIds, &models.CampaignManagementBody{
+		GetLabelsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetLabelsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetLabelsByIds)
+	}
+	return resp.Labels, resp.PartialErrors, nil
+}
+
+// SetLabelAssociations 将标签关联到指定类型的实体
+func (s *LabelService) SetLabelAssociations(entityType models.EntityType, labelAssociations []models.LabelAssociation) ([]models.BatchError, error) {
+	if err := checkBatchSize("标签关联", len(labelAssociations), models.MaxLabelAssociationsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.SetLabelAssociationsRequest{
+		Namespace:         config.CampaignManagementNamespace,
+		EntityType:        entityType,
+		LabelAssociations: labelAssociations,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSetLabelAssociations, &models.CampaignManagementBody{
+		SetLabelAssociationsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.SetLabelAssociationsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionSetLabelAssociations)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteLabelAssociations 删除标签与实体的关联
+func (s *LabelService) DeleteLabelAssociations(entityType models.EntityType, labelAssociations []models.LabelAssociation) ([]models.BatchError, error) {
+	if err := checkBatchSize("标签关联", len(labelAssociations), models.MaxLabelAssociationsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteLabelAssociationsRequest{
+		Namespace:         config.CampaignManagementNamespace,
+		EntityType:        entityType,
+		LabelAssociations: labelAssociations,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteLabelAssociations, &models.CampaignManagementBody{
+		DeleteLabelAssociationsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteLabelAssociationsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteLabelAssociations)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetLabelAssociationsByEntityIds 获取实体关联的标签
+func (s *LabelService) GetLabelAssociationsByEntityIds(entityIds []int64, entityType models.EntityType) ([]models.LabelAssociation, []models.BatchError, error) {
+	if err := checkBatchSize("实体", len(entityIds), models.MaxLabelsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetLabelAssociationsByEntityIdsRequest{
+		Namespace:  config.CampaignManagementNamespace,
+		EntityIds:  entityIds,
+		EntityType: entityType,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetLabelAssociationsByEntityIds, &models.CampaignManagementBody{
+		GetLabelAssociationsByEntityIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetLabelAssociationsByEntityIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetLabelAssociationsByEntityIds)
+	}
+	return resp.LabelAssociations, resp.PartialErrors, nil
+}
+
+// GetLabelAssociationsByLabelIds 分页获取标签关联的实体
+//
+// pageInfo.Size 为 0 时使用 models.MaxPageSize，返回的关联数小于 Size 时表示已经是最后一页。
+func (s *LabelService) GetLabelAssociationsByLabelIds(entityType models.EntityType, labelIds []int64, pageInfo models.Paging) ([]models.LabelAssociation, []models.BatchError, error) {
+	if err := checkBatchSize("标签", len(labelIds), models.MaxLabelsPerCall); err != nil {
+		return nil, nil, err
+	}
+	paging, err := normalizePaging(pageInfo, models.MaxPageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.GetLabelAssociationsByLabelIdsRequest{
+		Namespace:  config.CampaignManagementNamespace,
+		EntityType: entityType,
+		LabelIds:   labelIds,
+		PageInfo:   paging,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetLabelAssociationsByLabelIds, &models.CampaignManagementBody{
+		GetLabelAssociationsByLabelIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetLabelAssociationsByLabelIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetLabelAssociationsByLabelIds)
+	}
+	return resp.LabelAssociations, resp.PartialErrors, nil
+}
+
+// LabelsPager 返回逐页获取标签的迭代器，pageSize 为 0 时使用 models.MaxPageSize
+//
+// 响应中包含部分错误时迭代终止，Err 返回 base.ErrAPIError 错误。
+func (s *LabelService) LabelsPager(ctx context.Context, labelIds []int64, pageSize int) *common.Pager[models.Label] {
+	return common.NewPager(ctx, common.PageSizeOrMax(pageSize, models.MaxPageSize), func(index, size int) ([]models.Label, error) {
+		labels, partialErrors, err := s.GetLabelsByIds(labelIds, models.Paging{Index: index, Size: size})
+		if err != nil {
+			return nil, err
+		}
+		return labels, partialError(models.SOAPActionGetLabelsByIds, partialErrors)
+	})
+}
+
+// LabelAssociationsPager 返回逐页获取标签关联的迭代器，pageSize 为 0 时使用 models.MaxPageSize
+//
+// 响应中包含部分错误时迭代终止，Err 返回 base.ErrAPIError 错误。
+func (s *LabelService) LabelAssociationsPager(ctx context.Context, entityType models.EntityType, labelIds []int64, pageSize int) *common.Pager[models.LabelAssociation] {
+	return common.NewPager(ctx, common.PageSizeOrMax(pageSize, models.MaxPageSize), func(index, size int) ([]models.LabelAssociation, error) {
+		associations, partialErrors, err := s.GetLabelAssociationsByLabelIds(entityType, labelIds, models.Paging{Index: index, Size: size})
+		if err != nil {
+			return nil, err
+		}
+		return associations, partialError(models.SOAPActionGetLabelAssociationsByLabelIds, partialErrors)
+	})
+}
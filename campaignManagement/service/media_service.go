@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// MediaService 实现媒体服务
+type MediaService struct {
+	client *Client
+}
+
+// NewMediaService 创建一个新的媒体服务
+func NewMediaService(client *Client) *MediaService {
+	return &MediaService{
+		client: client,
+	}
+}
+
+// AddMedia 向账户上传图片，返回与 images 一一对应的媒体 ID
+//
+// Image.Data 为图片的原始字节，序列化时自动进行 base64 编码。
+func (s *MediaService) AddMedia(accountId int64, images []models.Image) ([]int64, error) {
+	if err := checkBatchSize("媒体", len(images), models.MaxMediaPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.AddMediaRequest{
+		Namespace: config.CampaignManagementNamespace,
+		AccountId: accountId,
+		Media:     images,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddMedia, &models.CampaignManagementBody{
+		AddMediaRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.AddMediaResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionAddMedia)
+	}
+	return resp.MediaIds, nil
+}
+
+// GetMediaMetaDataByAccountId 分页获取账户下可用于指定实体的媒体元数据
+//
+// entities 为空时查询图片附加信息可用的媒体，pageInfo.Size 为 0 时使用 models.MaxMediaMetaDataPageSize。
+func (s *MediaService) GetMediaMetaDataByAccountId(entities []models.MediaEnabledEntity, pageInfo models.Paging) ([]models.MediaMetaData, error) {
+	paging, err := normalizePaging(pageInfo, models.MaxMediaMetaDataPageSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		entities = []models.MediaEnabledEntity{models.MediaEnabledEntityImageAdExtension}
+	}
+	names := make([]string, len(entities))
+	for i, entity := range entities {
+		names[i] = string(entity)
+	}
+
+	// 创建请求
+	request := models.GetMediaMetaDataByAccountIdRequest{
+		Namespace:            config.CampaignManagementNamespace,
+		MediaEnabledEntities: strings.Join(names, " "),
+		PageInfo:             paging,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetMediaMetaDataByAccountId, &models.CampaignManagementBody{
+		GetMediaMetaDataByAccountIdRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.GetMediaMetaDataByAccountIdResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionGetMediaMetaDataByAccountId)
+	}
+	return resp.MediaMetaData, nil
+}
+
+// MediaMetaDataPager 返回逐页获取媒体元数据的迭代器，pageSize 为 0 时使用 models.MaxMediaMetaDataPageSize
+func (s *MediaService) MediaMetaDataPager(ctx context.Context, entities []models.MediaEnabledEntity, pageSize int) *common.Pager[models.MediaMetaData] {
+	return common.NewPager(ctx, common.PageSizeOrMax(pageSize, models.MaxMediaMetaDataPageSize), func(index, size int) ([]models.MediaMetaData, error) {
+		return s.GetMediaMetaDataByAccountId(entities, models.Paging{Index: index, Size: size})
+	})
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// NegativeKeywordService 实现活动和广告组负面关键词服务
+type NegativeKeywordService struct {
+	client *Client
+}
+
+// NewNegativeKeywordService 创建一个新的负面关键词服务
+func NewNegativeKeywordService(client *Client) *NegativeKeywordService {
+	return &NegativeKeywordService{
+		client: client,
+	}
+}
+
+// GetNegativeKeywordsByEntityIds 获取活动或广告组上的负面关键词
+//
+// entityType 为 models.EntityTypeCampaign 时 parentEntityId 为账户 ID，
+// 为 models.EntityTypeAdGroup 时 parentEntityId 为活动 ID。
+func (s *NegativeKeywordService) GetNegativeKeywordsByEntityIds(entityIds []int64, entityType models.EntityType, parentEntityId int64) ([]models.EntityNegativeKeyword, []models.BatchError, error) {
+	if err := checkEntityType(entityType); err != nil {
+		return nil, nil, err
+	}
+	if len(entityIds) == 0 {
+		return nil, nil, base.NewError(base.ErrInvalidInput, "实体不能为空", nil)
+	}
+
+	// 创建请求
+	request := models.GetNegativeKeywordsByEntityIdsRequest{
+		Namespace:      config.CampaignManagementNamespace,
+		EntityIds:      entityIds,
+		EntityType:     entityType,
+		ParentEntityId: parentEntityId,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetNegativeKeywordsByEntityIds, &models.CampaignManagementBody{
+		GetNegativeKeywordsByEntityIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetNegativeKeywordsByEntityIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetNegativeKeywordsByEntityIds)
+	}
+	return resp.EntityNegativeKeywords, resp.PartialErrors, nil
+}
+
+// AddNegativeKeywordsToEntities 向活动或广告组添加负面关键词
+//
+// 返回的 ID 集合与 entityNegativeKeywords 一一对应，集合中的 ID 与该实体的 NegativeKeywords 一一对应。
+// 错误以 models.BatchErrorCollection 返回，其 Index 为实体的位置，BatchErrors 中的 Index 为关键词的位置。
+func (s *NegativeKeywordService) AddNegativeKeywordsToEntities(entityNegativeKeywords []models.EntityNegativeKeyword) ([]models.IdCollection, []models.BatchErrorCollection, error) {
+	if err := checkEntityNegativeKeywords(entityNegativeKeywords); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddNegativeKeywordsToEntitiesRequest{
+		Namespace:              config.CampaignManagementNamespace,
+		EntityNegativeKeywords: entityNegativeKeywords,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddNegativeKeywordsToEntities, &models.CampaignManagementBody{
+		AddNegativeKeywordsToEntitiesRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddNegativeKeywordsToEntitiesResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddNegativeKeywordsToEntities)
+	}
+	return resp.NegativeKeywordIds, resp.NestedPartialErrors, nil
+}
+
+// DeleteNegativeKeywordsFromEntities 从活动或广告组删除负面关键词，NegativeKeywords 中的关键词必须设置 Id
+func (s *NegativeKeywordService) DeleteNegativeKeywordsFromEntities(entityNegativeKeywords []models.EntityNegativeKeyword) ([]models.BatchErrorCollection, error) {
+	if err := checkEntityNegativeKeywords(entityNegativeKeywords); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteNegativeKeywordsFromEntitiesRequest{
+		Namespace:              config.CampaignManagementNamespace,
+		EntityNegativeKeywords: entityNegativeKeywords,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteNegativeKeywordsFromEntities, &models.CampaignManagementBody{
+		DeleteNegativeKeywordsFromEntitiesRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteNegativeKeywordsFromEntitiesResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteNegativeKeywordsFromEntities)
+	}
+	return resp.NestedPartialErrors, nil
+}
+
+// checkEntityType 检查实体类型是否为活动或广告组
+func checkEntityType(entityType models.EntityType) error {
+	if entityType != models.EntityTypeCampaign && entityType != models.EntityTypeAdGroup {
+		return base.NewError(base.ErrInvalidInput, fmt.Sprintf("负面关键词只支持 Campaign 和 AdGroup 实体，实际为 %q", entityType), nil)
+	}
+	return nil
+}
+
+// checkEntityNegativeKeywords 检查实体类型以及每个实体的负面关键词数量
+func checkEntityNegativeKeywords(entityNegativeKeywords []models.EntityNegativeKeyword) error {
+	if len(entityNegativeKeywords) == 0 {
+		return base.NewError(base.ErrInvalidInput, "实体不能为空", nil)
+	}
+	for _, entity := range entityNegativeKeywords {
+		if err := checkEntityType(entity.EntityType); err != nil {
+			return err
+		}
+		if err := checkBatchSize(fmt.Sprintf("实体 %d 的负面关键词", entity.EntityId), len(entity.NegativeKeywords), models.MaxNegativeKeywordsPerEntity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
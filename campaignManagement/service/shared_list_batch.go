@@ -0,0 +1,90 @@
+package service
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// AddListItemsToSharedListInBatches 分批向共享列表添加项目
+//
+// 列表项按 API 上限拆分为多个批次发送，返回的 ID 与 listItems 一一对应，
+// BatchError.Index 已经换算为 listItems 中的位置。某个批次请求失败时返回
+// common.ChunkError，已成功批次的结果仍会返回。
+func (s *SharedListService) AddListItemsToSharedListInBatches(sharedList models.SharedListLike, listItems []models.SharedListItem, scope models.EntityScope, opts models.BatchOptions) ([]int64, []models.BatchError, error) {
+	ids := make([]int64, len(listItems))
+	collector := &batchErrorCollector{}
+
+	err := common.RunChunks(len(listItems), batchSize(opts), opts.Concurrency, func(r common.ChunkRange) error {
+		chunkIds, partialErrors, err := s.AddListItemsToSharedList(sharedList, listItems[r.Start:r.End], scope)
+		if err != nil {
+			return err
+		}
+
+		copy(ids[r.Start:r.End], chunkIds)
+		collector.add(r.Start, partialErrors)
+		return nil
+	})
+
+	return ids, collector.sorted(), err
+}
+
+// DeleteListItemsFromSharedListInBatches 分批从共享列表删除项目
+//
+// BatchError.Index 已经换算为 listItemIds 中的位置。某个批次请求失败时返回
+// common.ChunkError，已成功批次的部分错误仍会返回。
+func (s *SharedListService) DeleteListItemsFromSharedListInBatches(sharedList models.SharedListLike, listItemIds []int64, scope models.EntityScope, opts models.BatchOptions) ([]models.BatchError, error) {
+	collector := &batchErrorCollector{}
+
+	err := common.RunChunks(len(listItemIds), batchSize(opts), opts.Concurrency, func(r common.ChunkRange) error {
+		partialErrors, err := s.DeleteListItemsFromSharedList(sharedList, listItemIds[r.Start:r.End], scope)
+		if err != nil {
+			return err
+		}
+
+		collector.add(r.Start, partialErrors)
+		return nil
+	})
+
+	return collector.sorted(), err
+}
+
+// batchSize 返回实际使用的批次大小
+func batchSize(opts models.BatchOptions) int {
+	if opts.BatchSize <= 0 || opts.BatchSize > models.MaxListItemsPerCall {
+		return models.MaxListItemsPerCall
+	}
+	return opts.BatchSize
+}
+
+// batchErrorCollector 并发安全地收集各批次的部分错误
+type batchErrorCollector struct {
+	mu     sync.Mutex
+	errors []models.BatchError
+}
+
+// add 将批次内的索引换算为原始切片中的位置后加入结果
+func (c *batchErrorCollector) add(offset int, partialErrors []models.BatchError) {
+	if len(partialErrors) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range partialErrors {
+		e.Index += offset
+		c.errors = append(c.errors, e)
+	}
+}
+
+// sorted 返回按索引排序的部分错误
+func (c *batchErrorCollector) sorted() []models.BatchError {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sort.SliceStable(c.errors, func(i, j int) bool {
+		return c.errors[i].Index < c.errors[j].Index
+	})
+	return c.errors
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+// ExportSharedLists 获取多种类型的所有共享列表及其项目，结果按 entityTypes 的顺序排列
+//
+// 重复的类型只获取一次。返回的结果可以通过 models.WriteSharedListsCSV 或
+// models.WriteSharedListsJSON 写入文件，文件可以再用 models.ReadListItemsCSV 或
+// models.ReadListItemsJSON 读回。某个列表获取失败时返回错误以及已经获取的列表。
+func (s *SharedListService) ExportSharedLists(entityTypes []models.SharedEntityType, scope models.EntityScope) ([]models.SharedListExport, error) {
+	if len(entityTypes) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "共享列表类型不能为空", nil)
+	}
+
+	var lists []models.SharedListExport
+	seen := make(map[models.SharedEntityType]bool, len(entityTypes))
+	for _, entityType := range entityTypes {
+		if seen[entityType] {
+			continue
+		}
+		seen[entityType] = true
+
+		exports, err := s.exportSharedListsOfType(entityType, scope)
+		lists = append(lists, exports...)
+		if err != nil {
+			return lists, err
+		}
+	}
+	return lists, nil
+}
+
+// exportSharedListsOfType 获取某种类型的所有共享列表及其项目
+func (s *SharedListService) exportSharedListsOfType(entityType models.SharedEntityType, scope models.EntityScope) ([]models.SharedListExport, error) {
+	entities, err := s.GetSharedEntities(entityType, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	lists := make([]models.SharedListExport, 0, len(entities))
+	for _, entity := range entities {
+		typ := models.SharedEntityType(entity.Type)
+		if typ == "" {
+			typ = entityType
+		}
+
+		list := models.SharedList{SharedEntity: models.SharedEntity{Id: entity.Id}, ItemType: string(typ)}
+		items, err := s.GetListItemsBySharedList(list, scope)
+		if err != nil {
+			return lists, err
+		}
+
+		export := models.SharedListExport{
+			Id:    entity.Id.Value(),
+			Name:  entity.Name,
+			Type:  typ,
+			Items: make([]models.ListItemRecord, len(items)),
+		}
+		for i, item := range items {
+			export.Items[i] = models.NewListItemRecord(item)
+		}
+		lists = append(lists, export)
+	}
+	return lists, nil
+}
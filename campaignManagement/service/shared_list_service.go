@@ -1,8 +1,6 @@
 package service
 
 import (
-	"fmt"
-
 	"github.com/vancevox/bingads-go/config"
 
 	"github.com/vancevox/bingads-go/campaignManagement/models"
@@ -21,42 +19,26 @@ func NewSharedListService(client *Client) *SharedListService {
 }
 
 // GetListItemsBySharedList 获取共享列表中的项目
-func (s *SharedListService) GetListItemsBySharedList(sharedList any, scope models.EntityScope) ([]models.SharedListItem, error) {
-	var sharedListObj models.SharedList
-	var sharedListType string
-
-	// 根据不同的派生类型设置请求
-	switch list := sharedList.(type) {
-	case models.NegativeKeywordList:
-		sharedListObj = list.SharedList
-		sharedListType = "NegativeKeywordList"
-	case models.PlacementExclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "PlacementExclusionList"
-	case models.AccountNegativeKeywordList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountNegativeKeywordList"
-	case models.BrandList:
-		sharedListObj = list.SharedList
-		sharedListType = "BrandList"
-	case models.AccountPlacementExclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountPlacementExclusionList"
-	case models.AccountPlacementInclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountPlacementInclusionList"
-	case models.SharedList:
-		sharedListObj = list
-		sharedListType = list.ItemType
-	default:
-		return nil, fmt.Errorf("不支持的共享列表类型: %T", sharedList)
-	}
-
-	sharedListObj.ItemType = sharedListType
+//
+// 遇到无法识别的列表项类型时返回 models.UnknownListItemTypeError。
+func (s *SharedListService) GetListItemsBySharedList(sharedList models.SharedListLike, scope models.EntityScope) ([]models.SharedListItem, error) {
+	items, err := s.GetTypedListItemsBySharedList(sharedList, scope, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return items.SharedListItems()
+}
+
+// GetTypedListItemsBySharedList 获取共享列表中的项目，并按 i:type 解码为具体类型
+//
+// preserveUnknown 为 true 时无法识别的列表项会保留为 models.UnknownListItem，
+// 否则返回 models.UnknownListItemTypeError。
+func (s *SharedListService) GetTypedListItemsBySharedList(sharedList models.SharedListLike, scope models.EntityScope, preserveUnknown bool) (models.ListItems, error) {
 	// 创建请求
 	request := models.GetListItemsBySharedListRequest{
 		Namespace:         config.CampaignManagementNamespace,
-		SharedList:        sharedListObj,
+		SharedList:        sharedList.AsSharedList(),
 		SharedEntityScope: scope,
 	}
 
@@ -80,7 +62,14 @@ func (s *SharedListService) GetListItemsBySharedList(sharedList any, scope model
 		return nil, err
 	}
 
-	return response.Body.GetListItemsBySharedListResponse.ListItems, nil
+	items := response.Body.GetListItemsBySharedListResponse.ListItems
+	if !preserveUnknown {
+		if err := items.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
 }
 
 // GetSharedEntities 获取共享实体
@@ -156,39 +145,7 @@ func (s *SharedListService) GetSharedEntityAssociationsBySharedEntityIds(
 }
 
 // AddListItemsToSharedList 向共享列表添加项目
-func (s *SharedListService) AddListItemsToSharedList(sharedList any, listItems []models.SharedListItem, scope models.EntityScope) ([]int64, []models.BatchError, error) {
-	var sharedListObj models.SharedList
-	var sharedListType string
-
-	// 根据不同的派生类型设置请求
-	switch list := sharedList.(type) {
-	case models.NegativeKeywordList:
-		sharedListObj = list.SharedList
-		sharedListType = "NegativeKeywordList"
-	case models.PlacementExclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "PlacementExclusionList"
-	case models.AccountNegativeKeywordList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountNegativeKeywordList"
-	case models.BrandList:
-		sharedListObj = list.SharedList
-		sharedListType = "BrandList"
-	case models.AccountPlacementExclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountPlacementExclusionList"
-	case models.AccountPlacementInclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountPlacementInclusionList"
-	case models.SharedList:
-		sharedListObj = list
-		sharedListType = list.ItemType
-	default:
-		return nil, nil, fmt.Errorf("不支持的共享列表类型: %T", sharedList)
-	}
-
-	sharedListObj.ItemType = sharedListType
-
+func (s *SharedListService) AddListItemsToSharedList(sharedList models.SharedListLike, listItems []models.SharedListItem, scope models.EntityScope) ([]int64, []models.BatchError, error) {
 	// 确保每个列表项都有正确的ItemType设置
 	for i := range listItems {
 		if listItems[i].ItemType == "" {
@@ -200,7 +157,7 @@ func (s *SharedListService) AddListItemsToSharedList(sharedList any, listItems [
 	request := models.AddListItemsToSharedListRequest{
 		Namespace:         config.CampaignManagementNamespace,
 		ListItems:         listItems,
-		SharedList:        sharedListObj,
+		SharedList:        sharedList.AsSharedList(),
 		SharedEntityScope: scope,
 	}
 
@@ -225,48 +182,19 @@ func (s *SharedListService) AddListItemsToSharedList(sharedList any, listItems [
 	}
 
 	resp := response.Body.AddListItemsToSharedListResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddListItemsToSharedList)
+	}
 	return resp.ListItemIds, resp.PartialErrors, nil
 }
 
 // DeleteListItemsFromSharedList 从共享列表中删除项目
-func (s *SharedListService) DeleteListItemsFromSharedList(sharedList any, listItemIds []int64, scope models.EntityScope) ([]models.BatchError, error) {
-	var sharedListObj models.SharedList
-	var sharedListType string
-
-	// 根据不同的派生类型设置请求
-	switch list := sharedList.(type) {
-	case models.NegativeKeywordList:
-		sharedListObj = list.SharedList
-		sharedListType = "NegativeKeywordList"
-	case models.PlacementExclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "PlacementExclusionList"
-	case models.AccountNegativeKeywordList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountNegativeKeywordList"
-	case models.BrandList:
-		sharedListObj = list.SharedList
-		sharedListType = "BrandList"
-	case models.AccountPlacementExclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountPlacementExclusionList"
-	case models.AccountPlacementInclusionList:
-		sharedListObj = list.SharedList
-		sharedListType = "AccountPlacementInclusionList"
-	case models.SharedList:
-		sharedListObj = list
-		sharedListType = list.ItemType
-	default:
-		return nil, fmt.Errorf("不支持的共享列表类型: %T", sharedList)
-	}
-
-	sharedListObj.ItemType = sharedListType
-
+func (s *SharedListService) DeleteListItemsFromSharedList(sharedList models.SharedListLike, listItemIds []int64, scope models.EntityScope) ([]models.BatchError, error) {
 	// 创建请求
 	request := models.DeleteListItemsFromSharedListRequest{
 		Namespace:         config.CampaignManagementNamespace,
 		ListItemIds:       listItemIds,
-		SharedList:        sharedListObj,
+		SharedList:        sharedList.AsSharedList(),
 		SharedEntityScope: scope,
 	}
 
@@ -291,5 +219,102 @@ func (s *SharedListService) DeleteListItemsFromSharedList(sharedList any, listIt
 	}
 
 	resp := response.Body.DeleteListItemsFromSharedListResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteListItemsFromSharedList)
+	}
+	return resp.PartialErrors, nil
+}
+
+// GetListItemsBySharedListAny 获取共享列表中的项目
+//
+// Deprecated: 使用 GetListItemsBySharedList，传入实现了 models.SharedListLike 的类型。
+func (s *SharedListService) GetListItemsBySharedListAny(sharedList any, scope models.EntityScope) ([]models.SharedListItem, error) {
+	list, err := models.SharedListFromAny(sharedList)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetListItemsBySharedList(list, scope)
+}
+
+// AddListItemsToSharedListAny 向共享列表添加项目
+//
+// Deprecated: 使用 AddListItemsToSharedList，传入实现了 models.SharedListLike 的类型。
+func (s *SharedListService) AddListItemsToSharedListAny(sharedList any, listItems []models.SharedListItem, scope models.EntityScope) ([]int64, []models.BatchError, error) {
+	list, err := models.SharedListFromAny(sharedList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.AddListItemsToSharedList(list, listItems, scope)
+}
+
+// DeleteListItemsFromSharedListAny 从共享列表中删除项目
+//
+// Deprecated: 使用 DeleteListItemsFromSharedList，传入实现了 models.SharedListLike 的类型。
+func (s *SharedListService) DeleteListItemsFromSharedListAny(sharedList any, listItemIds []int64, scope models.EntityScope) ([]models.BatchError, error) {
+	list, err := models.SharedListFromAny(sharedList)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DeleteListItemsFromSharedList(list, listItemIds, scope)
+}
+
+// SetSharedEntityAssociations 将共享实体关联到活动或账户
+//
+// scope 为空时使用服务端默认的账户级范围，客户级共享列表使用 models.EntityScopeCustomer。
+func (s *SharedListService) SetSharedEntityAssociations(associations []models.SharedEntityAssociation, scope models.EntityScope) ([]models.BatchError, error) {
+	if err := checkBatchSize("共享实体关联", len(associations), models.MaxSharedEntityAssociationsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.SetSharedEntityAssociationsRequest{
+		Namespace:         config.CampaignManagementNamespace,
+		Associations:      associations,
+		SharedEntityScope: scope,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSetSharedEntityAssociations, &models.CampaignManagementBody{
+		SetSharedEntityAssociationsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.SetSharedEntityAssociationsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionSetSharedEntityAssociations)
+	}
+	return resp.PartialErrors, nil
+}
+
+// DeleteSharedEntityAssociations 删除共享实体与活动或账户的关联
+func (s *SharedListService) DeleteSharedEntityAssociations(associations []models.SharedEntityAssociation, scope models.EntityScope) ([]models.BatchError, error) {
+	if err := checkBatchSize("共享实体关联", len(associations), models.MaxSharedEntityAssociationsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.DeleteSharedEntityAssociationsRequest{
+		Namespace:         config.CampaignManagementNamespace,
+		Associations:      associations,
+		SharedEntityScope: scope,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionDeleteSharedEntityAssociations, &models.CampaignManagementBody{
+		DeleteSharedEntityAssociationsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.DeleteSharedEntityAssociationsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionDeleteSharedEntityAssociations)
+	}
 	return resp.PartialErrors, nil
 }
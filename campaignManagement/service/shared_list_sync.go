@@ -0,0 +1,61 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+// PlanSharedListSync 获取共享列表的当前项目，计算将其同步为 desired 所需的修改
+func (s *SharedListService) PlanSharedListSync(sharedList models.SharedListLike, desired []models.SharedListItem, scope models.EntityScope) (*models.SharedListSyncPlan, error) {
+	current, err := s.GetListItemsBySharedList(sharedList, scope)
+	if err != nil {
+		return nil, err
+	}
+	return models.PlanSharedListSync(current, desired)
+}
+
+// ApplySharedListSync 按计划先删除再添加项目，两者都按 opts 分批发送
+//
+// 共享列表的项目数有上限，先删除才能为新项目腾出空间。删除阶段有批次请求失败时返回
+// common.ChunkError 以及已经执行的结果，不再添加；部分项目删除失败时仍继续添加，失败的
+// 项目通过 SharedListSyncResult 返回。
+func (s *SharedListService) ApplySharedListSync(sharedList models.SharedListLike, plan *models.SharedListSyncPlan, scope models.EntityScope, opts models.BatchOptions) (*models.SharedListSyncResult, error) {
+	if plan == nil {
+		return nil, base.NewError(base.ErrInvalidInput, "同步计划不能为空", nil)
+	}
+	result := &models.SharedListSyncResult{}
+
+	if len(plan.Delete) > 0 {
+		deleteErrors, err := s.DeleteListItemsFromSharedListInBatches(sharedList, plan.DeleteIds(), scope, opts)
+		result.DeleteErrors = deleteErrors
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if len(plan.Add) > 0 {
+		ids, addErrors, err := s.AddListItemsToSharedListInBatches(sharedList, plan.Add, scope, opts)
+		result.AddedIds = ids
+		result.AddErrors = addErrors
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// SyncSharedList 将共享列表同步为 desired，使列表恰好包含这些项目
+//
+// 返回执行的计划；opts.DryRun 为 true 时只计算计划，返回的结果为 nil。
+func (s *SharedListService) SyncSharedList(sharedList models.SharedListLike, desired []models.SharedListItem, scope models.EntityScope, opts models.SyncOptions) (*models.SharedListSyncPlan, *models.SharedListSyncResult, error) {
+	plan, err := s.PlanSharedListSync(sharedList, desired, scope)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts.DryRun || plan.Empty() {
+		return plan, nil, nil
+	}
+
+	result, err := s.ApplySharedListSync(sharedList, plan, scope, opts.BatchOptions)
+	return plan, result, err
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// UetTagService 实现 UET 标签服务
+type UetTagService struct {
+	client *Client
+}
+
+// NewUetTagService 创建一个新的 UET 标签服务
+func NewUetTagService(client *Client) *UetTagService {
+	return &UetTagService{
+		client: client,
+	}
+}
+
+// AddUetTags 添加 UET 标签
+//
+// 返回的标签与 uetTags 一一对应，包含服务端分配的 Id 以及需要部署到网站的 TrackingScript。
+func (s *UetTagService) AddUetTags(uetTags []models.UetTag) ([]models.UetTag, []models.BatchError, error) {
+	if err := checkBatchSize("UET 标签", len(uetTags), models.MaxUetTagsPerCall); err != nil {
+		return nil, nil, err
+	}
+
+	// 创建请求
+	request := models.AddUetTagsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		UetTags:   uetTags,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddUetTags, &models.CampaignManagementBody{
+		AddUetTagsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.AddUetTagsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionAddUetTags)
+	}
+	return resp.UetTags, resp.PartialErrors, nil
+}
+
+// GetUetTagsByIds 获取 UET 标签，tagIds 为空时获取账户下的所有标签
+func (s *UetTagService) GetUetTagsByIds(tagIds []int64) ([]models.UetTag, []models.BatchError, error) {
+	// 创建请求
+	request := models.GetUetTagsByIdsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		TagIds:    tagIds,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetUetTagsByIds, &models.CampaignManagementBody{
+		GetUetTagsByIdsRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetUetTagsByIdsResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetUetTagsByIds)
+	}
+	return resp.UetTags, resp.PartialErrors, nil
+}
+
+// UpdateUetTags 更新 UET 标签，uetTags 必须设置 Id，未设置的字段不会被修改
+func (s *UetTagService) UpdateUetTags(uetTags []models.UetTag) ([]models.BatchError, error) {
+	if err := checkBatchSize("UET 标签", len(uetTags), models.MaxUetTagsPerCall); err != nil {
+		return nil, err
+	}
+
+	// 创建请求
+	request := models.UpdateUetTagsRequest{
+		Namespace: config.CampaignManagementNamespace,
+		UetTags:   uetTags,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateUetTags, &models.CampaignManagementBody{
+		UpdateUetTagsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := body.UpdateUetTagsResponse
+	if resp == nil {
+		return nil, missingResponse(models.SOAPActionUpdateUetTags)
+	}
+	return resp.PartialErrors, nil
+}
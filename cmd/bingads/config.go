@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vancevox/bingads-go/config"
+)
+
+// 读取配置时使用的环境变量
+const (
+	envConfigFile       = "BINGADS_CONFIG"
+	envDeveloperToken   = "BINGADS_DEVELOPER_TOKEN"
+	envAuthToken        = "BINGADS_AUTH_TOKEN"
+	envCustomerID       = "BINGADS_CUSTOMER_ID"
+	envAccountID        = "BINGADS_ACCOUNT_ID"
+	envEnvironment      = "BINGADS_ENVIRONMENT"
+	envCampaignEndpoint = "BINGADS_CAMPAIGN_ENDPOINT"
+)
+
+// fileConfig 为配置文件的内容
+type fileConfig struct {
+	DeveloperToken   string `json:"developer_token"`
+	AuthToken        string `json:"auth_token"`
+	CustomerID       string `json:"customer_id"`
+	AccountID        string `json:"account_id"`
+	Environment      string `json:"environment"`
+	CampaignEndpoint string `json:"campaign_endpoint"`
+}
+
+// defaultConfigPath 返回默认的配置文件路径，即用户配置目录下的 bingads/config.json
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "bingads", "config.json")
+}
+
+// loadConfig 读取配置
+//
+// 配置文件依次取 path、$BINGADS_CONFIG 和默认路径，默认路径的文件不存在时忽略；
+// 环境变量中的值覆盖配置文件中的值。
+func loadConfig(path string) (*config.Config, error) {
+	var fc fileConfig
+
+	explicit := path != ""
+	if !explicit {
+		path = os.Getenv(envConfigFile)
+		explicit = path != ""
+	}
+	if !explicit {
+		path = defaultConfigPath()
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, &fc); err != nil {
+				return nil, fmt.Errorf("解析配置文件 %s 失败: %w", path, err)
+			}
+		case explicit || !errors.Is(err, os.ErrNotExist):
+			return nil, fmt.Errorf("读取配置文件失败: %w", err)
+		}
+	}
+
+	for env, field := range map[string]*string{
+		envDeveloperToken:   &fc.DeveloperToken,
+		envAuthToken:        &fc.AuthToken,
+		envCustomerID:       &fc.CustomerID,
+		envAccountID:        &fc.AccountID,
+		envEnvironment:      &fc.Environment,
+		envCampaignEndpoint: &fc.CampaignEndpoint,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+
+	auth := config.NewAuthConfig(fc.DeveloperToken, fc.AuthToken, fc.CustomerID, fc.AccountID)
+	if !auth.IsValid() {
+		return nil, fmt.Errorf("缺少认证配置，请设置 %s、%s 和 %s 或在配置文件中设置 developer_token、auth_token 和 customer_id",
+			envDeveloperToken, envAuthToken, envCustomerID)
+	}
+
+	api := config.DefaultConfig()
+	switch config.Environment(fc.Environment) {
+	case "", config.Production:
+	case config.Sandbox:
+		api.Env = config.Sandbox
+	default:
+		return nil, fmt.Errorf("未知的环境 %q，只支持 %s 和 %s", fc.Environment, config.Production, config.Sandbox)
+	}
+	api.CampaignEndpoint = fc.CampaignEndpoint
+
+	return config.NewConfig(auth, api), nil
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/campaignManagement/service"
+)
+
+// listRow 为 lists ls 输出的一行
+type listRow struct {
+	Id               int64  `json:"id"`
+	Name             string `json:"name"`
+	Type             string `json:"type"`
+	ItemCount        int    `json:"item_count"`
+	AssociationCount int    `json:"association_count"`
+}
+
+// associationRow 为 lists associations 输出的一行
+type associationRow struct {
+	SharedListId int64  `json:"shared_list_id"`
+	EntityId     int64  `json:"entity_id"`
+	EntityType   string `json:"entity_type"`
+}
+
+// changeRow 为 add-items 和 delete-items 输出的一行
+type changeRow struct {
+	Action    models.ChangeAction   `json:"action"`
+	Item      models.ListItemRecord `json:"item"`
+	Status    string                `json:"status"`
+	ErrorCode string                `json:"error_code,omitempty"`
+	Message   string                `json:"message,omitempty"`
+}
+
+// 修改的执行状态
+const (
+	statusPlanned    = "planned"
+	statusOK         = "ok"
+	statusFailed     = "failed"
+	statusNotApplied = "not_applied"
+)
+
+// setupLs 列出共享列表
+func setupLs(fs *flag.FlagSet) runFunc {
+	return func(client *service.Client, opts *options, _ []string, _ io.Writer) (*result, error) {
+		entities, err := client.SharedListService().GetSharedEntities(models.SharedEntityType(opts.listType), models.EntityScope(opts.scope))
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([]listRow, len(entities))
+		res := &result{columns: []string{"id", "name", "type", "item_count", "association_count"}, value: rows}
+		for i, entity := range entities {
+			rows[i] = listRow{
+				Id:               entity.Id.Value(),
+				Name:             entity.Name,
+				Type:             entity.Type,
+				ItemCount:        entity.ItemCount.Value(),
+				AssociationCount: entity.AssociationCount.Value(),
+			}
+			res.rows = append(res.rows, []string{
+				strconv.FormatInt(rows[i].Id, 10),
+				rows[i].Name,
+				rows[i].Type,
+				strconv.Itoa(rows[i].ItemCount),
+				strconv.Itoa(rows[i].AssociationCount),
+			})
+		}
+		return res, nil
+	}
+}
+
+// setupItems 列出共享列表中的项目，CSV 输出可以直接用于 add-items
+func setupItems(fs *flag.FlagSet) runFunc {
+	return func(client *service.Client, opts *options, args []string, _ io.Writer) (*result, error) {
+		list, err := changeSharedList(opts, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := client.SharedListService().GetListItemsBySharedList(list.AsSharedList(), models.EntityScope(opts.scope))
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]models.ListItemRecord, len(items))
+		res := &result{columns: models.ListItemColumns, value: records}
+		for i, item := range items {
+			records[i] = models.NewListItemRecord(item)
+			res.rows = append(res.rows, records[i].Values())
+		}
+		return res, nil
+	}
+}
+
+// setupAddItems 从文件添加项目
+func setupAddItems(fs *flag.FlagSet) runFunc {
+	file := fs.String("file", "", "CSV 或 JSON 文件，扩展名为 .json 时按 JSON 读取")
+	dryRun := fs.Bool("dry-run", false, "只输出计划，不修改共享列表")
+	batchSize := fs.Int("batch-size", 0, "每批最多的项目数，0 表示使用 API 上限")
+
+	return func(client *service.Client, opts *options, args []string, stdout io.Writer) (*result, error) {
+		list, err := changeSharedList(opts, args[0])
+		if err != nil {
+			return nil, err
+		}
+		if *file == "" {
+			return nil, usageError("缺少 --file")
+		}
+
+		items, err := readListItemsFile(*file)
+		if err != nil {
+			return nil, err
+		}
+		for i := range items {
+			items[i].ID = base.Nillable[int64]{}
+		}
+
+		var cs models.ChangeSet
+		cs.AddListItems(list, models.EntityScope(opts.scope), items...)
+		return applyChanges(client, &cs, opts.output, *dryRun, *batchSize, stdout)
+	}
+}
+
+// setupDeleteItems 按 ID 或文件删除项目
+func setupDeleteItems(fs *flag.FlagSet) runFunc {
+	ids := fs.String("ids", "", "逗号分隔的项目 ID")
+	file := fs.String("file", "", "CSV 或 JSON 文件，没有 id 的行按内容匹配共享列表中的项目")
+	dryRun := fs.Bool("dry-run", false, "只输出计划，不修改共享列表")
+	batchSize := fs.Int("batch-size", 0, "每批最多的项目数，0 表示使用 API 上限")
+
+	return func(client *service.Client, opts *options, args []string, stdout io.Writer) (*result, error) {
+		list, err := changeSharedList(opts, args[0])
+		if err != nil {
+			return nil, err
+		}
+		if (*ids == "") == (*file == "") {
+			return nil, usageError("需要 --ids 或 --file 其中之一")
+		}
+
+		var wanted []models.SharedListItem
+		if *file != "" {
+			if wanted, err = readListItemsFile(*file); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range splitList(*ids) {
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, usageError("无效的项目 ID %q", v)
+			}
+			wanted = append(wanted, models.ListItemRecord{Id: id}.SharedListItem())
+		}
+
+		// 读取共享列表中的项目，用于补全计划中的项目内容并检查项目是否存在
+		scope := models.EntityScope(opts.scope)
+		current, err := client.SharedListService().GetListItemsBySharedList(list.AsSharedList(), scope)
+		if err != nil {
+			return nil, err
+		}
+		items, err := matchListItems(current, wanted)
+		if err != nil {
+			return nil, err
+		}
+
+		var cs models.ChangeSet
+		cs.DeleteListItems(list, scope, items...)
+		return applyChanges(client, &cs, opts.output, *dryRun, *batchSize, stdout)
+	}
+}
+
+// setupAssociations 列出共享列表关联的活动或账户
+func setupAssociations(fs *flag.FlagSet) runFunc {
+	entityType := fs.String("entity-type", string(models.EntityTypeCampaign), "关联的实体类型：Campaign 或 Account")
+
+	return func(client *service.Client, opts *options, args []string, _ io.Writer) (*result, error) {
+		ids := make([]int64, len(args))
+		for i, arg := range args {
+			id, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				return nil, usageError("无效的共享列表 ID %q", arg)
+			}
+			ids[i] = id
+		}
+
+		associations, partialErrors, err := client.SharedListService().GetSharedEntityAssociationsBySharedEntityIds(
+			models.EntityType(*entityType), ids, models.SharedEntityType(opts.listType), models.EntityScope(opts.scope))
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([]associationRow, len(associations))
+		res := &result{columns: []string{"shared_list_id", "entity_id", "entity_type"}, value: rows}
+		for i, a := range associations {
+			rows[i] = associationRow{SharedListId: a.SharedEntityId, EntityId: a.EntityId, EntityType: string(a.EntityType)}
+			res.rows = append(res.rows, []string{strconv.FormatInt(a.SharedEntityId, 10), strconv.FormatInt(a.EntityId, 10), string(a.EntityType)})
+		}
+		if len(partialErrors) > 0 {
+			e := partialErrors[0]
+			return res, fmt.Errorf("%d 个共享列表查询失败，第一个为 %s (%d) %s", len(partialErrors), e.ErrorCode, e.Code, e.Message)
+		}
+		return res, nil
+	}
+}
+
+// changeSharedList 根据共享列表 ID 参数和 --type 返回共享列表
+func changeSharedList(opts *options, arg string) (models.ChangeSharedList, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return models.ChangeSharedList{}, usageError("无效的共享列表 ID %q", arg)
+	}
+	return models.ChangeSharedList{Id: id, Type: models.SharedEntityType(opts.listType)}, nil
+}
+
+// readListItemsFile 从 CSV 或 JSON 文件读取项目，文件中有无效值时返回所有错误
+func readListItemsFile(path string) ([]models.SharedListItem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []models.SharedListItem
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		items, err = models.ReadListItemsJSON(f)
+	} else {
+		items, err = models.ReadListItemsCSV(f)
+	}
+	if fileErr, ok := err.(*models.ListItemFileError); ok {
+		lines := make([]string, len(fileErr.Errors))
+		for i, e := range fileErr.Errors {
+			lines[i] = "  " + e.Error()
+		}
+		return nil, fmt.Errorf("%s 中有 %d 处错误:\n%s", path, len(fileErr.Errors), strings.Join(lines, "\n"))
+	}
+	return items, err
+}
+
+// matchListItems 在 current 中查找 wanted 对应的项目，有 ID 的按 ID 匹配，否则按内容匹配
+func matchListItems(current, wanted []models.SharedListItem) ([]models.SharedListItem, error) {
+	byId := make(map[int64]models.SharedListItem, len(current))
+	byKey := make(map[string]models.SharedListItem, len(current))
+	for _, item := range current {
+		byId[item.ID.Value()] = item
+		if key, err := models.SharedListItemKey(item); err == nil {
+			byKey[key] = item
+		}
+	}
+
+	var (
+		items   []models.SharedListItem
+		missing []string
+		seen    = make(map[int64]bool)
+	)
+	for _, w := range wanted {
+		var (
+			item models.SharedListItem
+			ok   bool
+		)
+		if w.ID.HasValue() {
+			item, ok = byId[w.ID.Value()]
+		} else if key, err := models.SharedListItemKey(w); err == nil {
+			item, ok = byKey[key]
+		}
+		if !ok {
+			missing = append(missing, describeWanted(w))
+			continue
+		}
+		if !seen[item.ID.Value()] {
+			seen[item.ID.Value()] = true
+			items = append(items, item)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("共享列表中没有以下项目: %s", strings.Join(missing, ", "))
+	}
+	return items, nil
+}
+
+// describeWanted 返回要删除的项目的简短描述
+func describeWanted(item models.SharedListItem) string {
+	if item.ID.HasValue() {
+		return "Id " + strconv.FormatInt(item.ID.Value(), 10)
+	}
+	record := models.NewListItemRecord(item)
+	for _, v := range []string{record.Text, record.Url} {
+		if v != "" {
+			return strconv.Quote(v)
+		}
+	}
+	return "BrandId " + strconv.FormatInt(record.BrandId, 10)
+}
+
+// applyChanges 输出或执行变更集，返回每项修改的执行结果
+//
+// 表格输出时 dry-run 输出文本计划；JSON 和 CSV 输出时 dry-run 返回状态为 planned 的修改，
+// 格式与执行结果相同。
+func applyChanges(client *service.Client, cs *models.ChangeSet, output string, dryRun bool, batchSize int, stdout io.Writer) (*result, error) {
+	if output == outputTable {
+		if len(cs.Changes) == 0 {
+			fmt.Fprintln(stdout, "没有需要执行的修改。")
+			return nil, nil
+		}
+		if dryRun {
+			fmt.Fprint(stdout, cs.Diff())
+			return nil, nil
+		}
+	}
+
+	rows := make([]changeRow, len(cs.Changes))
+	for i, change := range cs.Changes {
+		rows[i] = changeRow{Action: change.Action, Item: *change.ListItem, Status: statusPlanned}
+	}
+	if dryRun || len(cs.Changes) == 0 {
+		return changeResult(rows), nil
+	}
+
+	applied, err := client.ChangeSetService().ApplyChangeSet(cs, models.BatchOptions{BatchSize: batchSize})
+	if applied == nil {
+		return nil, err
+	}
+
+	for i, change := range cs.Changes {
+		rows[i].Status = statusOK
+		if change.Action == models.ChangeActionCreate {
+			rows[i].Item.Id = applied.Ids[i]
+		}
+	}
+	failed := 0
+	for _, e := range applied.Errors {
+		if e.Index < 0 || e.Index >= len(rows) {
+			continue
+		}
+		if rows[e.Index].Status != statusFailed {
+			failed++
+		}
+		rows[e.Index].Status = statusFailed
+		rows[e.Index].ErrorCode = e.ErrorCode
+		rows[e.Index].Message = e.Message
+	}
+	for _, index := range applied.NotApplied {
+		rows[index].Status = statusNotApplied
+	}
+
+	if err == nil && failed > 0 {
+		err = fmt.Errorf("%d 项修改失败", failed)
+	}
+	return changeResult(rows), err
+}
+
+// changeResult 将修改的执行结果转换为输出
+func changeResult(rows []changeRow) *result {
+	columns := []string{"action"}
+	columns = append(columns, models.ListItemColumns...)
+	columns = append(columns, "status", "error_code", "message")
+	res := &result{columns: columns, value: rows}
+	for _, row := range rows {
+		values := append([]string{string(row.Action)}, row.Item.Values()...)
+		res.rows = append(res.rows, append(values, row.Status, row.ErrorCode, row.Message))
+	}
+	return res
+}
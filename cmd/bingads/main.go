@@ -0,0 +1,184 @@
+// bingads 是基于 SharedListService 的命令行工具，用于查看和修改共享列表
+//
+// 用法：
+//
+//	bingads lists ls [--type NegativeKeywordList] [--scope Customer]
+//	bingads lists items <id>
+//	bingads lists add-items <id> --file kw.csv [--dry-run]
+//	bingads lists delete-items <id> (--ids 1,2,3 | --file kw.csv) [--dry-run]
+//	bingads lists associations <id>... [--entity-type Campaign]
+//
+// 所有命令支持 --output table|json|csv、--type、--scope 和 --config。认证信息从 JSON 配置文件读取，
+// 字段为 developer_token、auth_token、customer_id、account_id、environment 和 campaign_endpoint；
+// 环境变量 BINGADS_DEVELOPER_TOKEN、BINGADS_AUTH_TOKEN、BINGADS_CUSTOMER_ID、BINGADS_ACCOUNT_ID、
+// BINGADS_ENVIRONMENT 和 BINGADS_CAMPAIGN_ENDPOINT 覆盖配置文件中的值。
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/campaignManagement/service"
+)
+
+const usage = `用法: bingads lists <命令> [参数]
+
+命令:
+  ls                         列出共享列表
+  items <id>                 列出共享列表中的项目
+  add-items <id> --file F    从 CSV 或 JSON 文件添加项目
+  delete-items <id>          按 --ids 或 --file 删除项目
+  associations <id>...       列出共享列表关联的活动或账户
+
+通用参数:
+  --output table|json|csv    输出格式（默认 table）
+  --type TYPE                共享列表类型（默认 NegativeKeywordList）
+  --scope Account|Customer   共享列表范围（默认 Account）
+  --config PATH              配置文件路径（默认 $BINGADS_CONFIG 或用户配置目录下的 bingads/config.json）
+
+运行 bingads lists <命令> -h 查看命令的参数。
+`
+
+// errUsage 表示命令行参数错误
+var errUsage = errors.New("参数错误")
+
+// options 为所有命令共用的参数
+type options struct {
+	configPath string
+	output     string
+	listType   string
+	scope      string
+}
+
+// runFunc 执行命令，返回需要输出的结果
+type runFunc func(client *service.Client, opts *options, args []string, stdout io.Writer) (*result, error)
+
+// command 为 lists 的子命令
+type command struct {
+	// 需要的位置参数个数，-1 表示至少一个
+	args int
+	// 添加命令特有的参数，返回执行命令的函数
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+var commands = map[string]command{
+	"ls":           {args: 0, setup: setupLs},
+	"items":        {args: 1, setup: setupItems},
+	"add-items":    {args: 1, setup: setupAddItems},
+	"delete-items": {args: 1, setup: setupDeleteItems},
+	"associations": {args: -1, setup: setupAssociations},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run 执行命令并返回退出码：0 成功，1 执行失败或部分项目失败，2 参数错误
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) < 2 || args[0] != "lists" {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	cmd, ok := commands[args[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "未知的命令 %q\n\n%s", args[1], usage)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("bingads lists "+args[1], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts := &options{}
+	fs.StringVar(&opts.configPath, "config", "", "配置文件路径")
+	fs.StringVar(&opts.output, "output", outputTable, "输出格式：table、json 或 csv")
+	fs.StringVar(&opts.output, "o", outputTable, "--output 的简写")
+	fs.StringVar(&opts.listType, "type", string(models.SharedEntityTypeNegativeKeywordList), "共享列表类型")
+	fs.StringVar(&opts.scope, "scope", "", "共享列表范围：Account 或 Customer")
+	exec := cmd.setup(fs)
+
+	positional, err := parseArgs(fs, args[2:])
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err == nil {
+		err = checkOutputFormat(opts.output)
+	}
+	if err == nil {
+		err = checkArgs(fs.Name(), cmd.args, positional)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	cfg, err := loadConfig(opts.configPath)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	res, err := exec(service.NewClient(cfg), opts, positional, stdout)
+	if res != nil {
+		if werr := res.write(stdout, opts.output); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// parseArgs 解析参数，允许参数和位置参数交替出现，返回位置参数
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// checkArgs 检查位置参数的个数
+func checkArgs(name string, want int, args []string) error {
+	switch {
+	case want < 0 && len(args) == 0:
+		return fmt.Errorf("%s 需要至少 1 个共享列表 ID", name)
+	case want >= 0 && len(args) != want:
+		return fmt.Errorf("%s 需要 %d 个参数，实际 %d 个", name, want, len(args))
+	}
+	return nil
+}
+
+// usageError 返回参数错误
+func usageError(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errUsage, fmt.Sprintf(format, args...))
+}
+
+// splitList 拆分逗号分隔的参数值
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
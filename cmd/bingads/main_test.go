@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+// setTestEnv 清除配置相关的环境变量，使测试不读取用户的配置文件，并设置 vars 中的值
+func setTestEnv(t *testing.T, vars map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	for _, env := range []string{envConfigFile, envDeveloperToken, envAuthToken, envCustomerID, envAccountID, envEnvironment, envCampaignEndpoint} {
+		t.Setenv(env, vars[env])
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	output := fs.String("o", outputTable, "")
+	file := fs.String("file", "", "")
+	dryRun := fs.Bool("dry-run", false, "")
+
+	positional, err := parseArgs(fs, []string{"123", "--file", "kw.csv", "456", "-o", "json", "--dry-run", "--", "-7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(positional, " ") != "123 456 -7" {
+		t.Errorf("位置参数不正确: %q", positional)
+	}
+	if *output != outputJSON || *file != "kw.csv" || !*dryRun {
+		t.Errorf("参数解析不正确: output=%q file=%q dry-run=%v", *output, *file, *dryRun)
+	}
+
+	fs.SetOutput(&bytes.Buffer{})
+	if _, err := parseArgs(fs, []string{"123", "--unknown"}); err == nil {
+		t.Error("未知的参数应返回错误")
+	}
+}
+
+func TestRunUsageErrors(t *testing.T) {
+	setTestEnv(t, nil)
+
+	for _, args := range [][]string{
+		{},
+		{"lists"},
+		{"lists", "unknown"},
+		{"lists", "items"},
+		{"lists", "items", "1", "2"},
+		{"lists", "associations"},
+		{"lists", "ls", "-o", "xml"},
+		{"lists", "ls", "--unknown"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run(args, &stdout, &stderr); code != 2 {
+			t.Errorf("%q 的退出码应为 2，实际 %d: %s", args, code, stderr.String())
+		}
+	}
+
+	if err := checkArgs("items", 1, []string{"1"}); err != nil {
+		t.Errorf("参数个数正确时不应返回错误: %v", err)
+	}
+	if err := checkArgs("associations", -1, []string{"1", "2"}); err != nil {
+		t.Errorf("至少一个参数时不应返回错误: %v", err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{"developer_token":"file-dev","auth_token":"file-auth","customer_id":"1","account_id":"2","environment":"sandbox"}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	setTestEnv(t, map[string]string{envAuthToken: "env-auth", envCampaignEndpoint: "http://localhost/campaign"})
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Auth.DeveloperToken != "file-dev" || cfg.Auth.AuthenticationToken != "env-auth" || cfg.Auth.CustomerAccountID != "2" {
+		t.Errorf("环境变量应覆盖配置文件中的值: %+v", cfg.Auth)
+	}
+	if cfg.API.CampaignEndpoint != "http://localhost/campaign" {
+		t.Errorf("CampaignEndpoint 不正确: %q", cfg.API.CampaignEndpoint)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := loadConfig(missing); err == nil {
+		t.Error("--config 指定的文件不存在时应返回错误")
+	}
+	setTestEnv(t, map[string]string{envConfigFile: missing, envDeveloperToken: "dev", envAuthToken: "auth", envCustomerID: "1"})
+	if _, err := loadConfig(""); err == nil {
+		t.Errorf("%s 指定的文件不存在时应返回错误", envConfigFile)
+	}
+
+	// 默认路径的文件不存在时只使用环境变量
+	setTestEnv(t, map[string]string{envDeveloperToken: "dev", envAuthToken: "auth", envCustomerID: "1"})
+	if _, err := loadConfig(""); err != nil {
+		t.Errorf("默认配置文件不存在时应当忽略: %v", err)
+	}
+	setTestEnv(t, map[string]string{envDeveloperToken: "dev", envAuthToken: "auth", envCustomerID: "1", envEnvironment: "staging"})
+	if _, err := loadConfig(""); err == nil {
+		t.Error("未知的环境应返回错误")
+	}
+}
+
+func TestMatchListItems(t *testing.T) {
+	current := []models.SharedListItem{
+		{Type: models.SharedListItemTypeNegativeKeyword, ID: base.Int64(1), Text: "free", MatchType: "Exact"},
+		{Type: models.SharedListItemTypeNegativeKeyword, ID: base.Int64(2), Text: "cheap", MatchType: "Phrase"},
+	}
+
+	items, err := matchListItems(current, []models.SharedListItem{
+		{ID: base.Int64(2)},
+		{Type: models.SharedListItemTypeNegativeKeyword, Text: "free", MatchType: "Exact"},
+		{Type: models.SharedListItemTypeNegativeKeyword, ID: base.Int64(1)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].ID.Value() != 2 || items[1].ID.Value() != 1 || items[1].Text != "free" {
+		t.Errorf("应按 ID 和内容匹配并去重: %+v", items)
+	}
+
+	_, err = matchListItems(current, []models.SharedListItem{
+		{ID: base.Int64(3)},
+		{Type: models.SharedListItemTypeNegativeKeyword, Text: "free", MatchType: "Phrase"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "Id 3") || !strings.Contains(err.Error(), `"free"`) {
+		t.Errorf("应列出所有不存在的项目，实际 %v", err)
+	}
+
+	sites := []models.SharedListItem{{Type: models.SharedListItemTypeNegativeSite, ID: base.Int64(4), Url: "www.example.com"}}
+	if _, err := matchListItems(sites, []models.SharedListItem{{Type: models.SharedListItemTypeNegativeSite, Url: "https://example.com/"}}); err == nil {
+		t.Error("example.com 不应匹配 www.example.com")
+	}
+	if items, err := matchListItems(sites, []models.SharedListItem{{Type: models.SharedListItemTypeNegativeSite, Url: "https://WWW.Example.com/"}}); err != nil || len(items) != 1 {
+		t.Errorf("应忽略协议、大小写和末尾斜杠: %+v %v", items, err)
+	}
+}
+
+func TestAddItemsOutput(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+			<AddListItemsToSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+				<ListItemIds><a:long>101</a:long><a:long>0</a:long></ListItemIds>
+				<PartialErrors><BatchError><Code>1</Code><ErrorCode>DuplicateNegativeKeyword</ErrorCode><Index>1</Index><Message>dup</Message></BatchError></PartialErrors>
+			</AddListItemsToSharedListResponse></s:Body></s:Envelope>`))
+	}))
+	defer server.Close()
+
+	setTestEnv(t, map[string]string{envDeveloperToken: "dev", envAuthToken: "auth", envCustomerID: "1", envAccountID: "2", envCampaignEndpoint: server.URL})
+	file := filepath.Join(t.TempDir(), "kw.csv")
+	if err := os.WriteFile(file, []byte("type,text,match_type\nNegativeKeyword,free,Exact\nNegativeKeyword,cheap,phrase\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	addItems := func(extra ...string) (string, int) {
+		t.Helper()
+		var stdout, stderr bytes.Buffer
+		code := run(append([]string{"lists", "add-items", "123", "--file", file}, extra...), &stdout, &stderr)
+		return stdout.String(), code
+	}
+
+	out, code := addItems()
+	if code != 1 {
+		t.Errorf("部分项目失败时退出码应为 1，实际 %d", code)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "ACTION") || !strings.Contains(lines[1], "101") || !strings.Contains(lines[2], "DuplicateNegativeKeyword") {
+		t.Errorf("表格输出不正确:\n%s", out)
+	}
+
+	out, _ = addItems("-o", "json")
+	var rows []changeRow
+	if err := json.Unmarshal([]byte(out), &rows); err != nil {
+		t.Fatalf("JSON 输出无法解析: %v\n%s", err, out)
+	}
+	if len(rows) != 2 || rows[0].Item.Id != 101 || rows[0].Status != statusOK || rows[1].Status != statusFailed || rows[1].ErrorCode != "DuplicateNegativeKeyword" {
+		t.Errorf("JSON 输出不正确: %+v", rows)
+	}
+
+	out, _ = addItems("--output", "csv")
+	want := "action,type,id,text,match_type,url,brand_id,status,error_code,message\n" +
+		"create,NegativeKeyword,101,free,Exact,,,ok,,\n" +
+		"create,NegativeKeyword,,cheap,Phrase,,,failed,DuplicateNegativeKeyword,dup\n"
+	if out != want {
+		t.Errorf("CSV 输出不正确:\n%s", out)
+	}
+
+	// dry-run 不发送请求，JSON 和 CSV 输出计划中的修改
+	before := atomic.LoadInt32(&calls)
+	out, code = addItems("--dry-run", "-o", "json")
+	rows = nil
+	if err := json.Unmarshal([]byte(out), &rows); err != nil || code != 0 {
+		t.Fatalf("dry-run 的 JSON 输出无法解析: %v (退出码 %d)\n%s", err, code, out)
+	}
+	if len(rows) != 2 || rows[0].Status != statusPlanned || rows[1].Item.Text != "cheap" {
+		t.Errorf("dry-run 的 JSON 输出不正确: %+v", rows)
+	}
+	if out, _ = addItems("--dry-run", "-o", "csv"); !strings.Contains(out, "create,NegativeKeyword,,free,Exact,,,planned,,\n") {
+		t.Errorf("dry-run 的 CSV 输出不正确:\n%s", out)
+	}
+	if out, _ = addItems("--dry-run"); !strings.Contains(out, "+ NegativeKeyword [Exact] free") {
+		t.Errorf("dry-run 的表格输出应为文本计划:\n%s", out)
+	}
+	if atomic.LoadInt32(&calls) != before {
+		t.Error("dry-run 不应发送请求")
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// 输出格式
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+// result 为命令的输出，表格和 CSV 使用 columns 和 rows，JSON 使用 value
+type result struct {
+	columns []string
+	rows    [][]string
+	value   any
+}
+
+// checkOutputFormat 检查输出格式是否有效
+func checkOutputFormat(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputCSV:
+		return nil
+	default:
+		return fmt.Errorf("未知的输出格式 %q，只支持 %s、%s 和 %s", format, outputTable, outputJSON, outputCSV)
+	}
+}
+
+// write 按 format 输出结果
+func (r *result) write(w io.Writer, format string) error {
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r.value)
+	case outputCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(r.columns); err != nil {
+			return err
+		}
+		if err := writer.WriteAll(r.rows); err != nil {
+			return err
+		}
+		return writer.Error()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		header := make([]string, len(r.columns))
+		for i, column := range r.columns {
+			header[i] = strings.ToUpper(column)
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, row := range r.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+}
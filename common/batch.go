@@ -0,0 +1,116 @@
+package common
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ChunkRange 表示切片中一个批次的范围 [Start, End)
+type ChunkRange struct {
+	Start int
+	End   int
+}
+
+// Len 返回批次中的元素个数
+func (r ChunkRange) Len() int {
+	return r.End - r.Start
+}
+
+// ChunkRanges 将长度为 total 的切片按 size 划分为多个批次
+func ChunkRanges(total, size int) []ChunkRange {
+	if total <= 0 {
+		return nil
+	}
+	if size <= 0 {
+		size = total
+	}
+
+	ranges := make([]ChunkRange, 0, (total+size-1)/size)
+	for start := 0; start < total; start += size {
+		end := start + size
+		if end > total {
+			end = total
+		}
+		ranges = append(ranges, ChunkRange{Start: start, End: end})
+	}
+	return ranges
+}
+
+// Chunk 将切片按 size 划分为多个子切片，子切片与原切片共享底层数组
+func Chunk[T any](items []T, size int) [][]T {
+	ranges := ChunkRanges(len(items), size)
+	chunks := make([][]T, 0, len(ranges))
+	for _, r := range ranges {
+		chunks = append(chunks, items[r.Start:r.End:r.End])
+	}
+	return chunks
+}
+
+// ChunkError 表示某个批次执行失败
+type ChunkError struct {
+	Range ChunkRange
+	Err   error
+}
+
+// Error 实现 error 接口
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("批次 [%d, %d) 执行失败: %v", e.Range.Start, e.Range.End, e.Err)
+}
+
+// Unwrap 返回原始错误
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// RunChunks 将长度为 total 的任务按 size 分批执行，最多同时执行 concurrency 个批次
+//
+// 某个批次失败后不再启动新的批次，已经开始的批次会执行完毕。
+// 返回按批次顺序最靠前的失败批次对应的 ChunkError。
+func RunChunks(total, size, concurrency int, fn func(r ChunkRange) error) error {
+	ranges := ChunkRanges(total, size)
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(ranges))
+	sem := make(chan struct{}, concurrency)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed bool
+	)
+
+	for i, r := range ranges {
+		sem <- struct{}{}
+
+		mu.Lock()
+		stop := failed
+		mu.Unlock()
+		if stop {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, r ChunkRange) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(r); err != nil {
+				errs[i] = &ChunkError{Range: r, Err: err}
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}(i, r)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
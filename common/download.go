@@ -0,0 +1,101 @@
+package common
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// DownloadZip 下载 zip 压缩包并返回其中第一个文件的内容，调用方负责关闭
+//
+// 报告和批量文件可能有数 GB，压缩包先写入临时文件再解压，不会整体读入内存，关闭时删除临时文件。
+func DownloadZip(ctx context.Context, client *http.Client, downloadURL string) (io.ReadCloser, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, base.NewError(base.ErrInvalidInput, "创建下载请求失败", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, base.NewError(base.ErrNetworkFail, "下载文件失败", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewError(base.ErrHTTPRequestFail, fmt.Sprintf("下载文件返回非 200 状态码: %d", resp.StatusCode), nil)
+	}
+
+	// 写入临时文件，zip 需要随机读取
+	file, err := os.CreateTemp("", "bingads-*.zip")
+	if err != nil {
+		return nil, base.NewError(base.ErrFileIOFail, "创建临时文件失败", err)
+	}
+	entry := &zipEntry{file: file}
+
+	writer := &fileWriter{file: file}
+	size, err := io.Copy(writer, resp.Body)
+	if err != nil {
+		entry.Close()
+		if writer.err != nil {
+			return nil, base.NewError(base.ErrFileIOFail, "写入临时文件失败", err)
+		}
+		return nil, base.NewError(base.ErrNetworkFail, "下载文件失败", err)
+	}
+
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		entry.Close()
+		return nil, base.NewError(base.ErrInvalidResponse, "下载的文件不是有效的 zip 文件", err)
+	}
+	if len(archive.File) == 0 {
+		entry.Close()
+		return nil, base.NewError(base.ErrInvalidResponse, "压缩包为空", nil)
+	}
+
+	entry.ReadCloser, err = archive.File[0].Open()
+	if err != nil {
+		entry.Close()
+		return nil, base.NewError(base.ErrInvalidResponse, "解压文件失败", err)
+	}
+	return entry, nil
+}
+
+// fileWriter 记录写入临时文件时的错误，用于区分下载失败和写入失败
+type fileWriter struct {
+	file *os.File
+	err  error
+}
+
+// Write 实现 io.Writer 接口
+func (w *fileWriter) Write(p []byte) (int, error) {
+	n, err := w.file.Write(p)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// zipEntry 是解压后的文件内容，关闭时删除临时文件
+type zipEntry struct {
+	io.ReadCloser
+	file *os.File
+}
+
+// Close 关闭文件并删除临时文件
+func (z *zipEntry) Close() error {
+	var err error
+	if z.ReadCloser != nil {
+		err = z.ReadCloser.Close()
+	}
+	z.file.Close()
+	os.Remove(z.file.Name())
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -27,6 +28,9 @@ import (
 type HTTPClient struct {
 	Client *resty.Client
 	Config *config.Config
+
+	// 限流器，默认按开发者令牌和 CustomerId 在进程内共享，为 nil 时不限流
+	Limiter *RateLimiter
 }
 
 // NewHTTPClient 创建一个新的 HTTP 客户端
@@ -35,21 +39,63 @@ func NewHTTPClient(cfg *config.Config) *HTTPClient {
 		Timeout: time.Duration(cfg.API.Timeout) * time.Second,
 	})
 	return &HTTPClient{
-		Client: client,
-		Config: cfg,
+		Client:  client,
+		Config:  cfg,
+		Limiter: RateLimiterFor(cfg.Auth, cfg.API),
 	}
 }
 
-// Post 发送 POST 请求
+// Post 发送 POST 请求，等待限流器的时间不计入请求超时
+//
+// 等待限流器的时间最长为 API.RateLimitWait，未设置时为 API.Timeout，超时后返回 RATE_LIMIT_ERROR。
 func (c *HTTPClient) Post(url string, action string, body []byte) ([]byte, error) {
+	wait := c.Config.API.RateLimitWait
+	if wait <= 0 {
+		wait = c.Config.API.Timeout
+	}
+	acquireCtx, cancelAcquire := context.Background(), func() {}
+	if wait > 0 {
+		acquireCtx, cancelAcquire = context.WithTimeout(acquireCtx, time.Duration(wait)*time.Second)
+	}
+	release, err := c.acquire(acquireCtx)
+	cancelAcquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(c.Config.API.Timeout)*time.Second)
 	defer cancel()
 
-	return c.PostWithContext(ctx, url, action, body)
+	return c.post(ctx, url, action, body)
 }
 
-// PostWithContext 使用指定的上下文发送 POST 请求
+// PostWithContext 使用指定的上下文发送 POST 请求，ctx 同时限制等待限流器的时间
 func (c *HTTPClient) PostWithContext(ctx context.Context, url string, action string, body []byte) ([]byte, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return c.post(ctx, url, action, body)
+}
+
+// acquire 等待限流器放行
+func (c *HTTPClient) acquire(ctx context.Context) (func(), error) {
+	if c.Limiter == nil {
+		return func() {}, nil
+	}
+
+	release, err := c.Limiter.Acquire(ctx)
+	if err != nil {
+		return nil, base.NewError(base.ErrRateLimitError, "等待限流器超时", err)
+	}
+	return release, nil
+}
+
+// post 发送请求并检查响应状态
+func (c *HTTPClient) post(ctx context.Context, url string, action string, body []byte) ([]byte, error) {
 	resp, err := c.Client.
 		R().
 		SetContext(ctx).
@@ -63,9 +109,29 @@ func (c *HTTPClient) PostWithContext(ctx context.Context, url string, action str
 		return nil, base.NewError(base.ErrNetworkFail, "发送 HTTP 请求失败", err)
 	}
 
+	// 检查是否触发服务端限流，触发时所有共享该限流器的请求一起退避
+	if resp.StatusCode() == http.StatusTooManyRequests || (resp.StatusCode() != http.StatusOK && IsThrottlingFault(resp.Body())) {
+		if c.Limiter != nil {
+			c.Limiter.Throttled(retryAfter(resp.Header().Get("Retry-After")))
+		}
+		return resp.Body(), base.NewError(base.ErrRateLimitError, fmt.Sprintf("触发 API 限流: %d", resp.StatusCode()), nil)
+	}
+
 	// 检查状态码
 	if resp.StatusCode() != http.StatusOK {
 		return resp.Body(), base.NewError(base.ErrAPIError, fmt.Sprintf("API 返回非 200 状态码: %d", resp.StatusCode()), nil)
 	}
+	if c.Limiter != nil {
+		c.Limiter.Succeeded()
+	}
 	return resp.Body(), nil
 }
+
+// retryAfter 解析 Retry-After 响应头中的秒数
+func retryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// PageFunc 获取第 index 页（从 0 开始）的结果，每页最多 size 个
+type PageFunc[T any] func(index, size int) ([]T, error)
+
+// Pager 按需逐页获取分页查询的结果
+//
+// 只有当前页遍历完后才会请求下一页，返回的结果数小于 size 时视为最后一页。
+// 每次请求前检查 ctx，ctx 结束后 Next 返回 false，Err 返回 ctx.Err()。
+// 用法：
+//
+//	for pager.Next() {
+//		item := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	ctx   context.Context
+	size  int
+	fetch PageFunc[T]
+
+	index int
+	page  []T
+	pos   int
+	last  bool
+	err   error
+}
+
+// PageSizeOrMax 返回分页迭代器使用的每页大小，size 为 0 时使用 maxSize，负数原样返回由 NewPager 报错
+func PageSizeOrMax(size, maxSize int) int {
+	if size == 0 {
+		return maxSize
+	}
+	return size
+}
+
+// NewPager 创建一个每页 size 个结果的分页迭代器
+//
+// size 必须为正数，否则返回的迭代器不会发送请求，Next 返回 false，Err 返回 INVALID_INPUT 错误。
+func NewPager[T any](ctx context.Context, size int, fetch PageFunc[T]) *Pager[T] {
+	p := &Pager[T]{ctx: ctx, size: size, fetch: fetch, pos: -1}
+	if size <= 0 {
+		p.err = base.NewError(base.ErrInvalidInput, fmt.Sprintf("每页结果数必须为正数，实际为 %d", size), nil)
+	}
+	return p
+}
+
+// Next 前进到下一个结果，没有更多结果或出错时返回 false
+func (p *Pager[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.pos++
+	for p.pos >= len(p.page) {
+		if p.last {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		page, err := p.fetch(p.index, p.size)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.index++
+		p.page = page
+		p.pos = 0
+		p.last = len(page) == 0 || len(page) < p.size
+	}
+	return true
+}
+
+// Item 返回当前结果，只能在 Next 返回 true 之后调用
+func (p *Pager[T]) Item() T {
+	return p.page[p.pos]
+}
+
+// PageIndex 返回最近一次请求的页码，尚未请求时返回 -1
+func (p *Pager[T]) PageIndex() int {
+	return p.index - 1
+}
+
+// Err 返回遍历过程中遇到的错误
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All 遍历剩余的所有结果并返回，出错时同时返回已获取的结果
+func (p *Pager[T]) All() ([]T, error) {
+	var items []T
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
@@ -0,0 +1,305 @@
+package common
+
+import (
+	"context"
+	"encoding/xml"
+	"sync"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// 限流相关常量
+const (
+	// 默认的限流退避时间，Bing Ads 要求触发 CallRateExceeded 后等待 60 秒
+	DefaultThrottleBackoff = 60 * time.Second
+
+	// 触发限流后速率最多降低到配置值的比例
+	minRateFactor = 0.1
+
+	// 每次成功请求后速率恢复的比例
+	rateRecoveryFactor = 0.05
+
+	// Bing Ads 限流错误码
+	throttleErrorCode    = 117
+	throttleErrorCodeStr = "CallRateExceeded"
+)
+
+// RateLimiter 令牌桶限流器，同时限制最大并发请求数
+//
+// 触发服务端限流时，所有使用该限流器的请求都会暂停一段时间，
+// 并且速率减半，之后随着请求成功逐步恢复到配置值。
+type RateLimiter struct {
+	mu          sync.Mutex
+	rate        float64 // 当前速率（每秒请求数），0 表示不限速
+	maxRate     float64 // 配置的速率
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	backoff     time.Duration
+	sem         chan struct{} // 为 nil 时不限制并发
+	settings    limiterSettings
+}
+
+// limiterSettings 为创建或更新限流器时的参数
+type limiterSettings struct {
+	rps         float64
+	burst       int
+	maxInFlight int
+	backoff     time.Duration
+}
+
+// NewRateLimiter 创建一个新的限流器
+//
+// rps 为每秒请求数，0 表示不限速；burst 为令牌桶容量，<=0 时为 1；
+// maxInFlight 为最大并发请求数，0 表示不限制；backoff 为触发限流后的暂停时间。
+func NewRateLimiter(rps float64, burst, maxInFlight int, backoff time.Duration) *RateLimiter {
+	l := &RateLimiter{rate: rps, last: time.Now()}
+	l.apply(newLimiterSettings(rps, burst, maxInFlight, backoff))
+	l.tokens = l.burst
+	return l
+}
+
+// newLimiterSettings 返回规范化后的限流器参数
+func newLimiterSettings(rps float64, burst, maxInFlight int, backoff time.Duration) limiterSettings {
+	if burst <= 0 {
+		burst = 1
+	}
+	if maxInFlight < 0 {
+		maxInFlight = 0
+	}
+	if backoff <= 0 {
+		backoff = DefaultThrottleBackoff
+	}
+	return limiterSettings{rps: rps, burst: burst, maxInFlight: maxInFlight, backoff: backoff}
+}
+
+// apply 使用新的参数，调用方需要持有 l.mu 或独占 l
+func (l *RateLimiter) apply(settings limiterSettings) {
+	// 触发限流后降低的速率按相同比例换算为新的速率
+	switch {
+	case settings.rps <= 0 || l.maxRate <= 0:
+		l.rate = settings.rps
+	case l.rate < l.maxRate:
+		l.rate = settings.rps * l.rate / l.maxRate
+	default:
+		l.rate = settings.rps
+	}
+	l.maxRate = settings.rps
+	l.burst = float64(settings.burst)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.backoff = settings.backoff
+
+	if settings.maxInFlight != l.settings.maxInFlight {
+		l.sem = nil
+		if settings.maxInFlight > 0 {
+			l.sem = make(chan struct{}, settings.maxInFlight)
+		}
+	}
+	l.settings = settings
+}
+
+// Reconfigure 更新限流器的速率、令牌桶容量、最大并发数和退避时间
+//
+// 已经获得并发名额的请求仍按原来的并发限制释放，当前的限流暂停不受影响。
+func (l *RateLimiter) Reconfigure(rps float64, burst, maxInFlight int, backoff time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.apply(newLimiterSettings(rps, burst, maxInFlight, backoff))
+}
+
+// Acquire 等待并发名额和令牌，返回的 release 函数必须在请求结束后调用
+func (l *RateLimiter) Acquire(ctx context.Context) (release func(), err error) {
+	l.mu.Lock()
+	sem := l.sem
+	l.mu.Unlock()
+
+	if sem != nil {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release = func() {
+		if sem != nil {
+			<-sem
+		}
+	}
+
+	if err := l.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// Wait 等待一个令牌，限流暂停期间会一直等待
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve 尝试取出一个令牌，失败时返回需要等待的时间
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	// 补充令牌
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// Throttled 记录一次服务端限流，暂停所有请求并降低速率
+//
+// retryAfter 大于 0 时使用服务端给出的等待时间，否则使用配置的退避时间。并发请求通常会
+// 同时收到限流响应，因此速率在每个暂停期间最多减半一次，暂停期间的限流只延长暂停时间。
+func (l *RateLimiter) Throttled(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if retryAfter <= 0 {
+		retryAfter = l.backoff
+	}
+	now := time.Now()
+	paused := now.Before(l.pausedUntil)
+	if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+
+	if l.rate > 0 && !paused {
+		l.rate /= 2
+		if floor := l.maxRate * minRateFactor; l.rate < floor {
+			l.rate = floor
+		}
+		l.tokens = 0
+	}
+}
+
+// Succeeded 记录一次成功请求，逐步恢复速率
+func (l *RateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 && l.rate < l.maxRate {
+		l.rate += l.maxRate * rateRecoveryFactor
+		if l.rate > l.maxRate {
+			l.rate = l.maxRate
+		}
+	}
+}
+
+// Rate 返回当前速率（每秒请求数）
+func (l *RateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+// 按开发者令牌和 CustomerId 共享的限流器
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = map[string]*RateLimiter{}
+)
+
+// RateLimiterFor 返回指定开发者令牌和 CustomerId 共享的限流器，不存在时按 api 配置创建
+//
+// 同一进程内使用相同开发者令牌和 CustomerId 的所有客户端共享同一个限流器，限流器在进程
+// 结束前一直保留。api 中的限流参数与限流器当前的参数不同时，限流器按 api 重新配置，
+// 即以最后创建的客户端的配置为准。
+func RateLimiterFor(auth *config.AuthConfig, api *config.APIConfig) *RateLimiter {
+	var key string
+	if auth != nil {
+		key = auth.DeveloperToken + "/" + auth.CustomerID
+	}
+
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+
+	backoff := time.Duration(api.ThrottleBackoff) * time.Second
+	if l, ok := rateLimiters[key]; ok {
+		l.mu.Lock()
+		changed := l.settings != newLimiterSettings(api.RequestsPerSecond, api.Burst, api.MaxConcurrentRequests, backoff)
+		l.mu.Unlock()
+		if changed {
+			l.Reconfigure(api.RequestsPerSecond, api.Burst, api.MaxConcurrentRequests, backoff)
+		}
+		return l
+	}
+
+	l := NewRateLimiter(api.RequestsPerSecond, api.Burst, api.MaxConcurrentRequests, backoff)
+	rateLimiters[key] = l
+	return l
+}
+
+// throttleEnvelope 用于从响应中解析 SOAP 故障
+type throttleEnvelope struct {
+	Body struct {
+		Fault *base.Fault `xml:"Fault"`
+	} `xml:"Body"`
+}
+
+// IsThrottlingFault 检查响应体是否为 Bing Ads 的限流故障
+//
+// 限流错误可能出现在 AdApiFaultDetail 的 Errors 中，也可能出现在 ApiFaultDetail 或
+// ApiFault 的 OperationErrors 中，取决于服务和操作。
+func IsThrottlingFault(body []byte) bool {
+	var env throttleEnvelope
+	if err := xml.Unmarshal(body, &env); err != nil || env.Body.Fault == nil {
+		return false
+	}
+
+	detail := env.Body.Fault.Detail
+	for _, apiErr := range detail.AdApiFaultDetail.Errors.AdApiError {
+		if isThrottlingError(apiErr.Code, apiErr.ErrorCode) {
+			return true
+		}
+	}
+	for _, errs := range [][]base.OperationError{detail.ApiFaultDetail.OperationErrors, detail.ApiFault.OperationErrors} {
+		for _, opErr := range errs {
+			if isThrottlingError(opErr.Code, opErr.ErrorCode) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isThrottlingError 检查错误码是否表示限流
+func isThrottlingError(code int, errorCode string) bool {
+	return code == throttleErrorCode || errorCode == throttleErrorCodeStr
+}
@@ -0,0 +1,309 @@
+package common
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// 认证和限流相关的 Bing Ads 错误码
+var (
+	authErrorCodes      = map[int]bool{105: true, 106: true, 109: true}
+	rateLimitErrorCodes = map[int]bool{throttleErrorCode: true}
+)
+
+// NewRequestHeader 根据配置创建 SOAP 请求头，所有服务共用同一套认证信息
+func NewRequestHeader(cfg *config.Config, namespace string, action string, mustUnderstand string) base.RequestHeader {
+	return base.RequestHeader{
+		Namespace:           namespace,
+		Action:              action,
+		MustUnderstand:      mustUnderstand,
+		AuthenticationToken: cfg.Auth.AuthenticationToken,
+		CustomerAccountId:   cfg.Auth.CustomerAccountID,
+		CustomerId:          cfg.Auth.CustomerID,
+		DeveloperToken:      cfg.Auth.DeveloperToken,
+	}
+}
+
+// AuthHeaders 返回非 SOAP 请求（例如批量文件上传）使用的认证 HTTP 头，与 NewRequestHeader 使用相同的认证信息
+func AuthHeaders(cfg *config.Config) map[string]string {
+	headers := map[string]string{
+		"AuthenticationToken": cfg.Auth.AuthenticationToken,
+		"DeveloperToken":      cfg.Auth.DeveloperToken,
+		"CustomerId":          cfg.Auth.CustomerID,
+	}
+	if cfg.Auth.CustomerAccountID != "" {
+		headers["CustomerAccountId"] = cfg.Auth.CustomerAccountID
+	}
+	return headers
+}
+
+// NewEnvelope 创建 SOAP 信封
+func NewEnvelope(header base.RequestHeader) base.Envelope {
+	return base.Envelope{
+		XMLName: xml.Name{},
+		XmlnsI:  config.XSINamespace,
+		XmlnsS:  config.SOAPEnvelopeNamespace,
+		Header:  header,
+		Body:    nil,
+	}
+}
+
+// FaultError 将 SOAP 故障转换为 BingAdsError
+func FaultError(fault *base.Fault, trackingId string) error {
+	code := base.ErrAPIError
+	errorMsg := fmt.Sprintf("SOAP错误: %s - %s", fault.FaultCode, fault.FaultString)
+
+	// 检查是否有详细错误信息
+	detail := fault.Detail
+	var apiCode int
+	if len(detail.AdApiFaultDetail.Errors.AdApiError) > 0 {
+		apiError := detail.AdApiFaultDetail.Errors.AdApiError[0]
+		apiCode = apiError.Code
+		errorMsg = fmt.Sprintf("BingAds API错误 [%s]: %s", apiError.ErrorCode, apiError.Message)
+	} else if opError, ok := firstOperationError(fault); ok {
+		apiCode = opError.Code
+		errorMsg = fmt.Sprintf("BingAds API错误 [%s]: %s", opError.ErrorCode, opError.Message)
+	}
+
+	switch {
+	case authErrorCodes[apiCode]:
+		code = base.ErrAuthError
+	case rateLimitErrorCodes[apiCode]:
+		code = base.ErrRateLimitError
+	}
+
+	// 记录跟踪ID（如果有）
+	if trackingId != "" {
+		errorMsg += fmt.Sprintf(" (TrackingId: %s)", trackingId)
+	}
+
+	return base.NewError(code, errorMsg, nil)
+}
+
+// firstOperationError 返回 SOAP 故障中的第一个操作错误
+func firstOperationError(fault *base.Fault) (base.OperationError, bool) {
+	if errs := fault.Detail.ApiFaultDetail.OperationErrors; len(errs) > 0 {
+		return errs[0], true
+	}
+	if errs := fault.Detail.ApiFault.OperationErrors; len(errs) > 0 {
+		return errs[0], true
+	}
+	return base.OperationError{}, false
+}
+
+// EntityEncoder 将实体编码为子元素带命名空间前缀的 XML
+//
+// Bing Ads 的请求消息元素位于服务命名空间，而实体的字段位于单独的实体命名空间，
+// 例如 <Account i:type="e1:AdvertiserAccount" xmlns:e1="..."><e1:Id>1</e1:Id></Account>。
+type EntityEncoder struct {
+	// 实体命名空间
+	Namespace string
+
+	// 命名空间前缀
+	Prefix string
+}
+
+// Qualify 返回带前缀的限定名称
+func (enc EntityEncoder) Qualify(local string) xml.Name {
+	return xml.Name{Local: enc.Prefix + ":" + local}
+}
+
+// TypeAttr 返回指向实体命名空间中类型的 i:type 属性
+func (enc EntityEncoder) TypeAttr(typeName string) xml.Attr {
+	return xml.Attr{Name: xml.Name{Local: "i:type"}, Value: enc.Prefix + ":" + typeName}
+}
+
+// Encode 将 v 编码为 start 元素，start 元素本身保持原有名称，所有子元素加上命名空间前缀
+func (enc EntityEncoder) Encode(e *xml.Encoder, start xml.StartElement, v any) error {
+	// 先按普通结构体编码，再逐个标记加上前缀
+	var buf bytes.Buffer
+	inner := xml.NewEncoder(&buf)
+	if err := inner.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: "entity"}}); err != nil {
+		return err
+	}
+	if err := inner.Flush(); err != nil {
+		return err
+	}
+
+	d := xml.NewDecoder(&buf)
+	tok, err := d.RawToken()
+	if err != nil {
+		return err
+	}
+	root, ok := tok.(xml.StartElement)
+	if !ok {
+		return fmt.Errorf("实体编码结果不是元素: %T", tok)
+	}
+
+	// 保留实体自身输出的属性（例如 i:nil），并声明实体命名空间
+	for _, attr := range root.Attr {
+		start.Attr = append(start.Attr, xml.Attr{Name: PrefixedName(attr.Name), Value: attr.Value})
+	}
+	start.Attr = append(start.Attr, xml.Attr{
+		Name:  xml.Name{Local: "xmlns:" + enc.Prefix},
+		Value: enc.Namespace,
+	})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	depth := 0
+	err = CopyTokens(e, d, func(tok xml.Token) (xml.Token, bool) {
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			t.Name = enc.qualify(t.Name)
+			return t, true
+		case xml.EndElement:
+			// 跳过临时根元素的结束标签
+			if depth == 0 {
+				return nil, false
+			}
+			depth--
+			t.Name = enc.qualify(t.Name)
+			return t, true
+		}
+		return tok, true
+	})
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// qualify 为没有前缀的元素名称加上实体命名空间前缀
+func (enc EntityEncoder) qualify(name xml.Name) xml.Name {
+	if name.Space != "" || strings.Contains(name.Local, ":") {
+		return PrefixedName(name)
+	}
+	return enc.Qualify(name.Local)
+}
+
+// PrefixedName 将 RawToken 解析出的带前缀名称转换为限定名称，避免编码器生成新的命名空间声明
+func PrefixedName(name xml.Name) xml.Name {
+	if name.Space == "" {
+		return name
+	}
+	return xml.Name{Local: name.Space + ":" + name.Local}
+}
+
+// CopyTokens 将 d 中剩余的原始标记逐个编码到 e，保留命名空间前缀
+//
+// transform 可以修改标记，返回 false 时跳过该标记；为 nil 时原样复制。
+func CopyTokens(e *xml.Encoder, d *xml.Decoder, transform func(xml.Token) (xml.Token, bool)) error {
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		tok = xml.CopyToken(tok)
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			t.Name = PrefixedName(t.Name)
+			for i := range t.Attr {
+				t.Attr[i].Name = PrefixedName(t.Attr[i].Name)
+			}
+			tok = t
+		case xml.EndElement:
+			t.Name = PrefixedName(t.Name)
+			tok = t
+		case xml.ProcInst, xml.Directive:
+			continue
+		}
+
+		if transform != nil {
+			var keep bool
+			if tok, keep = transform(tok); !keep {
+				continue
+			}
+		}
+
+		if err := e.EncodeToken(tok); err != nil {
+			return err
+		}
+	}
+}
+
+// CopyRawXML 将一段原始 XML 片段逐个标记编码到 e
+func CopyRawXML(e *xml.Encoder, raw string) error {
+	return CopyTokens(e, xml.NewDecoder(strings.NewReader(raw)), nil)
+}
+
+// LongArray 表示 Arrays 命名空间中的 long 数组，例如 <AccountIds xmlns:a1="..."><a1:long>1</a1:long></AccountIds>
+//
+// 数组为空时不输出元素。
+type LongArray []int64
+
+// MarshalXML 自定义 LongArray 的 XML 序列化
+func (a LongArray) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(a) == 0 {
+		return nil
+	}
+
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:a1"}, Value: config.ArraysNamespace})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range a {
+		if err := e.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: "a1:long"}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML 自定义 LongArray 的 XML 反序列化，接受任意命名空间前缀的 long 元素
+func (a *LongArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var values struct {
+		Long []int64 `xml:"long"`
+	}
+	if err := d.DecodeElement(&values, &start); err != nil {
+		return err
+	}
+	*a = values.Long
+	return nil
+}
+
+// StringArray 表示 Arrays 命名空间中的 string 数组，数组为空时不输出元素
+type StringArray []string
+
+// MarshalXML 自定义 StringArray 的 XML 序列化
+func (a StringArray) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(a) == 0 {
+		return nil
+	}
+
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:a1"}, Value: config.ArraysNamespace})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range a {
+		if err := e.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: "a1:string"}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML 自定义 StringArray 的 XML 反序列化，接受任意命名空间前缀的 string 元素
+func (a *StringArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var values struct {
+		String []string `xml:"string"`
+	}
+	if err := d.DecodeElement(&values, &start); err != nil {
+		return err
+	}
+	*a = values.String
+	return nil
+}
@@ -18,8 +18,81 @@ const (
 
 	// 沙箱环境的 Campaign Management API 端点
 	SandboxCampaignEndpoint = "https://campaign.api.sandbox.bingads.microsoft.com/Api/Advertiser/CampaignManagement/v13/CampaignManagementService.svc"
+
+	// 生产环境的 Customer Management API 端点
+	ProductionCustomerManagementEndpoint = "https://clientcenter.api.bingads.microsoft.com/Api/CustomerManagement/v13/CustomerManagementService.svc"
+
+	// 沙箱环境的 Customer Management API 端点
+	SandboxCustomerManagementEndpoint = "https://clientcenter.api.sandbox.bingads.microsoft.com/Api/CustomerManagement/v13/CustomerManagementService.svc"
+
+	// 生产环境的 Reporting API 端点
+	ProductionReportingEndpoint = "https://reporting.api.bingads.microsoft.com/Api/Advertiser/Reporting/v13/ReportingService.svc"
+
+	// 沙箱环境的 Reporting API 端点
+	SandboxReportingEndpoint = "https://reporting.api.sandbox.bingads.microsoft.com/Api/Advertiser/Reporting/v13/ReportingService.svc"
+
+	// 生产环境的 Bulk API 端点
+	ProductionBulkEndpoint = "https://bulk.api.bingads.microsoft.com/Api/Advertiser/CampaignManagement/v13/BulkService.svc"
+
+	// 沙箱环境的 Bulk API 端点
+	SandboxBulkEndpoint = "https://bulk.api.sandbox.bingads.microsoft.com/Api/Advertiser/CampaignManagement/v13/BulkService.svc"
+
+	// 生产环境的 Ad Insight API 端点
+	ProductionAdInsightEndpoint = "https://adinsight.api.bingads.microsoft.com/Api/Advertiser/AdInsight/v13/AdInsightService.svc"
+
+	// 沙箱环境的 Ad Insight API 端点
+	SandboxAdInsightEndpoint = "https://adinsight.api.sandbox.bingads.microsoft.com/Api/Advertiser/AdInsight/v13/AdInsightService.svc"
+
+	// 生产环境的 Customer Billing API 端点
+	ProductionCustomerBillingEndpoint = "https://clientcenter.api.bingads.microsoft.com/Api/Billing/v13/CustomerBillingService.svc"
+
+	// 沙箱环境的 Customer Billing API 端点
+	SandboxCustomerBillingEndpoint = "https://clientcenter.api.sandbox.bingads.microsoft.com/Api/Billing/v13/CustomerBillingService.svc"
 )
 
+// Service 表示 Bing Ads API 服务
+type Service string
+
+const (
+	// Campaign Management 服务
+	ServiceCampaignManagement Service = "CampaignManagement"
+
+	// Customer Management 服务
+	ServiceCustomerManagement Service = "CustomerManagement"
+
+	// Reporting 服务
+	ServiceReporting Service = "Reporting"
+
+	// Bulk 服务，消息使用 Campaign Management 命名空间
+	ServiceBulk Service = "Bulk"
+
+	// Ad Insight 服务
+	ServiceAdInsight Service = "AdInsight"
+
+	// Customer Billing 服务
+	ServiceCustomerBilling Service = "CustomerBilling"
+)
+
+// 各环境下的服务端点
+var endpoints = map[Environment]map[Service]string{
+	Production: {
+		ServiceCampaignManagement: ProductionCampaignEndpoint,
+		ServiceCustomerManagement: ProductionCustomerManagementEndpoint,
+		ServiceReporting:          ProductionReportingEndpoint,
+		ServiceBulk:               ProductionBulkEndpoint,
+		ServiceAdInsight:          ProductionAdInsightEndpoint,
+		ServiceCustomerBilling:    ProductionCustomerBillingEndpoint,
+	},
+	Sandbox: {
+		ServiceCampaignManagement: SandboxCampaignEndpoint,
+		ServiceCustomerManagement: SandboxCustomerManagementEndpoint,
+		ServiceReporting:          SandboxReportingEndpoint,
+		ServiceBulk:               SandboxBulkEndpoint,
+		ServiceAdInsight:          SandboxAdInsightEndpoint,
+		ServiceCustomerBilling:    SandboxCustomerBillingEndpoint,
+	},
+}
+
 // 命名空间常量
 const (
 	// XML Schema Instance 命名空间
@@ -30,6 +103,24 @@ const (
 
 	// Campaign Management API 命名空间
 	CampaignManagementNamespace = "https://bingads.microsoft.com/CampaignManagement/v13"
+
+	// Customer Management API 命名空间
+	CustomerManagementNamespace = "https://bingads.microsoft.com/Customer/v13"
+
+	// Customer Management API 实体命名空间
+	CustomerManagementEntitiesNamespace = "https://bingads.microsoft.com/Customer/v13/Entities"
+
+	// Reporting API 命名空间
+	ReportingNamespace = "https://bingads.microsoft.com/Reporting/v13"
+
+	// Ad Insight API 命名空间
+	AdInsightNamespace = "https://bingads.microsoft.com/AdInsight/v13"
+
+	// Customer Billing API 命名空间，实体位于 Customer Management 实体命名空间
+	CustomerBillingNamespace = "https://bingads.microsoft.com/Billing/v13"
+
+	// 数组类型命名空间
+	ArraysNamespace = "http://schemas.microsoft.com/2003/10/Serialization/Arrays"
 )
 
 // APIConfig 包含 Bing Ads API 的环境配置
@@ -45,24 +136,62 @@ type APIConfig struct {
 
 	// 是否启用调试模式
 	Debug bool
+
+	// 自定义 Campaign Management API 端点，为空时根据环境选择
+	CampaignEndpoint string
+
+	// 自定义各服务的端点，未设置的服务根据环境选择
+	Endpoints map[Service]string
+
+	// 以下限流参数作用于进程内按开发者令牌和 CustomerId 共享的限流器，该限流器在进程结束前
+	// 一直保留；使用不同参数创建同一开发者令牌和 CustomerId 的客户端时，共享的限流器改用
+	// 最后创建的客户端的参数。
+
+	// 每个 CustomerId 每秒最多的请求数，0 表示不限速
+	RequestsPerSecond float64
+
+	// 令牌桶容量，即允许的突发请求数
+	Burst int
+
+	// 每个 CustomerId 最大并发请求数，0 表示不限制
+	MaxConcurrentRequests int
+
+	// 触发服务端限流后所有请求暂停的时间（秒），0 表示使用默认值 60 秒
+	ThrottleBackoff int
+
+	// 不带 context 的请求等待限流器的最长时间（秒），0 表示使用 Timeout
+	RateLimitWait int
 }
 
 // DefaultConfig 返回默认的 API 配置
 func DefaultConfig() *APIConfig {
 	return &APIConfig{
-		Env:        Production,
-		Timeout:    30,
-		MaxRetries: 3,
-		Debug:      false,
+		Env:             Production,
+		Timeout:         30,
+		MaxRetries:      3,
+		Debug:           false,
+		Burst:           1,
+		ThrottleBackoff: 60,
 	}
 }
 
 // GetCampaignEndpoint 根据环境获取 Campaign Management API 端点
 func (c *APIConfig) GetCampaignEndpoint() string {
+	if c.CampaignEndpoint != "" {
+		return c.CampaignEndpoint
+	}
+	return c.GetEndpoint(ServiceCampaignManagement)
+}
+
+// GetEndpoint 根据环境获取指定服务的 API 端点，优先使用 Endpoints 中的自定义端点
+func (c *APIConfig) GetEndpoint(service Service) string {
+	if endpoint := c.Endpoints[service]; endpoint != "" {
+		return endpoint
+	}
 	if c.Env == Sandbox {
-		return SandboxCampaignEndpoint
+		return endpoints[Sandbox][service]
 	}
-	return ProductionCampaignEndpoint
+	return endpoints[Production][service]
 }
 
 // Config 包含所有 Bing Ads API 配置
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// CustomerBillingBody 表示请求体
+type CustomerBillingBody struct {
+	XMLName                        xml.Name                        `xml:"s:Body"`
+	SearchInsertionOrdersRequest   *SearchInsertionOrdersRequest   `xml:"SearchInsertionOrdersRequest,omitempty"`
+	AddInsertionOrderRequest       *AddInsertionOrderRequest       `xml:"AddInsertionOrderRequest,omitempty"`
+	UpdateInsertionOrderRequest    *UpdateInsertionOrderRequest    `xml:"UpdateInsertionOrderRequest,omitempty"`
+	GetAccountMonthlySpendRequest  *GetAccountMonthlySpendRequest  `xml:"GetAccountMonthlySpendRequest,omitempty"`
+	GetBillingDocumentsInfoRequest *GetBillingDocumentsInfoRequest `xml:"GetBillingDocumentsInfoRequest,omitempty"`
+	GetBillingDocumentsRequest     *GetBillingDocumentsRequest     `xml:"GetBillingDocumentsRequest,omitempty"`
+}
+
+// CustomerBillingResponseBody 表示响应体
+type CustomerBillingResponseBody struct {
+	XMLName                         xml.Name                         `xml:"Body"`
+	Fault                           *base.Fault                      `xml:"Fault,omitempty"`
+	SearchInsertionOrdersResponse   *SearchInsertionOrdersResponse   `xml:"SearchInsertionOrdersResponse,omitempty"`
+	AddInsertionOrderResponse       *AddInsertionOrderResponse       `xml:"AddInsertionOrderResponse,omitempty"`
+	UpdateInsertionOrderResponse    *UpdateInsertionOrderResponse    `xml:"UpdateInsertionOrderResponse,omitempty"`
+	GetAccountMonthlySpendResponse  *GetAccountMonthlySpendResponse  `xml:"GetAccountMonthlySpendResponse,omitempty"`
+	GetBillingDocumentsInfoResponse *GetBillingDocumentsInfoResponse `xml:"GetBillingDocumentsInfoResponse,omitempty"`
+	GetBillingDocumentsResponse     *GetBillingDocumentsResponse     `xml:"GetBillingDocumentsResponse,omitempty"`
+}
+
+// CustomerBillingResponseEnvelope 表示完整的 SOAP 响应
+type CustomerBillingResponseEnvelope struct {
+	XMLName xml.Name                    `xml:"Envelope"`
+	XmlnsS  string                      `xml:"xmlns:s,attr,omitempty"`
+	Header  base.ResponseHeader         `xml:"Header"`
+	Body    CustomerBillingResponseBody `xml:"Body"`
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// BillingDocumentInfo 表示账单的摘要信息
+type BillingDocumentInfo struct {
+	AccountId      int64                 `xml:"AccountId"`
+	AccountName    string                `xml:"AccountName"`
+	AccountNumber  string                `xml:"AccountNumber"`
+	Amount         float64               `xml:"Amount"`
+	CurrencyCode   string                `xml:"CurrencyCode"`
+	DocumentDate   base.Nillable[string] `xml:"DocumentDate"`
+	DocumentId     base.Nillable[int64]  `xml:"DocumentId"`
+	CustomerId     int64                 `xml:"CustomerId"`
+	DocumentNumber string                `xml:"DocumentNumber"`
+}
+
+// Base64Data 表示以 base64 编码传输的二进制数据
+type Base64Data []byte
+
+// MarshalText 实现 encoding.TextMarshaler 接口
+func (d Base64Data) MarshalText() ([]byte, error) {
+	return []byte(base64.StdEncoding.EncodeToString(d)), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler 接口
+func (d *Base64Data) UnmarshalText(text []byte) error {
+	data, err := base64.StdEncoding.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = data
+	return nil
+}
+
+// BillingDocument 表示账单文件
+type BillingDocument struct {
+	// 账单文件内容，已从 base64 解码
+	Data Base64Data `xml:"Data"`
+
+	Id   int64    `xml:"Id"`
+	Type DataType `xml:"Type"`
+}
+
+// GetAccountMonthlySpendRequest 请求结构体
+type GetAccountMonthlySpendRequest struct {
+	XMLName   xml.Name  `xml:"GetAccountMonthlySpendRequest"`
+	Namespace string    `xml:"xmlns,attr"`
+	AccountId int64     `xml:"AccountId"`
+	MonthYear time.Time `xml:"MonthYear"`
+}
+
+// GetAccountMonthlySpendResponse 响应结构体
+type GetAccountMonthlySpendResponse struct {
+	XMLName   xml.Name `xml:"GetAccountMonthlySpendResponse"`
+	Namespace string   `xml:"xmlns,attr"`
+	Amount    float64  `xml:"Amount"`
+}
+
+// GetBillingDocumentsInfoRequest 请求结构体
+type GetBillingDocumentsInfoRequest struct {
+	XMLName             xml.Name         `xml:"GetBillingDocumentsInfoRequest"`
+	Namespace           string           `xml:"xmlns,attr"`
+	AccountIds          common.LongArray `xml:"AccountIds"`
+	StartDate           time.Time        `xml:"StartDate"`
+	EndDate             *time.Time       `xml:"EndDate,omitempty"`
+	ReturnInvoiceNumber bool             `xml:"ReturnInvoiceNumber"`
+}
+
+// GetBillingDocumentsInfoResponse 响应结构体
+type GetBillingDocumentsInfoResponse struct {
+	XMLName              xml.Name              `xml:"GetBillingDocumentsInfoResponse"`
+	Namespace            string                `xml:"xmlns,attr"`
+	BillingDocumentsInfo []BillingDocumentInfo `xml:"BillingDocumentsInfo>BillingDocumentInfo,omitempty"`
+}
+
+// GetBillingDocumentsRequest 请求结构体
+type GetBillingDocumentsRequest struct {
+	XMLName     xml.Name         `xml:"GetBillingDocumentsRequest"`
+	Namespace   string           `xml:"xmlns,attr"`
+	DocumentIds common.LongArray `xml:"DocumentIds"`
+	Type        DataType         `xml:"Type"`
+}
+
+// GetBillingDocumentsResponse 响应结构体
+type GetBillingDocumentsResponse struct {
+	XMLName          xml.Name          `xml:"GetBillingDocumentsResponse"`
+	Namespace        string            `xml:"xmlns,attr"`
+	BillingDocuments []BillingDocument `xml:"BillingDocuments>BillingDocument,omitempty"`
+}
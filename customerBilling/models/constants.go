@@ -0,0 +1,55 @@
+package models
+
+type SOAPAction string
+
+const (
+	SOAPActionSearchInsertionOrders   SOAPAction = "SearchInsertionOrders"
+	SOAPActionAddInsertionOrder       SOAPAction = "AddInsertionOrder"
+	SOAPActionUpdateInsertionOrder    SOAPAction = "UpdateInsertionOrder"
+	SOAPActionGetAccountMonthlySpend  SOAPAction = "GetAccountMonthlySpend"
+	SOAPActionGetBillingDocumentsInfo SOAPAction = "GetBillingDocumentsInfo"
+	SOAPActionGetBillingDocuments     SOAPAction = "GetBillingDocuments"
+)
+
+// InsertionOrderStatus 表示订单的状态
+type InsertionOrderStatus string
+
+const (
+	InsertionOrderStatusActive              InsertionOrderStatus = "Active"
+	InsertionOrderStatusCanceled            InsertionOrderStatus = "Canceled"
+	InsertionOrderStatusDeclined            InsertionOrderStatus = "Declined"
+	InsertionOrderStatusExhausted           InsertionOrderStatus = "Exhausted"
+	InsertionOrderStatusExpired             InsertionOrderStatus = "Expired"
+	InsertionOrderStatusNotStarted          InsertionOrderStatus = "NotStarted"
+	InsertionOrderStatusPendingSystemReview InsertionOrderStatus = "PendingSystemReview"
+)
+
+// InsertionOrderPendingChangesStatus 表示订单待审核修改的状态
+type InsertionOrderPendingChangesStatus string
+
+const (
+	InsertionOrderPendingChangesStatusPendingUserReview   InsertionOrderPendingChangesStatus = "PendingUserReview"
+	InsertionOrderPendingChangesStatusDeclined            InsertionOrderPendingChangesStatus = "Declined"
+	InsertionOrderPendingChangesStatusPendingSystemReview InsertionOrderPendingChangesStatus = "PendingSystemReview"
+)
+
+// SearchInsertionOrders 支持的搜索字段
+const (
+	InsertionOrderSearchFieldAccountId = "AccountId"
+	InsertionOrderSearchFieldId        = "Id"
+	InsertionOrderSearchFieldName      = "Name"
+	InsertionOrderSearchFieldStatus    = "Status"
+	InsertionOrderSearchFieldStartDate = "StartDate"
+	InsertionOrderSearchFieldEndDate   = "EndDate"
+)
+
+// DataType 表示账单文件的格式
+type DataType string
+
+const (
+	DataTypePdf DataType = "Pdf"
+	DataTypeXml DataType = "Xml"
+)
+
+// MaxPageSize 是 SearchInsertionOrders 每页最多的结果数
+const MaxPageSize = 1000
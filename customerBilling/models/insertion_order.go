@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+	cm "github.com/vancevox/bingads-go/customerManagement/models"
+)
+
+// entityEncoder 用于编码实体命名空间下的元素，Customer Billing 与 Customer Management 共用实体命名空间
+var entityEncoder = common.EntityEncoder{
+	Namespace: config.CustomerManagementEntitiesNamespace,
+	Prefix:    "e1",
+}
+
+// Predicate 表示搜索条件
+type Predicate = cm.Predicate
+
+// OrderBy 表示排序条件
+type OrderBy = cm.OrderBy
+
+// Paging 表示分页信息，Index 从 0 开始
+type Paging = cm.Paging
+
+// InsertionOrderPendingChanges 表示订单待审核的修改
+type InsertionOrderPendingChanges struct {
+	Comment               base.Nillable[string]                             `xml:"Comment"`
+	EndDate               base.Nillable[string]                             `xml:"EndDate"`
+	RequestedByUserId     base.Nillable[int64]                              `xml:"RequestedByUserId"`
+	ModifiedDateTime      base.Nillable[string]                             `xml:"ModifiedDateTime"`
+	NotificationThreshold base.Nillable[float64]                            `xml:"NotificationThreshold"`
+	ReferenceId           base.Nillable[int64]                              `xml:"ReferenceId"`
+	SpendCapAmount        base.Nillable[float64]                            `xml:"SpendCapAmount"`
+	StartDate             base.Nillable[string]                             `xml:"StartDate"`
+	Name                  base.Nillable[string]                             `xml:"Name"`
+	PurchaseOrder         base.Nillable[string]                             `xml:"PurchaseOrder"`
+	ChangeStatus          base.Nillable[InsertionOrderPendingChangesStatus] `xml:"ChangeStatus"`
+}
+
+// InsertionOrder 表示账户的订单（预算上限）
+//
+// 字段顺序与 WSDL 一致，日期使用 xsd:dateTime 格式，例如 2026-01-01T00:00:00。
+// 更新时必须设置 Id 和 LastModifiedTime，未设置的字段不会被发送。
+type InsertionOrder struct {
+	AccountId              base.Nillable[int64]                `xml:"AccountId"`
+	BookingCountryCode     base.Nillable[string]               `xml:"BookingCountryCode"`
+	Comment                base.Nillable[string]               `xml:"Comment"`
+	EndDate                base.Nillable[string]               `xml:"EndDate"`
+	Id                     base.Nillable[int64]                `xml:"Id"`
+	LastModifiedByUserId   base.Nillable[int64]                `xml:"LastModifiedByUserId"`
+	LastModifiedTime       base.Nillable[string]               `xml:"LastModifiedTime"`
+	NotificationThreshold  base.Nillable[float64]              `xml:"NotificationThreshold"`
+	ReferenceId            base.Nillable[int64]                `xml:"ReferenceId"`
+	SpendCapAmount         base.Nillable[float64]              `xml:"SpendCapAmount"`
+	StartDate              base.Nillable[string]               `xml:"StartDate"`
+	Name                   base.Nillable[string]               `xml:"Name"`
+	Status                 base.Nillable[InsertionOrderStatus] `xml:"Status"`
+	PurchaseOrder          base.Nillable[string]               `xml:"PurchaseOrder"`
+	PendingChanges         *InsertionOrderPendingChanges       `xml:"PendingChanges,omitempty"`
+	AccountNumber          base.Nillable[string]               `xml:"AccountNumber"`
+	BudgetRemaining        base.Nillable[float64]              `xml:"BudgetRemaining"`
+	BudgetSpent            base.Nillable[float64]              `xml:"BudgetSpent"`
+	BudgetRemainingPercent base.Nillable[float64]              `xml:"BudgetRemainingPercent"`
+	BudgetSpentPercent     base.Nillable[float64]              `xml:"BudgetSpentPercent"`
+	SeriesName             base.Nillable[string]               `xml:"SeriesName"`
+	IsInSeries             base.Nillable[bool]                 `xml:"IsInSeries"`
+	SeriesFrequencyType    base.Nillable[string]               `xml:"SeriesFrequencyType"`
+	IsUnlimited            base.Nillable[bool]                 `xml:"IsUnlimited"`
+	IsEndless              base.Nillable[bool]                 `xml:"IsEndless"`
+}
+
+// MarshalXML 自定义 InsertionOrder 的 XML 序列化，字段位于实体命名空间
+func (o InsertionOrder) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type insertionOrder InsertionOrder
+	return entityEncoder.Encode(e, start, insertionOrder(o))
+}
+
+// SearchInsertionOrdersRequest 请求结构体
+type SearchInsertionOrdersRequest struct {
+	XMLName    xml.Name    `xml:"SearchInsertionOrdersRequest"`
+	Namespace  string      `xml:"xmlns,attr"`
+	Predicates []Predicate `xml:"Predicates>Predicate"`
+	Ordering   []OrderBy   `xml:"Ordering>OrderBy,omitempty"`
+	PageInfo   Paging      `xml:"PageInfo"`
+}
+
+// SearchInsertionOrdersResponse 响应结构体
+type SearchInsertionOrdersResponse struct {
+	XMLName         xml.Name         `xml:"SearchInsertionOrdersResponse"`
+	Namespace       string           `xml:"xmlns,attr"`
+	InsertionOrders []InsertionOrder `xml:"InsertionOrders>InsertionOrder,omitempty"`
+}
+
+// AddInsertionOrderRequest 请求结构体
+type AddInsertionOrderRequest struct {
+	XMLName        xml.Name       `xml:"AddInsertionOrderRequest"`
+	Namespace      string         `xml:"xmlns,attr"`
+	InsertionOrder InsertionOrder `xml:"InsertionOrder"`
+}
+
+// AddInsertionOrderResponse 响应结构体
+type AddInsertionOrderResponse struct {
+	XMLName          xml.Name `xml:"AddInsertionOrderResponse"`
+	Namespace        string   `xml:"xmlns,attr"`
+	InsertionOrderId int64    `xml:"InsertionOrderId"`
+	CreateTime       string   `xml:"CreateTime"`
+}
+
+// UpdateInsertionOrderRequest 请求结构体
+type UpdateInsertionOrderRequest struct {
+	XMLName        xml.Name       `xml:"UpdateInsertionOrderRequest"`
+	Namespace      string         `xml:"xmlns,attr"`
+	InsertionOrder InsertionOrder `xml:"InsertionOrder"`
+}
+
+// UpdateInsertionOrderResponse 响应结构体
+type UpdateInsertionOrderResponse struct {
+	XMLName          xml.Name `xml:"UpdateInsertionOrderResponse"`
+	Namespace        string   `xml:"xmlns,attr"`
+	LastModifiedTime string   `xml:"LastModifiedTime"`
+}
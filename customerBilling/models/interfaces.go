@@ -0,0 +1,44 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/vancevox/bingads-go/common"
+)
+
+// CustomerBillingAPI 定义Customer Billing API的操作
+type CustomerBillingAPI interface {
+	// InsertionOrderService 返回订单服务
+	InsertionOrderService() InsertionOrderService
+
+	// BillingService 返回账单服务
+	BillingService() BillingService
+}
+
+// InsertionOrderService 定义订单相关的操作
+type InsertionOrderService interface {
+	// SearchInsertionOrders 按条件分页搜索订单
+	SearchInsertionOrders(predicates []Predicate, ordering []OrderBy, pageInfo Paging) ([]InsertionOrder, error)
+
+	// InsertionOrdersPager 返回逐页搜索的迭代器
+	InsertionOrdersPager(ctx context.Context, predicates []Predicate, ordering []OrderBy, pageSize int) *common.Pager[InsertionOrder]
+
+	// AddInsertionOrder 添加订单，返回订单 ID 和创建时间
+	AddInsertionOrder(insertionOrder InsertionOrder) (int64, string, error)
+
+	// UpdateInsertionOrder 更新订单，返回最后修改时间
+	UpdateInsertionOrder(insertionOrder InsertionOrder) (string, error)
+}
+
+// BillingService 定义消费和账单相关的操作
+type BillingService interface {
+	// GetAccountMonthlySpend 获取账户在 monthYear 所在月份的消费金额
+	GetAccountMonthlySpend(accountId int64, monthYear time.Time) (float64, error)
+
+	// GetBillingDocumentsInfo 获取账户在日期范围内的账单摘要，endDate 为零值时截止到当前
+	GetBillingDocumentsInfo(accountIds []int64, startDate, endDate time.Time, returnInvoiceNumber bool) ([]BillingDocumentInfo, error)
+
+	// GetBillingDocuments 按 ID 获取账单文件，dataType 为空时获取 PDF
+	GetBillingDocuments(documentIds []int64, dataType DataType) ([]BillingDocument, error)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerBilling/models"
+)
+
+// BillingService 实现账单服务
+type BillingService struct {
+	client *Client
+}
+
+// NewBillingService 创建一个新的账单服务
+func NewBillingService(client *Client) *BillingService {
+	return &BillingService{
+		client: client,
+	}
+}
+
+// GetAccountMonthlySpend 获取账户在 monthYear 所在月份的消费金额
+func (s *BillingService) GetAccountMonthlySpend(accountId int64, monthYear time.Time) (float64, error) {
+	// 创建请求
+	request := models.GetAccountMonthlySpendRequest{
+		Namespace: config.CustomerBillingNamespace,
+		AccountId: accountId,
+		MonthYear: time.Date(monthYear.Year(), monthYear.Month(), 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAccountMonthlySpend, &models.CustomerBillingBody{
+		GetAccountMonthlySpendRequest: &request,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if body.GetAccountMonthlySpendResponse == nil {
+		return 0, missingResponse(models.SOAPActionGetAccountMonthlySpend)
+	}
+	return body.GetAccountMonthlySpendResponse.Amount, nil
+}
+
+// GetBillingDocumentsInfo 获取账户在日期范围内的账单摘要，endDate 为零值时截止到当前
+func (s *BillingService) GetBillingDocumentsInfo(accountIds []int64, startDate, endDate time.Time, returnInvoiceNumber bool) ([]models.BillingDocumentInfo, error) {
+	if len(accountIds) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "账户 ID 不能为空", nil)
+	}
+
+	// 创建请求
+	request := models.GetBillingDocumentsInfoRequest{
+		Namespace:           config.CustomerBillingNamespace,
+		AccountIds:          accountIds,
+		StartDate:           startDate,
+		ReturnInvoiceNumber: returnInvoiceNumber,
+	}
+	if !endDate.IsZero() {
+		request.EndDate = &endDate
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBillingDocumentsInfo, &models.CustomerBillingBody{
+		GetBillingDocumentsInfoRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetBillingDocumentsInfoResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetBillingDocumentsInfo)
+	}
+	return body.GetBillingDocumentsInfoResponse.BillingDocumentsInfo, nil
+}
+
+// GetBillingDocuments 按 ID 获取账单文件，dataType 为空时获取 PDF
+func (s *BillingService) GetBillingDocuments(documentIds []int64, dataType models.DataType) ([]models.BillingDocument, error) {
+	if len(documentIds) == 0 {
+		return nil, base.NewError(base.ErrInvalidInput, "账单 ID 不能为空", nil)
+	}
+	if dataType == "" {
+		dataType = models.DataTypePdf
+	}
+
+	// 创建请求
+	request := models.GetBillingDocumentsRequest{
+		Namespace:   config.CustomerBillingNamespace,
+		DocumentIds: documentIds,
+		Type:        dataType,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetBillingDocuments, &models.CustomerBillingBody{
+		GetBillingDocumentsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetBillingDocumentsResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetBillingDocuments)
+	}
+	return body.GetBillingDocumentsResponse.BillingDocuments, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerBilling/models"
+)
+
+// InsertionOrderService 实现订单服务
+type InsertionOrderService struct {
+	client *Client
+}
+
+// NewInsertionOrderService 创建一个新的订单服务
+func NewInsertionOrderService(client *Client) *InsertionOrderService {
+	return &InsertionOrderService{
+		client: client,
+	}
+}
+
+// SearchInsertionOrders 按条件分页搜索订单
+func (s *InsertionOrderService) SearchInsertionOrders(predicates []models.Predicate, ordering []models.OrderBy, pageInfo models.Paging) ([]models.InsertionOrder, error) {
+	// 创建请求
+	request := models.SearchInsertionOrdersRequest{
+		Namespace:  config.CustomerBillingNamespace,
+		Predicates: predicates,
+		Ordering:   ordering,
+		PageInfo:   pageInfo,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSearchInsertionOrders, &models.CustomerBillingBody{
+		SearchInsertionOrdersRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.SearchInsertionOrdersResponse == nil {
+		return nil, missingResponse(models.SOAPActionSearchInsertionOrders)
+	}
+	return body.SearchInsertionOrdersResponse.InsertionOrders, nil
+}
+
+// AddInsertionOrder 添加订单，返回订单 ID 和创建时间
+func (s *InsertionOrderService) AddInsertionOrder(insertionOrder models.InsertionOrder) (int64, string, error) {
+	// 创建请求
+	request := models.AddInsertionOrderRequest{
+		Namespace:      config.CustomerBillingNamespace,
+		InsertionOrder: insertionOrder,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionAddInsertionOrder, &models.CustomerBillingBody{
+		AddInsertionOrderRequest: &request,
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	if body.AddInsertionOrderResponse == nil {
+		return 0, "", missingResponse(models.SOAPActionAddInsertionOrder)
+	}
+	return body.AddInsertionOrderResponse.InsertionOrderId, body.AddInsertionOrderResponse.CreateTime, nil
+}
+
+// UpdateInsertionOrder 更新订单，返回最后修改时间
+//
+// insertionOrder 必须设置 Id 和 LastModifiedTime，未设置的字段不会被修改。
+func (s *InsertionOrderService) UpdateInsertionOrder(insertionOrder models.InsertionOrder) (string, error) {
+	// 创建请求
+	request := models.UpdateInsertionOrderRequest{
+		Namespace:      config.CustomerBillingNamespace,
+		InsertionOrder: insertionOrder,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateInsertionOrder, &models.CustomerBillingBody{
+		UpdateInsertionOrderRequest: &request,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if body.UpdateInsertionOrderResponse == nil {
+		return "", missingResponse(models.SOAPActionUpdateInsertionOrder)
+	}
+	return body.UpdateInsertionOrderResponse.LastModifiedTime, nil
+}
+
+// InsertionOrdersPager 返回逐页搜索的迭代器，pageSize 为 0 时使用 models.MaxPageSize，为负数时 Err 返回 INVALID_INPUT
+func (s *InsertionOrderService) InsertionOrdersPager(ctx context.Context, predicates []models.Predicate, ordering []models.OrderBy, pageSize int) *common.Pager[models.InsertionOrder] {
+	return common.NewPager(ctx, common.PageSizeOrMax(pageSize, models.MaxPageSize), func(index, size int) ([]models.InsertionOrder, error) {
+		return s.SearchInsertionOrders(predicates, ordering, models.Paging{Index: index, Size: size})
+	})
+}
@@ -0,0 +1,176 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// entityEncoder 用于编码 Customer Management 实体命名空间下的元素
+var entityEncoder = common.EntityEncoder{
+	Namespace: config.CustomerManagementEntitiesNamespace,
+	Prefix:    "e1",
+}
+
+// AccountInfo 表示账户的摘要信息
+type AccountInfo struct {
+	Id                     int64                  `xml:"Id"`
+	Name                   string                 `xml:"Name"`
+	Number                 string                 `xml:"Number"`
+	AccountLifeCycleStatus AccountLifeCycleStatus `xml:"AccountLifeCycleStatus"`
+	PauseReason            base.Nillable[int]     `xml:"PauseReason"`
+}
+
+// Address 表示地址
+type Address struct {
+	City            base.Nillable[string] `xml:"City"`
+	CountryCode     base.Nillable[string] `xml:"CountryCode"`
+	Id              base.Nillable[int64]  `xml:"Id"`
+	Line1           base.Nillable[string] `xml:"Line1"`
+	Line2           base.Nillable[string] `xml:"Line2"`
+	Line3           base.Nillable[string] `xml:"Line3"`
+	Line4           base.Nillable[string] `xml:"Line4"`
+	PostalCode      base.Nillable[string] `xml:"PostalCode"`
+	StateOrProvince base.Nillable[string] `xml:"StateOrProvince"`
+	TimeStamp       base.Nillable[string] `xml:"TimeStamp"`
+	BusinessName    base.Nillable[string] `xml:"BusinessName"`
+}
+
+// AdvertiserAccount 表示广告账户
+//
+// 字段顺序与 WSDL 一致，更新时未设置的字段不会被发送。
+type AdvertiserAccount struct {
+	BillToCustomerId          base.Nillable[int64]                  `xml:"BillToCustomerId"`
+	CurrencyCode              base.Nillable[string]                 `xml:"CurrencyCode"`
+	AccountFinancialStatus    base.Nillable[string]                 `xml:"AccountFinancialStatus"`
+	Id                        base.Nillable[int64]                  `xml:"Id"`
+	Language                  base.Nillable[string]                 `xml:"Language"`
+	LastModifiedByUserId      base.Nillable[int64]                  `xml:"LastModifiedByUserId"`
+	LastModifiedTime          base.Nillable[string]                 `xml:"LastModifiedTime"`
+	Name                      base.Nillable[string]                 `xml:"Name"`
+	Number                    base.Nillable[string]                 `xml:"Number"`
+	ParentCustomerId          base.Nillable[int64]                  `xml:"ParentCustomerId"`
+	PaymentMethodId           base.Nillable[int64]                  `xml:"PaymentMethodId"`
+	PaymentMethodType         base.Nillable[string]                 `xml:"PaymentMethodType"`
+	PrimaryUserId             base.Nillable[int64]                  `xml:"PrimaryUserId"`
+	AccountLifeCycleStatus    base.Nillable[AccountLifeCycleStatus] `xml:"AccountLifeCycleStatus"`
+	TimeStamp                 base.Nillable[string]                 `xml:"TimeStamp"`
+	TimeZone                  base.Nillable[string]                 `xml:"TimeZone"`
+	PauseReason               base.Nillable[int]                    `xml:"PauseReason"`
+	SalesHouseCustomerId      base.Nillable[int64]                  `xml:"SalesHouseCustomerId"`
+	BackUpPaymentInstrumentId base.Nillable[int64]                  `xml:"BackUpPaymentInstrumentId"`
+	BillingThresholdAmount    base.Nillable[float64]                `xml:"BillingThresholdAmount"`
+	BusinessAddress           *Address                              `xml:"BusinessAddress,omitempty"`
+	AutoTagType               base.Nillable[string]                 `xml:"AutoTagType"`
+	SoldToPaymentInstrumentId base.Nillable[int64]                  `xml:"SoldToPaymentInstrumentId"`
+	AccountMode               base.Nillable[string]                 `xml:"AccountMode"`
+}
+
+// MarshalXML 自定义 AdvertiserAccount 的 XML 序列化，字段位于实体命名空间
+func (a AdvertiserAccount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type advertiserAccount AdvertiserAccount
+	start.Attr = append(start.Attr, entityEncoder.TypeAttr("AdvertiserAccount"))
+	return entityEncoder.Encode(e, start, advertiserAccount(a))
+}
+
+// Predicate 表示搜索条件
+type Predicate struct {
+	Field    string            `xml:"Field"`
+	Operator PredicateOperator `xml:"Operator"`
+	Value    string            `xml:"Value"`
+}
+
+// MarshalXML 自定义 Predicate 的 XML 序列化，元素位于实体命名空间
+func (p Predicate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type predicate Predicate
+	start.Name = entityEncoder.Qualify(start.Name.Local)
+	return entityEncoder.Encode(e, start, predicate(p))
+}
+
+// OrderBy 表示排序条件
+type OrderBy struct {
+	Field OrderByField `xml:"Field"`
+	Order SortOrder    `xml:"Order"`
+}
+
+// MarshalXML 自定义 OrderBy 的 XML 序列化，元素位于实体命名空间
+func (o OrderBy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type orderBy OrderBy
+	start.Name = entityEncoder.Qualify(start.Name.Local)
+	return entityEncoder.Encode(e, start, orderBy(o))
+}
+
+// Paging 表示分页信息，Index 从 0 开始
+type Paging struct {
+	Index int `xml:"Index"`
+	Size  int `xml:"Size"`
+}
+
+// MarshalXML 自定义 Paging 的 XML 序列化，字段位于实体命名空间
+func (p Paging) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type paging Paging
+	return entityEncoder.Encode(e, start, paging(p))
+}
+
+// GetAccountsInfoRequest 请求结构体
+type GetAccountsInfoRequest struct {
+	XMLName            xml.Name             `xml:"GetAccountsInfoRequest"`
+	Namespace          string               `xml:"xmlns,attr"`
+	CustomerId         base.Nillable[int64] `xml:"CustomerId"`
+	OnlyParentAccounts bool                 `xml:"OnlyParentAccounts"`
+}
+
+// GetAccountsInfoResponse 响应结构体
+type GetAccountsInfoResponse struct {
+	XMLName      xml.Name      `xml:"GetAccountsInfoResponse"`
+	Namespace    string        `xml:"xmlns,attr"`
+	AccountsInfo []AccountInfo `xml:"AccountsInfo>AccountInfo,omitempty"`
+}
+
+// SearchAccountsRequest 请求结构体
+type SearchAccountsRequest struct {
+	XMLName                xml.Name    `xml:"SearchAccountsRequest"`
+	Namespace              string      `xml:"xmlns,attr"`
+	Predicates             []Predicate `xml:"Predicates>Predicate"`
+	Ordering               []OrderBy   `xml:"Ordering>OrderBy,omitempty"`
+	PageInfo               Paging      `xml:"PageInfo"`
+	ReturnAdditionalFields string      `xml:"ReturnAdditionalFields,omitempty"`
+}
+
+// SearchAccountsResponse 响应结构体
+type SearchAccountsResponse struct {
+	XMLName   xml.Name            `xml:"SearchAccountsResponse"`
+	Namespace string              `xml:"xmlns,attr"`
+	Accounts  []AdvertiserAccount `xml:"Accounts>AdvertiserAccount,omitempty"`
+}
+
+// GetAccountRequest 请求结构体
+type GetAccountRequest struct {
+	XMLName                xml.Name `xml:"GetAccountRequest"`
+	Namespace              string   `xml:"xmlns,attr"`
+	AccountId              int64    `xml:"AccountId"`
+	ReturnAdditionalFields string   `xml:"ReturnAdditionalFields,omitempty"`
+}
+
+// GetAccountResponse 响应结构体
+type GetAccountResponse struct {
+	XMLName   xml.Name          `xml:"GetAccountResponse"`
+	Namespace string            `xml:"xmlns,attr"`
+	Account   AdvertiserAccount `xml:"Account"`
+}
+
+// UpdateAccountRequest 请求结构体
+type UpdateAccountRequest struct {
+	XMLName   xml.Name          `xml:"UpdateAccountRequest"`
+	Namespace string            `xml:"xmlns,attr"`
+	Account   AdvertiserAccount `xml:"Account"`
+}
+
+// UpdateAccountResponse 响应结构体
+type UpdateAccountResponse struct {
+	XMLName          xml.Name `xml:"UpdateAccountResponse"`
+	Namespace        string   `xml:"xmlns,attr"`
+	LastModifiedTime string   `xml:"LastModifiedTime"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// CustomerManagementBody 表示请求体
+type CustomerManagementBody struct {
+	XMLName                                  xml.Name                                  `xml:"s:Body"`
+	GetUserRequest                           *GetUserRequest                           `xml:"GetUserRequest,omitempty"`
+	GetAccountsInfoRequest                   *GetAccountsInfoRequest                   `xml:"GetAccountsInfoRequest,omitempty"`
+	SearchAccountsRequest                    *SearchAccountsRequest                    `xml:"SearchAccountsRequest,omitempty"`
+	GetAccountRequest                        *GetAccountRequest                        `xml:"GetAccountRequest,omitempty"`
+	UpdateAccountRequest                     *UpdateAccountRequest                     `xml:"UpdateAccountRequest,omitempty"`
+	GetCustomersInfoRequest                  *GetCustomersInfoRequest                  `xml:"GetCustomersInfoRequest,omitempty"`
+	GetLinkedAccountsAndCustomersInfoRequest *GetLinkedAccountsAndCustomersInfoRequest `xml:"GetLinkedAccountsAndCustomersInfoRequest,omitempty"`
+}
+
+// CustomerManagementResponseBody 表示响应体
+type CustomerManagementResponseBody struct {
+	XMLName                                   xml.Name                                   `xml:"Body"`
+	Fault                                     *base.Fault                                `xml:"Fault,omitempty"`
+	GetUserResponse                           *GetUserResponse                           `xml:"GetUserResponse,omitempty"`
+	GetAccountsInfoResponse                   *GetAccountsInfoResponse                   `xml:"GetAccountsInfoResponse,omitempty"`
+	SearchAccountsResponse                    *SearchAccountsResponse                    `xml:"SearchAccountsResponse,omitempty"`
+	GetAccountResponse                        *GetAccountResponse                        `xml:"GetAccountResponse,omitempty"`
+	UpdateAccountResponse                     *UpdateAccountResponse                     `xml:"UpdateAccountResponse,omitempty"`
+	GetCustomersInfoResponse                  *GetCustomersInfoResponse                  `xml:"GetCustomersInfoResponse,omitempty"`
+	GetLinkedAccountsAndCustomersInfoResponse *GetLinkedAccountsAndCustomersInfoResponse `xml:"GetLinkedAccountsAndCustomersInfoResponse,omitempty"`
+}
+
+// CustomerManagementResponseEnvelope 表示完整的 SOAP 响应
+type CustomerManagementResponseEnvelope struct {
+	XMLName xml.Name                       `xml:"Envelope"`
+	XmlnsS  string                         `xml:"xmlns:s,attr,omitempty"`
+	Header  base.ResponseHeader            `xml:"Header"`
+	Body    CustomerManagementResponseBody `xml:"Body"`
+}
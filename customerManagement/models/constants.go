@@ -0,0 +1,71 @@
+package models
+
+type SOAPAction string
+
+const (
+	SOAPActionGetUser                           SOAPAction = "GetUser"
+	SOAPActionGetAccountsInfo                   SOAPAction = "GetAccountsInfo"
+	SOAPActionSearchAccounts                    SOAPAction = "SearchAccounts"
+	SOAPActionGetAccount                        SOAPAction = "GetAccount"
+	SOAPActionUpdateAccount                     SOAPAction = "UpdateAccount"
+	SOAPActionGetCustomersInfo                  SOAPAction = "GetCustomersInfo"
+	SOAPActionGetLinkedAccountsAndCustomersInfo SOAPAction = "GetLinkedAccountsAndCustomersInfo"
+)
+
+// AccountLifeCycleStatus 表示账户的生命周期状态
+type AccountLifeCycleStatus string
+
+const (
+	AccountLifeCycleStatusDraft     AccountLifeCycleStatus = "Draft"
+	AccountLifeCycleStatusActive    AccountLifeCycleStatus = "Active"
+	AccountLifeCycleStatusInactive  AccountLifeCycleStatus = "Inactive"
+	AccountLifeCycleStatusPause     AccountLifeCycleStatus = "Pause"
+	AccountLifeCycleStatusPending   AccountLifeCycleStatus = "Pending"
+	AccountLifeCycleStatusSuspended AccountLifeCycleStatus = "Suspended"
+)
+
+// PredicateOperator 表示搜索条件的运算符
+type PredicateOperator string
+
+const (
+	PredicateOperatorEquals             PredicateOperator = "Equals"
+	PredicateOperatorNotEquals          PredicateOperator = "NotEquals"
+	PredicateOperatorContains           PredicateOperator = "Contains"
+	PredicateOperatorIn                 PredicateOperator = "In"
+	PredicateOperatorGreaterThan        PredicateOperator = "GreaterThan"
+	PredicateOperatorGreaterThanEqualTo PredicateOperator = "GreaterThanEqualTo"
+	PredicateOperatorLessThan           PredicateOperator = "LessThan"
+	PredicateOperatorLessThanEqualTo    PredicateOperator = "LessThanEqualTo"
+	PredicateOperatorStartsWith         PredicateOperator = "StartsWith"
+	PredicateOperatorNotIn              PredicateOperator = "NotIn"
+)
+
+// SearchAccounts 支持的搜索字段
+const (
+	AccountSearchFieldAccountId              = "AccountId"
+	AccountSearchFieldAccountName            = "AccountName"
+	AccountSearchFieldAccountNumber          = "AccountNumber"
+	AccountSearchFieldAccountLifeCycleStatus = "AccountLifeCycleStatus"
+	AccountSearchFieldCustomerId             = "CustomerId"
+	AccountSearchFieldUserId                 = "UserId"
+)
+
+// OrderByField 表示排序字段
+type OrderByField string
+
+const (
+	OrderByFieldId     OrderByField = "Id"
+	OrderByFieldName   OrderByField = "Name"
+	OrderByFieldNumber OrderByField = "Number"
+)
+
+// SortOrder 表示排序方向
+type SortOrder string
+
+const (
+	SortOrderAscending  SortOrder = "Ascending"
+	SortOrderDescending SortOrder = "Descending"
+)
+
+// MaxPageSize 是 SearchAccounts 每页最多的结果数
+const MaxPageSize = 1000
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// CustomerInfo 表示客户的摘要信息
+type CustomerInfo struct {
+	Id   int64  `xml:"Id"`
+	Name string `xml:"Name"`
+}
+
+// GetCustomersInfoRequest 请求结构体
+type GetCustomersInfoRequest struct {
+	XMLName            xml.Name `xml:"GetCustomersInfoRequest"`
+	Namespace          string   `xml:"xmlns,attr"`
+	CustomerNameFilter string   `xml:"CustomerNameFilter"`
+	TopN               int      `xml:"TopN"`
+}
+
+// GetCustomersInfoResponse 响应结构体
+type GetCustomersInfoResponse struct {
+	XMLName       xml.Name       `xml:"GetCustomersInfoResponse"`
+	Namespace     string         `xml:"xmlns,attr"`
+	CustomersInfo []CustomerInfo `xml:"CustomersInfo>CustomerInfo,omitempty"`
+}
+
+// GetLinkedAccountsAndCustomersInfoRequest 请求结构体
+type GetLinkedAccountsAndCustomersInfoRequest struct {
+	XMLName            xml.Name             `xml:"GetLinkedAccountsAndCustomersInfoRequest"`
+	Namespace          string               `xml:"xmlns,attr"`
+	CustomerId         base.Nillable[int64] `xml:"CustomerId"`
+	OnlyParentAccounts bool                 `xml:"OnlyParentAccounts"`
+}
+
+// GetLinkedAccountsAndCustomersInfoResponse 响应结构体
+type GetLinkedAccountsAndCustomersInfoResponse struct {
+	XMLName       xml.Name       `xml:"GetLinkedAccountsAndCustomersInfoResponse"`
+	Namespace     string         `xml:"xmlns,attr"`
+	AccountsInfo  []AccountInfo  `xml:"AccountsInfo>AccountInfo,omitempty"`
+	CustomersInfo []CustomerInfo `xml:"CustomersInfo>CustomerInfo,omitempty"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"context"
+
+	"github.com/vancevox/bingads-go/common"
+)
+
+// CustomerManagementAPI 定义Customer Management API的操作
+type CustomerManagementAPI interface {
+	// UserService 返回用户服务
+	UserService() UserService
+
+	// AccountService 返回账户服务
+	AccountService() AccountService
+
+	// CustomerService 返回客户服务
+	CustomerService() CustomerService
+}
+
+// UserService 定义用户相关的操作
+type UserService interface {
+	// GetUser 获取用户及其客户角色，userId 为 0 时获取当前认证的用户
+	GetUser(userId int64) (*User, []CustomerRole, error)
+}
+
+// AccountService 定义账户相关的操作
+type AccountService interface {
+	// GetAccountsInfo 获取客户下的账户摘要，customerId 为 0 时使用当前用户所属的客户
+	GetAccountsInfo(customerId int64, onlyParentAccounts bool) ([]AccountInfo, error)
+
+	// SearchAccounts 按条件分页搜索账户
+	SearchAccounts(predicates []Predicate, ordering []OrderBy, pageInfo Paging) ([]AdvertiserAccount, error)
+
+	// AccountsPager 返回逐页搜索的迭代器
+	AccountsPager(ctx context.Context, predicates []Predicate, ordering []OrderBy, pageSize int) *common.Pager[AdvertiserAccount]
+
+	// GetAccount 获取账户详情
+	GetAccount(accountId int64) (*AdvertiserAccount, error)
+
+	// UpdateAccount 更新账户，返回最后修改时间
+	UpdateAccount(account AdvertiserAccount) (string, error)
+}
+
+// CustomerService 定义客户相关的操作
+type CustomerService interface {
+	// GetCustomersInfo 按名称前缀获取客户摘要
+	GetCustomersInfo(customerNameFilter string, topN int) ([]CustomerInfo, error)
+
+	// GetLinkedAccountsAndCustomersInfo 获取关联的账户和客户，customerId 为 0 时使用当前用户所属的客户
+	GetLinkedAccountsAndCustomersInfo(customerId int64, onlyParentAccounts bool) ([]AccountInfo, []CustomerInfo, error)
+}
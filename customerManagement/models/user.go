@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// PersonName 表示用户姓名
+type PersonName struct {
+	FirstName     string `xml:"FirstName"`
+	LastName      string `xml:"LastName"`
+	MiddleInitial string `xml:"MiddleInitial,omitempty"`
+}
+
+// ContactInfo 表示用户的联系信息
+type ContactInfo struct {
+	Address             *Address             `xml:"Address,omitempty"`
+	ContactByPhone      bool                 `xml:"ContactByPhone"`
+	ContactByPostalMail bool                 `xml:"ContactByPostalMail"`
+	Email               string               `xml:"Email"`
+	EmailFormat         string               `xml:"EmailFormat"`
+	Fax                 string               `xml:"Fax"`
+	HomePhone           string               `xml:"HomePhone"`
+	Id                  base.Nillable[int64] `xml:"Id"`
+	Mobile              string               `xml:"Mobile"`
+	Phone1              string               `xml:"Phone1"`
+	Phone2              string               `xml:"Phone2"`
+}
+
+// User 表示 Microsoft Advertising 用户
+type User struct {
+	ContactInfo          *ContactInfo          `xml:"ContactInfo,omitempty"`
+	CustomerId           base.Nillable[int64]  `xml:"CustomerId"`
+	Id                   base.Nillable[int64]  `xml:"Id"`
+	JobTitle             string                `xml:"JobTitle"`
+	LastModifiedByUserId base.Nillable[int64]  `xml:"LastModifiedByUserId"`
+	LastModifiedTime     base.Nillable[string] `xml:"LastModifiedTime"`
+	Lcid                 string                `xml:"Lcid"`
+	Name                 *PersonName           `xml:"Name,omitempty"`
+	UserLifeCycleStatus  string                `xml:"UserLifeCycleStatus"`
+	TimeStamp            base.Nillable[string] `xml:"TimeStamp"`
+	UserName             string                `xml:"UserName"`
+}
+
+// CustomerRole 表示用户在某个客户下的角色
+type CustomerRole struct {
+	RoleId                 int     `xml:"RoleId"`
+	CustomerId             int64   `xml:"CustomerId"`
+	AccountIds             []int64 `xml:"AccountIds>long,omitempty"`
+	LinkedAccountIds       []int64 `xml:"LinkedAccountIds>long,omitempty"`
+	CustomerLinkPermission string  `xml:"CustomerLinkPermission"`
+}
+
+// GetUserRequest 请求结构体
+type GetUserRequest struct {
+	XMLName   xml.Name             `xml:"GetUserRequest"`
+	Namespace string               `xml:"xmlns,attr"`
+	UserId    base.Nillable[int64] `xml:"UserId"`
+}
+
+// GetUserResponse 响应结构体
+type GetUserResponse struct {
+	XMLName       xml.Name       `xml:"GetUserResponse"`
+	Namespace     string         `xml:"xmlns,attr"`
+	User          User           `xml:"User"`
+	CustomerRoles []CustomerRole `xml:"CustomerRoles>CustomerRole,omitempty"`
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerManagement/models"
+)
+
+// AccountService 实现账户服务
+type AccountService struct {
+	client *Client
+}
+
+// NewAccountService 创建一个新的账户服务
+func NewAccountService(client *Client) *AccountService {
+	return &AccountService{
+		client: client,
+	}
+}
+
+// GetAccountsInfo 获取客户下的账户摘要，customerId 为 0 时使用当前用户所属的客户
+func (s *AccountService) GetAccountsInfo(customerId int64, onlyParentAccounts bool) ([]models.AccountInfo, error) {
+	// 创建请求
+	request := models.GetAccountsInfoRequest{
+		Namespace:          config.CustomerManagementNamespace,
+		CustomerId:         nillableID(customerId),
+		OnlyParentAccounts: onlyParentAccounts,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAccountsInfo, &models.CustomerManagementBody{
+		GetAccountsInfoRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetAccountsInfoResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetAccountsInfo)
+	}
+	return body.GetAccountsInfoResponse.AccountsInfo, nil
+}
+
+// SearchAccounts 按条件分页搜索账户
+func (s *AccountService) SearchAccounts(predicates []models.Predicate, ordering []models.OrderBy, pageInfo models.Paging) ([]models.AdvertiserAccount, error) {
+	// 创建请求
+	request := models.SearchAccountsRequest{
+		Namespace:  config.CustomerManagementNamespace,
+		Predicates: predicates,
+		Ordering:   ordering,
+		PageInfo:   pageInfo,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSearchAccounts, &models.CustomerManagementBody{
+		SearchAccountsRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.SearchAccountsResponse == nil {
+		return nil, missingResponse(models.SOAPActionSearchAccounts)
+	}
+	return body.SearchAccountsResponse.Accounts, nil
+}
+
+// GetAccount 获取账户详情
+func (s *AccountService) GetAccount(accountId int64) (*models.AdvertiserAccount, error) {
+	// 创建请求
+	request := models.GetAccountRequest{
+		Namespace: config.CustomerManagementNamespace,
+		AccountId: accountId,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetAccount, &models.CustomerManagementBody{
+		GetAccountRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetAccountResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetAccount)
+	}
+	return &body.GetAccountResponse.Account, nil
+}
+
+// UpdateAccount 更新账户，返回最后修改时间
+//
+// account 必须设置 Id 和 TimeStamp，未设置的字段不会被修改。
+func (s *AccountService) UpdateAccount(account models.AdvertiserAccount) (string, error) {
+	// 创建请求
+	request := models.UpdateAccountRequest{
+		Namespace: config.CustomerManagementNamespace,
+		Account:   account,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionUpdateAccount, &models.CustomerManagementBody{
+		UpdateAccountRequest: &request,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if body.UpdateAccountResponse == nil {
+		return "", missingResponse(models.SOAPActionUpdateAccount)
+	}
+	return body.UpdateAccountResponse.LastModifiedTime, nil
+}
+
+// AccountsPager 返回逐页搜索的迭代器，pageSize 为 0 时使用 models.MaxPageSize，为负数时 Err 返回 INVALID_INPUT
+func (s *AccountService) AccountsPager(ctx context.Context, predicates []models.Predicate, ordering []models.OrderBy, pageSize int) *common.Pager[models.AdvertiserAccount] {
+	return common.NewPager(ctx, common.PageSizeOrMax(pageSize, models.MaxPageSize), func(index, size int) ([]models.AdvertiserAccount, error) {
+		return s.SearchAccounts(predicates, ordering, models.Paging{Index: index, Size: size})
+	})
+}
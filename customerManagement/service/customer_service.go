@@ -0,0 +1,65 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerManagement/models"
+)
+
+// CustomerService 实现客户服务
+type CustomerService struct {
+	client *Client
+}
+
+// NewCustomerService 创建一个新的客户服务
+func NewCustomerService(client *Client) *CustomerService {
+	return &CustomerService{
+		client: client,
+	}
+}
+
+// GetCustomersInfo 按名称前缀获取客户摘要
+func (s *CustomerService) GetCustomersInfo(customerNameFilter string, topN int) ([]models.CustomerInfo, error) {
+	// 创建请求
+	request := models.GetCustomersInfoRequest{
+		Namespace:          config.CustomerManagementNamespace,
+		CustomerNameFilter: customerNameFilter,
+		TopN:               topN,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetCustomersInfo, &models.CustomerManagementBody{
+		GetCustomersInfoRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.GetCustomersInfoResponse == nil {
+		return nil, missingResponse(models.SOAPActionGetCustomersInfo)
+	}
+	return body.GetCustomersInfoResponse.CustomersInfo, nil
+}
+
+// GetLinkedAccountsAndCustomersInfo 获取关联的账户和客户，customerId 为 0 时使用当前用户所属的客户
+func (s *CustomerService) GetLinkedAccountsAndCustomersInfo(customerId int64, onlyParentAccounts bool) ([]models.AccountInfo, []models.CustomerInfo, error) {
+	// 创建请求
+	request := models.GetLinkedAccountsAndCustomersInfoRequest{
+		Namespace:          config.CustomerManagementNamespace,
+		CustomerId:         nillableID(customerId),
+		OnlyParentAccounts: onlyParentAccounts,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetLinkedAccountsAndCustomersInfo, &models.CustomerManagementBody{
+		GetLinkedAccountsAndCustomersInfoRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetLinkedAccountsAndCustomersInfoResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetLinkedAccountsAndCustomersInfo)
+	}
+	return resp.AccountsInfo, resp.CustomersInfo, nil
+}
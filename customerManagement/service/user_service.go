@@ -0,0 +1,41 @@
+package service
+
+import (
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerManagement/models"
+)
+
+// UserService 实现用户服务
+type UserService struct {
+	client *Client
+}
+
+// NewUserService 创建一个新的用户服务
+func NewUserService(client *Client) *UserService {
+	return &UserService{
+		client: client,
+	}
+}
+
+// GetUser 获取用户及其客户角色，userId 为 0 时获取当前认证的用户
+func (s *UserService) GetUser(userId int64) (*models.User, []models.CustomerRole, error) {
+	// 创建请求
+	request := models.GetUserRequest{
+		Namespace: config.CustomerManagementNamespace,
+		UserId:    nillableID(userId),
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionGetUser, &models.CustomerManagementBody{
+		GetUserRequest: &request,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := body.GetUserResponse
+	if resp == nil {
+		return nil, nil, missingResponse(models.SOAPActionGetUser)
+	}
+	return &resp.User, resp.CustomerRoles, nil
+}
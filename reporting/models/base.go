@@ -0,0 +1,30 @@
+package models
+
+import (
+	"encoding/xml"
+
+	"github.com/vancevox/bingads-go/base"
+)
+
+// ReportingBody 表示请求体
+type ReportingBody struct {
+	XMLName                     xml.Name                     `xml:"s:Body"`
+	SubmitGenerateReportRequest *SubmitGenerateReportRequest `xml:"SubmitGenerateReportRequest,omitempty"`
+	PollGenerateReportRequest   *PollGenerateReportRequest   `xml:"PollGenerateReportRequest,omitempty"`
+}
+
+// ReportingResponseBody 表示响应体
+type ReportingResponseBody struct {
+	XMLName                      xml.Name                      `xml:"Body"`
+	Fault                        *base.Fault                   `xml:"Fault,omitempty"`
+	SubmitGenerateReportResponse *SubmitGenerateReportResponse `xml:"SubmitGenerateReportResponse,omitempty"`
+	PollGenerateReportResponse   *PollGenerateReportResponse   `xml:"PollGenerateReportResponse,omitempty"`
+}
+
+// ReportingResponseEnvelope 表示完整的 SOAP 响应
+type ReportingResponseEnvelope struct {
+	XMLName xml.Name              `xml:"Envelope"`
+	XmlnsS  string                `xml:"xmlns:s,attr,omitempty"`
+	Header  base.ResponseHeader   `xml:"Header"`
+	Body    ReportingResponseBody `xml:"Body"`
+}
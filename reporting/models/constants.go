@@ -0,0 +1,230 @@
+package models
+
+type SOAPAction string
+
+const (
+	SOAPActionSubmitGenerateReport SOAPAction = "SubmitGenerateReport"
+	SOAPActionPollGenerateReport   SOAPAction = "PollGenerateReport"
+)
+
+// ReportFormat 表示报告文件格式
+type ReportFormat string
+
+const (
+	ReportFormatCsv ReportFormat = "Csv"
+	ReportFormatTsv ReportFormat = "Tsv"
+)
+
+// ReportAggregation 表示报告数据的聚合方式
+type ReportAggregation string
+
+const (
+	ReportAggregationSummary              ReportAggregation = "Summary"
+	ReportAggregationHourly               ReportAggregation = "Hourly"
+	ReportAggregationDaily                ReportAggregation = "Daily"
+	ReportAggregationWeekly               ReportAggregation = "Weekly"
+	ReportAggregationMonthly              ReportAggregation = "Monthly"
+	ReportAggregationYearly               ReportAggregation = "Yearly"
+	ReportAggregationHourOfDay            ReportAggregation = "HourOfDay"
+	ReportAggregationDayOfWeek            ReportAggregation = "DayOfWeek"
+	ReportAggregationWeeklyStartingMonday ReportAggregation = "WeeklyStartingMonday"
+)
+
+// ReportTimePeriod 表示预定义的报告时间范围
+type ReportTimePeriod string
+
+const (
+	ReportTimePeriodToday                       ReportTimePeriod = "Today"
+	ReportTimePeriodYesterday                   ReportTimePeriod = "Yesterday"
+	ReportTimePeriodLastSevenDays               ReportTimePeriod = "LastSevenDays"
+	ReportTimePeriodThisWeek                    ReportTimePeriod = "ThisWeek"
+	ReportTimePeriodLastWeek                    ReportTimePeriod = "LastWeek"
+	ReportTimePeriodLast14Days                  ReportTimePeriod = "Last14Days"
+	ReportTimePeriodLast30Days                  ReportTimePeriod = "Last30Days"
+	ReportTimePeriodLastFourWeeks               ReportTimePeriod = "LastFourWeeks"
+	ReportTimePeriodThisMonth                   ReportTimePeriod = "ThisMonth"
+	ReportTimePeriodLastMonth                   ReportTimePeriod = "LastMonth"
+	ReportTimePeriodLastThreeMonths             ReportTimePeriod = "LastThreeMonths"
+	ReportTimePeriodLastSixMonths               ReportTimePeriod = "LastSixMonths"
+	ReportTimePeriodThisYear                    ReportTimePeriod = "ThisYear"
+	ReportTimePeriodLastYear                    ReportTimePeriod = "LastYear"
+	ReportTimePeriodThisWeekStartingMonday      ReportTimePeriod = "ThisWeekStartingMonday"
+	ReportTimePeriodLastWeekStartingMonday      ReportTimePeriod = "LastWeekStartingMonday"
+	ReportTimePeriodLastFourWeeksStartingMonday ReportTimePeriod = "LastFourWeeksStartingMonday"
+)
+
+// ReportRequestStatusType 表示报告的生成状态
+type ReportRequestStatusType string
+
+const (
+	ReportRequestStatusPending ReportRequestStatusType = "Pending"
+	ReportRequestStatusSuccess ReportRequestStatusType = "Success"
+	ReportRequestStatusError   ReportRequestStatusType = "Error"
+)
+
+// CampaignPerformanceReportColumn 表示活动效果报告的列
+type CampaignPerformanceReportColumn string
+
+const (
+	CampaignPerformanceReportColumnAccountName            CampaignPerformanceReportColumn = "AccountName"
+	CampaignPerformanceReportColumnAccountNumber          CampaignPerformanceReportColumn = "AccountNumber"
+	CampaignPerformanceReportColumnAccountId              CampaignPerformanceReportColumn = "AccountId"
+	CampaignPerformanceReportColumnTimePeriod             CampaignPerformanceReportColumn = "TimePeriod"
+	CampaignPerformanceReportColumnCampaignStatus         CampaignPerformanceReportColumn = "CampaignStatus"
+	CampaignPerformanceReportColumnCampaignName           CampaignPerformanceReportColumn = "CampaignName"
+	CampaignPerformanceReportColumnCampaignId             CampaignPerformanceReportColumn = "CampaignId"
+	CampaignPerformanceReportColumnCurrencyCode           CampaignPerformanceReportColumn = "CurrencyCode"
+	CampaignPerformanceReportColumnAdDistribution         CampaignPerformanceReportColumn = "AdDistribution"
+	CampaignPerformanceReportColumnDeviceType             CampaignPerformanceReportColumn = "DeviceType"
+	CampaignPerformanceReportColumnNetwork                CampaignPerformanceReportColumn = "Network"
+	CampaignPerformanceReportColumnImpressions            CampaignPerformanceReportColumn = "Impressions"
+	CampaignPerformanceReportColumnClicks                 CampaignPerformanceReportColumn = "Clicks"
+	CampaignPerformanceReportColumnCtr                    CampaignPerformanceReportColumn = "Ctr"
+	CampaignPerformanceReportColumnAverageCpc             CampaignPerformanceReportColumn = "AverageCpc"
+	CampaignPerformanceReportColumnSpend                  CampaignPerformanceReportColumn = "Spend"
+	CampaignPerformanceReportColumnConversions            CampaignPerformanceReportColumn = "Conversions"
+	CampaignPerformanceReportColumnConversionRate         CampaignPerformanceReportColumn = "ConversionRate"
+	CampaignPerformanceReportColumnCostPerConversion      CampaignPerformanceReportColumn = "CostPerConversion"
+	CampaignPerformanceReportColumnRevenue                CampaignPerformanceReportColumn = "Revenue"
+	CampaignPerformanceReportColumnReturnOnAdSpend        CampaignPerformanceReportColumn = "ReturnOnAdSpend"
+	CampaignPerformanceReportColumnQualityScore           CampaignPerformanceReportColumn = "QualityScore"
+	CampaignPerformanceReportColumnImpressionSharePercent CampaignPerformanceReportColumn = "ImpressionSharePercent"
+	CampaignPerformanceReportColumnBudgetName             CampaignPerformanceReportColumn = "BudgetName"
+	CampaignPerformanceReportColumnBudgetStatus           CampaignPerformanceReportColumn = "BudgetStatus"
+	CampaignPerformanceReportColumnAllConversions         CampaignPerformanceReportColumn = "AllConversions"
+	CampaignPerformanceReportColumnAllRevenue             CampaignPerformanceReportColumn = "AllRevenue"
+	CampaignPerformanceReportColumnViewThroughConversions CampaignPerformanceReportColumn = "ViewThroughConversions"
+)
+
+// AdGroupPerformanceReportColumn 表示广告组效果报告的列
+type AdGroupPerformanceReportColumn string
+
+const (
+	AdGroupPerformanceReportColumnAccountName            AdGroupPerformanceReportColumn = "AccountName"
+	AdGroupPerformanceReportColumnAccountNumber          AdGroupPerformanceReportColumn = "AccountNumber"
+	AdGroupPerformanceReportColumnAccountId              AdGroupPerformanceReportColumn = "AccountId"
+	AdGroupPerformanceReportColumnTimePeriod             AdGroupPerformanceReportColumn = "TimePeriod"
+	AdGroupPerformanceReportColumnCampaignName           AdGroupPerformanceReportColumn = "CampaignName"
+	AdGroupPerformanceReportColumnCampaignId             AdGroupPerformanceReportColumn = "CampaignId"
+	AdGroupPerformanceReportColumnAdGroupName            AdGroupPerformanceReportColumn = "AdGroupName"
+	AdGroupPerformanceReportColumnAdGroupId              AdGroupPerformanceReportColumn = "AdGroupId"
+	AdGroupPerformanceReportColumnStatus                 AdGroupPerformanceReportColumn = "Status"
+	AdGroupPerformanceReportColumnCurrencyCode           AdGroupPerformanceReportColumn = "CurrencyCode"
+	AdGroupPerformanceReportColumnAdDistribution         AdGroupPerformanceReportColumn = "AdDistribution"
+	AdGroupPerformanceReportColumnDeviceType             AdGroupPerformanceReportColumn = "DeviceType"
+	AdGroupPerformanceReportColumnNetwork                AdGroupPerformanceReportColumn = "Network"
+	AdGroupPerformanceReportColumnImpressions            AdGroupPerformanceReportColumn = "Impressions"
+	AdGroupPerformanceReportColumnClicks                 AdGroupPerformanceReportColumn = "Clicks"
+	AdGroupPerformanceReportColumnCtr                    AdGroupPerformanceReportColumn = "Ctr"
+	AdGroupPerformanceReportColumnAverageCpc             AdGroupPerformanceReportColumn = "AverageCpc"
+	AdGroupPerformanceReportColumnSpend                  AdGroupPerformanceReportColumn = "Spend"
+	AdGroupPerformanceReportColumnConversions            AdGroupPerformanceReportColumn = "Conversions"
+	AdGroupPerformanceReportColumnConversionRate         AdGroupPerformanceReportColumn = "ConversionRate"
+	AdGroupPerformanceReportColumnCostPerConversion      AdGroupPerformanceReportColumn = "CostPerConversion"
+	AdGroupPerformanceReportColumnRevenue                AdGroupPerformanceReportColumn = "Revenue"
+	AdGroupPerformanceReportColumnReturnOnAdSpend        AdGroupPerformanceReportColumn = "ReturnOnAdSpend"
+	AdGroupPerformanceReportColumnQualityScore           AdGroupPerformanceReportColumn = "QualityScore"
+	AdGroupPerformanceReportColumnImpressionSharePercent AdGroupPerformanceReportColumn = "ImpressionSharePercent"
+	AdGroupPerformanceReportColumnAllConversions         AdGroupPerformanceReportColumn = "AllConversions"
+	AdGroupPerformanceReportColumnAllRevenue             AdGroupPerformanceReportColumn = "AllRevenue"
+)
+
+// KeywordPerformanceReportColumn 表示关键词效果报告的列
+type KeywordPerformanceReportColumn string
+
+const (
+	KeywordPerformanceReportColumnAccountName        KeywordPerformanceReportColumn = "AccountName"
+	KeywordPerformanceReportColumnAccountNumber      KeywordPerformanceReportColumn = "AccountNumber"
+	KeywordPerformanceReportColumnAccountId          KeywordPerformanceReportColumn = "AccountId"
+	KeywordPerformanceReportColumnTimePeriod         KeywordPerformanceReportColumn = "TimePeriod"
+	KeywordPerformanceReportColumnCampaignName       KeywordPerformanceReportColumn = "CampaignName"
+	KeywordPerformanceReportColumnCampaignId         KeywordPerformanceReportColumn = "CampaignId"
+	KeywordPerformanceReportColumnAdGroupName        KeywordPerformanceReportColumn = "AdGroupName"
+	KeywordPerformanceReportColumnAdGroupId          KeywordPerformanceReportColumn = "AdGroupId"
+	KeywordPerformanceReportColumnKeyword            KeywordPerformanceReportColumn = "Keyword"
+	KeywordPerformanceReportColumnKeywordId          KeywordPerformanceReportColumn = "KeywordId"
+	KeywordPerformanceReportColumnKeywordStatus      KeywordPerformanceReportColumn = "KeywordStatus"
+	KeywordPerformanceReportColumnBidMatchType       KeywordPerformanceReportColumn = "BidMatchType"
+	KeywordPerformanceReportColumnDeliveredMatchType KeywordPerformanceReportColumn = "DeliveredMatchType"
+	KeywordPerformanceReportColumnCurrentMaxCpc      KeywordPerformanceReportColumn = "CurrentMaxCpc"
+	KeywordPerformanceReportColumnFirstPageBid       KeywordPerformanceReportColumn = "FirstPageBid"
+	KeywordPerformanceReportColumnFinalUrl           KeywordPerformanceReportColumn = "FinalUrl"
+	KeywordPerformanceReportColumnCurrencyCode       KeywordPerformanceReportColumn = "CurrencyCode"
+	KeywordPerformanceReportColumnDeviceType         KeywordPerformanceReportColumn = "DeviceType"
+	KeywordPerformanceReportColumnNetwork            KeywordPerformanceReportColumn = "Network"
+	KeywordPerformanceReportColumnImpressions        KeywordPerformanceReportColumn = "Impressions"
+	KeywordPerformanceReportColumnClicks             KeywordPerformanceReportColumn = "Clicks"
+	KeywordPerformanceReportColumnCtr                KeywordPerformanceReportColumn = "Ctr"
+	KeywordPerformanceReportColumnAverageCpc         KeywordPerformanceReportColumn = "AverageCpc"
+	KeywordPerformanceReportColumnSpend              KeywordPerformanceReportColumn = "Spend"
+	KeywordPerformanceReportColumnConversions        KeywordPerformanceReportColumn = "Conversions"
+	KeywordPerformanceReportColumnConversionRate     KeywordPerformanceReportColumn = "ConversionRate"
+	KeywordPerformanceReportColumnCostPerConversion  KeywordPerformanceReportColumn = "CostPerConversion"
+	KeywordPerformanceReportColumnRevenue            KeywordPerformanceReportColumn = "Revenue"
+	KeywordPerformanceReportColumnReturnOnAdSpend    KeywordPerformanceReportColumn = "ReturnOnAdSpend"
+	KeywordPerformanceReportColumnQualityScore       KeywordPerformanceReportColumn = "QualityScore"
+)
+
+// SearchQueryPerformanceReportColumn 表示搜索词效果报告的列
+type SearchQueryPerformanceReportColumn string
+
+const (
+	SearchQueryPerformanceReportColumnAccountName        SearchQueryPerformanceReportColumn = "AccountName"
+	SearchQueryPerformanceReportColumnAccountNumber      SearchQueryPerformanceReportColumn = "AccountNumber"
+	SearchQueryPerformanceReportColumnAccountId          SearchQueryPerformanceReportColumn = "AccountId"
+	SearchQueryPerformanceReportColumnTimePeriod         SearchQueryPerformanceReportColumn = "TimePeriod"
+	SearchQueryPerformanceReportColumnCampaignName       SearchQueryPerformanceReportColumn = "CampaignName"
+	SearchQueryPerformanceReportColumnCampaignId         SearchQueryPerformanceReportColumn = "CampaignId"
+	SearchQueryPerformanceReportColumnAdGroupName        SearchQueryPerformanceReportColumn = "AdGroupName"
+	SearchQueryPerformanceReportColumnAdGroupId          SearchQueryPerformanceReportColumn = "AdGroupId"
+	SearchQueryPerformanceReportColumnSearchQuery        SearchQueryPerformanceReportColumn = "SearchQuery"
+	SearchQueryPerformanceReportColumnKeyword            SearchQueryPerformanceReportColumn = "Keyword"
+	SearchQueryPerformanceReportColumnKeywordId          SearchQueryPerformanceReportColumn = "KeywordId"
+	SearchQueryPerformanceReportColumnAdId               SearchQueryPerformanceReportColumn = "AdId"
+	SearchQueryPerformanceReportColumnBidMatchType       SearchQueryPerformanceReportColumn = "BidMatchType"
+	SearchQueryPerformanceReportColumnDeliveredMatchType SearchQueryPerformanceReportColumn = "DeliveredMatchType"
+	SearchQueryPerformanceReportColumnCurrencyCode       SearchQueryPerformanceReportColumn = "CurrencyCode"
+	SearchQueryPerformanceReportColumnDeviceType         SearchQueryPerformanceReportColumn = "DeviceType"
+	SearchQueryPerformanceReportColumnNetwork            SearchQueryPerformanceReportColumn = "Network"
+	SearchQueryPerformanceReportColumnImpressions        SearchQueryPerformanceReportColumn = "Impressions"
+	SearchQueryPerformanceReportColumnClicks             SearchQueryPerformanceReportColumn = "Clicks"
+	SearchQueryPerformanceReportColumnCtr                SearchQueryPerformanceReportColumn = "Ctr"
+	SearchQueryPerformanceReportColumnAverageCpc         SearchQueryPerformanceReportColumn = "AverageCpc"
+	SearchQueryPerformanceReportColumnSpend              SearchQueryPerformanceReportColumn = "Spend"
+	SearchQueryPerformanceReportColumnConversions        SearchQueryPerformanceReportColumn = "Conversions"
+	SearchQueryPerformanceReportColumnConversionRate     SearchQueryPerformanceReportColumn = "ConversionRate"
+	SearchQueryPerformanceReportColumnCostPerConversion  SearchQueryPerformanceReportColumn = "CostPerConversion"
+	SearchQueryPerformanceReportColumnRevenue            SearchQueryPerformanceReportColumn = "Revenue"
+	SearchQueryPerformanceReportColumnReturnOnAdSpend    SearchQueryPerformanceReportColumn = "ReturnOnAdSpend"
+)
+
+// ShareOfVoiceReportColumn 表示展示份额报告的列
+type ShareOfVoiceReportColumn string
+
+const (
+	ShareOfVoiceReportColumnAccountName                       ShareOfVoiceReportColumn = "AccountName"
+	ShareOfVoiceReportColumnAccountNumber                     ShareOfVoiceReportColumn = "AccountNumber"
+	ShareOfVoiceReportColumnAccountId                         ShareOfVoiceReportColumn = "AccountId"
+	ShareOfVoiceReportColumnTimePeriod                        ShareOfVoiceReportColumn = "TimePeriod"
+	ShareOfVoiceReportColumnCampaignName                      ShareOfVoiceReportColumn = "CampaignName"
+	ShareOfVoiceReportColumnCampaignId                        ShareOfVoiceReportColumn = "CampaignId"
+	ShareOfVoiceReportColumnAdGroupName                       ShareOfVoiceReportColumn = "AdGroupName"
+	ShareOfVoiceReportColumnAdGroupId                         ShareOfVoiceReportColumn = "AdGroupId"
+	ShareOfVoiceReportColumnKeyword                           ShareOfVoiceReportColumn = "Keyword"
+	ShareOfVoiceReportColumnKeywordId                         ShareOfVoiceReportColumn = "KeywordId"
+	ShareOfVoiceReportColumnBidMatchType                      ShareOfVoiceReportColumn = "BidMatchType"
+	ShareOfVoiceReportColumnDeviceType                        ShareOfVoiceReportColumn = "DeviceType"
+	ShareOfVoiceReportColumnNetwork                           ShareOfVoiceReportColumn = "Network"
+	ShareOfVoiceReportColumnImpressions                       ShareOfVoiceReportColumn = "Impressions"
+	ShareOfVoiceReportColumnClicks                            ShareOfVoiceReportColumn = "Clicks"
+	ShareOfVoiceReportColumnCtr                               ShareOfVoiceReportColumn = "Ctr"
+	ShareOfVoiceReportColumnAverageCpc                        ShareOfVoiceReportColumn = "AverageCpc"
+	ShareOfVoiceReportColumnSpend                             ShareOfVoiceReportColumn = "Spend"
+	ShareOfVoiceReportColumnImpressionSharePercent            ShareOfVoiceReportColumn = "ImpressionSharePercent"
+	ShareOfVoiceReportColumnImpressionLostToBudgetPercent     ShareOfVoiceReportColumn = "ImpressionLostToBudgetPercent"
+	ShareOfVoiceReportColumnImpressionLostToRankAggPercent    ShareOfVoiceReportColumn = "ImpressionLostToRankAggPercent"
+	ShareOfVoiceReportColumnExactMatchImpressionSharePercent  ShareOfVoiceReportColumn = "ExactMatchImpressionSharePercent"
+	ShareOfVoiceReportColumnAbsoluteTopImpressionSharePercent ShareOfVoiceReportColumn = "AbsoluteTopImpressionSharePercent"
+	ShareOfVoiceReportColumnTopImpressionSharePercent         ShareOfVoiceReportColumn = "TopImpressionSharePercent"
+	ShareOfVoiceReportColumnClickSharePercent                 ShareOfVoiceReportColumn = "ClickSharePercent"
+)
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ReportingAPI 定义Reporting API的操作
+type ReportingAPI interface {
+	// ReportingService 返回报告服务
+	ReportingService() ReportingService
+}
+
+// ReportingService 定义报告相关的操作
+type ReportingService interface {
+	// SubmitGenerateReport 提交报告请求，返回报告请求 ID
+	SubmitGenerateReport(request ReportRequest) (string, error)
+
+	// PollGenerateReport 查询报告的生成状态
+	PollGenerateReport(reportRequestId string) (*ReportRequestStatus, error)
+
+	// WaitForReport 按 interval 轮询直到报告生成完成、失败或 ctx 结束
+	WaitForReport(ctx context.Context, reportRequestId string, interval time.Duration) (*ReportRequestStatus, error)
+
+	// DownloadReport 下载并解压报告，返回 CSV 内容，调用方负责关闭
+	DownloadReport(ctx context.Context, downloadURL string) (io.ReadCloser, error)
+}
@@ -0,0 +1,255 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/vancevox/bingads-go/common"
+)
+
+// ReportRequest 表示可以提交的报告请求，具体类型通过 i:type 区分
+type ReportRequest interface {
+	// ReportRequestType 返回报告请求的 i:type 名称
+	ReportRequestType() string
+
+	isReportRequest()
+}
+
+// ReportRequestBase 包含所有报告请求共有的字段
+type ReportRequestBase struct {
+	ExcludeColumnHeaders   bool         `xml:"ExcludeColumnHeaders"`
+	ExcludeReportFooter    bool         `xml:"ExcludeReportFooter"`
+	ExcludeReportHeader    bool         `xml:"ExcludeReportHeader"`
+	Format                 ReportFormat `xml:"Format"`
+	FormatVersion          string       `xml:"FormatVersion,omitempty"`
+	ReportName             string       `xml:"ReportName,omitempty"`
+	ReturnOnlyCompleteData bool         `xml:"ReturnOnlyCompleteData"`
+}
+
+// Date 表示报告使用的日期
+type Date struct {
+	Day   int `xml:"Day"`
+	Month int `xml:"Month"`
+	Year  int `xml:"Year"`
+}
+
+// NewDate 根据 time.Time 创建日期
+func NewDate(t time.Time) *Date {
+	return &Date{Day: t.Day(), Month: int(t.Month()), Year: t.Year()}
+}
+
+// ReportTime 表示报告的时间范围，PredefinedTime 与自定义日期范围二选一
+type ReportTime struct {
+	CustomDateRangeEnd   *Date            `xml:"CustomDateRangeEnd,omitempty"`
+	CustomDateRangeStart *Date            `xml:"CustomDateRangeStart,omitempty"`
+	PredefinedTime       ReportTimePeriod `xml:"PredefinedTime,omitempty"`
+	ReportTimeZone       string           `xml:"ReportTimeZone,omitempty"`
+}
+
+// CampaignReportScope 表示报告范围内的活动
+type CampaignReportScope struct {
+	AccountId  int64 `xml:"AccountId"`
+	CampaignId int64 `xml:"CampaignId"`
+}
+
+// AdGroupReportScope 表示报告范围内的广告组
+type AdGroupReportScope struct {
+	AccountId  int64 `xml:"AccountId"`
+	AdGroupId  int64 `xml:"AdGroupId"`
+	CampaignId int64 `xml:"CampaignId"`
+}
+
+// CampaignReportScopes 表示活动范围列表，为空时不输出元素
+type CampaignReportScopes []CampaignReportScope
+
+// MarshalXML 自定义 CampaignReportScopes 的 XML 序列化
+func (s CampaignReportScopes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeList(e, start, "CampaignReportScope", s)
+}
+
+// AdGroupReportScopes 表示广告组范围列表，为空时不输出元素
+type AdGroupReportScopes []AdGroupReportScope
+
+// MarshalXML 自定义 AdGroupReportScopes 的 XML 序列化
+func (s AdGroupReportScopes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeList(e, start, "AdGroupReportScope", s)
+}
+
+// encodeList 将 items 编码为 start 元素下的 itemName 子元素，列表为空时不输出任何内容
+func encodeList[T any](e *xml.Encoder, start xml.StartElement, itemName string, items []T) error {
+	if len(items) == 0 {
+		return nil
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := e.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: itemName}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// AccountThroughCampaignReportScope 表示账户到活动级别的报告范围
+type AccountThroughCampaignReportScope struct {
+	AccountIds common.LongArray     `xml:"AccountIds"`
+	Campaigns  CampaignReportScopes `xml:"Campaigns"`
+}
+
+// AccountThroughAdGroupReportScope 表示账户到广告组级别的报告范围
+type AccountThroughAdGroupReportScope struct {
+	AccountIds common.LongArray     `xml:"AccountIds"`
+	AdGroups   AdGroupReportScopes  `xml:"AdGroups"`
+	Campaigns  CampaignReportScopes `xml:"Campaigns"`
+}
+
+// CampaignPerformanceReportRequest 表示活动效果报告请求
+type CampaignPerformanceReportRequest struct {
+	ReportRequestBase
+	Aggregation ReportAggregation                 `xml:"Aggregation"`
+	Columns     []CampaignPerformanceReportColumn `xml:"Columns>CampaignPerformanceReportColumn"`
+	Scope       AccountThroughCampaignReportScope `xml:"Scope"`
+	Time        ReportTime                        `xml:"Time"`
+}
+
+// AdGroupPerformanceReportRequest 表示广告组效果报告请求
+type AdGroupPerformanceReportRequest struct {
+	ReportRequestBase
+	Aggregation ReportAggregation                `xml:"Aggregation"`
+	Columns     []AdGroupPerformanceReportColumn `xml:"Columns>AdGroupPerformanceReportColumn"`
+	Scope       AccountThroughAdGroupReportScope `xml:"Scope"`
+	Time        ReportTime                       `xml:"Time"`
+}
+
+// KeywordPerformanceReportRequest 表示关键词效果报告请求
+type KeywordPerformanceReportRequest struct {
+	ReportRequestBase
+	Aggregation ReportAggregation                `xml:"Aggregation"`
+	Columns     []KeywordPerformanceReportColumn `xml:"Columns>KeywordPerformanceReportColumn"`
+	Scope       AccountThroughAdGroupReportScope `xml:"Scope"`
+	Time        ReportTime                       `xml:"Time"`
+}
+
+// SearchQueryPerformanceReportRequest 表示搜索词效果报告请求
+type SearchQueryPerformanceReportRequest struct {
+	ReportRequestBase
+	Aggregation ReportAggregation                    `xml:"Aggregation"`
+	Columns     []SearchQueryPerformanceReportColumn `xml:"Columns>SearchQueryPerformanceReportColumn"`
+	Scope       AccountThroughAdGroupReportScope     `xml:"Scope"`
+	Time        ReportTime                           `xml:"Time"`
+}
+
+// ShareOfVoiceReportRequest 表示展示份额报告请求
+type ShareOfVoiceReportRequest struct {
+	ReportRequestBase
+	Aggregation ReportAggregation                `xml:"Aggregation"`
+	Columns     []ShareOfVoiceReportColumn       `xml:"Columns>ShareOfVoiceReportColumn"`
+	Scope       AccountThroughAdGroupReportScope `xml:"Scope"`
+	Time        ReportTime                       `xml:"Time"`
+}
+
+// ReportRequestType 实现 ReportRequest 接口
+func (CampaignPerformanceReportRequest) ReportRequestType() string {
+	return "CampaignPerformanceReportRequest"
+}
+
+// ReportRequestType 实现 ReportRequest 接口
+func (AdGroupPerformanceReportRequest) ReportRequestType() string {
+	return "AdGroupPerformanceReportRequest"
+}
+
+// ReportRequestType 实现 ReportRequest 接口
+func (KeywordPerformanceReportRequest) ReportRequestType() string {
+	return "KeywordPerformanceReportRequest"
+}
+
+// ReportRequestType 实现 ReportRequest 接口
+func (SearchQueryPerformanceReportRequest) ReportRequestType() string {
+	return "SearchQueryPerformanceReportRequest"
+}
+
+// ReportRequestType 实现 ReportRequest 接口
+func (ShareOfVoiceReportRequest) ReportRequestType() string {
+	return "ShareOfVoiceReportRequest"
+}
+
+func (CampaignPerformanceReportRequest) isReportRequest()    {}
+func (AdGroupPerformanceReportRequest) isReportRequest()     {}
+func (KeywordPerformanceReportRequest) isReportRequest()     {}
+func (SearchQueryPerformanceReportRequest) isReportRequest() {}
+func (ShareOfVoiceReportRequest) isReportRequest()           {}
+
+// MarshalXML 自定义 CampaignPerformanceReportRequest 的 XML 序列化
+func (r CampaignPerformanceReportRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type request CampaignPerformanceReportRequest
+	return encodeReportRequest(e, start, r.ReportRequestType(), request(r))
+}
+
+// MarshalXML 自定义 AdGroupPerformanceReportRequest 的 XML 序列化
+func (r AdGroupPerformanceReportRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type request AdGroupPerformanceReportRequest
+	return encodeReportRequest(e, start, r.ReportRequestType(), request(r))
+}
+
+// MarshalXML 自定义 KeywordPerformanceReportRequest 的 XML 序列化
+func (r KeywordPerformanceReportRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type request KeywordPerformanceReportRequest
+	return encodeReportRequest(e, start, r.ReportRequestType(), request(r))
+}
+
+// MarshalXML 自定义 SearchQueryPerformanceReportRequest 的 XML 序列化
+func (r SearchQueryPerformanceReportRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type request SearchQueryPerformanceReportRequest
+	return encodeReportRequest(e, start, r.ReportRequestType(), request(r))
+}
+
+// MarshalXML 自定义 ShareOfVoiceReportRequest 的 XML 序列化
+func (r ShareOfVoiceReportRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type request ShareOfVoiceReportRequest
+	return encodeReportRequest(e, start, r.ReportRequestType(), request(r))
+}
+
+// encodeReportRequest 编码报告请求并加上 i:type 属性
+//
+// Reporting 服务的实体与消息位于同一命名空间，因此类型名称不需要前缀。
+func encodeReportRequest(e *xml.Encoder, start xml.StartElement, typeName string, v any) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "i:type"}, Value: typeName})
+	return e.EncodeElement(v, start)
+}
+
+// ReportRequestStatus 表示报告的生成状态
+//
+// 报告生成成功但没有数据时 ReportDownloadUrl 为空。
+type ReportRequestStatus struct {
+	ReportDownloadUrl string                  `xml:"ReportDownloadUrl"`
+	Status            ReportRequestStatusType `xml:"Status"`
+}
+
+// SubmitGenerateReportRequest 请求结构体
+type SubmitGenerateReportRequest struct {
+	XMLName       xml.Name      `xml:"SubmitGenerateReportRequest"`
+	Namespace     string        `xml:"xmlns,attr"`
+	ReportRequest ReportRequest `xml:"ReportRequest"`
+}
+
+// SubmitGenerateReportResponse 响应结构体
+type SubmitGenerateReportResponse struct {
+	XMLName         xml.Name `xml:"SubmitGenerateReportResponse"`
+	Namespace       string   `xml:"xmlns,attr"`
+	ReportRequestId string   `xml:"ReportRequestId"`
+}
+
+// PollGenerateReportRequest 请求结构体
+type PollGenerateReportRequest struct {
+	XMLName         xml.Name `xml:"PollGenerateReportRequest"`
+	Namespace       string   `xml:"xmlns,attr"`
+	ReportRequestId string   `xml:"ReportRequestId"`
+}
+
+// PollGenerateReportResponse 响应结构体
+type PollGenerateReportResponse struct {
+	XMLName             xml.Name            `xml:"PollGenerateReportResponse"`
+	Namespace           string              `xml:"xmlns,attr"`
+	ReportRequestStatus ReportRequestStatus `xml:"ReportRequestStatus"`
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/reporting/models"
+)
+
+// Client 实现 ReportingAPI 接口
+type Client struct {
+	Config     *config.Config
+	HTTPClient *common.HTTPClient
+	XMLHelper  *common.XMLHelper
+
+	// 下载报告文件使用的 HTTP 客户端，下载地址是预签名的存储地址，不经过 API 限流
+	//
+	// 报告文件可能很大，默认不设置超时，由调用方通过 context 控制。
+	DownloadClient *http.Client
+}
+
+// NewClient 创建一个新的 Reporting API 客户端
+func NewClient(cfg *config.Config) *Client {
+	return &Client{
+		Config:         cfg,
+		HTTPClient:     common.NewHTTPClient(cfg),
+		XMLHelper:      common.NewXMLHelper(),
+		DownloadClient: &http.Client{},
+	}
+}
+
+// ReportingService 返回报告服务
+func (c *Client) ReportingService() models.ReportingService {
+	return NewReportingService(c)
+}
+
+// 创建 SOAP 请求头
+func (c *Client) createRequestHeader(action models.SOAPAction, mustUnderstand string) base.RequestHeader {
+	return common.NewRequestHeader(c.Config, config.ReportingNamespace, string(action), mustUnderstand)
+}
+
+// 创建 SOAP 信封
+func (c *Client) createEnvelope(action models.SOAPAction, mustUnderstand string) base.Envelope {
+	return common.NewEnvelope(c.createRequestHeader(action, mustUnderstand))
+}
+
+// 发送请求
+func (c *Client) sendRequest(envelope base.Envelope, action models.SOAPAction) ([]byte, error) {
+	// 序列化请求
+	reqBody, err := c.XMLHelper.Marshal(envelope)
+	if err != nil {
+		return nil, base.NewError(base.ErrSerializationFail, "序列化请求失败", err)
+	}
+
+	// 添加 XML 声明
+	reqBody = append([]byte(xml.Header), reqBody...)
+
+	if c.Config.API.Debug {
+		fmt.Println("请求体:", string(reqBody))
+	}
+	// 发送请求
+	respBody, err := c.HTTPClient.Post(c.Config.API.GetEndpoint(config.ServiceReporting), string(action), reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+// 处理响应
+func (c *Client) processResponse(respBody []byte, respObj any) error {
+	// 打印原始响应内容，用于调试
+	if c.Config.API.Debug {
+		fmt.Println("原始响应:", string(respBody))
+	}
+
+	// 先解析为通用结构，检查是否有错误
+	var genericResp models.ReportingResponseEnvelope
+	if err := c.XMLHelper.Unmarshal(respBody, &genericResp); err != nil {
+		return base.NewError(base.ErrDeserializationFail, "反序列化响应失败", err)
+	}
+
+	// 检查是否有SOAP故障
+	if genericResp.Body.Fault != nil {
+		return common.FaultError(genericResp.Body.Fault, genericResp.Header.TrackingId)
+	}
+
+	// 尝试将响应直接解析到目标对象
+	if err := c.XMLHelper.Unmarshal(respBody, respObj); err != nil {
+		return base.NewError(base.ErrDeserializationFail, fmt.Sprintf("反序列化响应对象失败: %v", err), nil)
+	}
+
+	return nil
+}
+
+// call 发送请求并解析响应
+func (c *Client) call(action models.SOAPAction, body *models.ReportingBody) (*models.ReportingResponseBody, error) {
+	// 创建信封
+	envelope := c.createEnvelope(action, "1")
+	envelope.Body = body
+
+	// 发送请求
+	respBody, err := c.sendRequest(envelope, action)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析响应
+	var response models.ReportingResponseEnvelope
+	if err := c.processResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Body, nil
+}
+
+// missingResponse 返回响应中缺少预期元素时的错误
+func missingResponse(action models.SOAPAction) error {
+	return base.NewError(base.ErrInvalidResponse, fmt.Sprintf("响应中缺少 %sResponse", action), nil)
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+// DownloadReport 下载报告压缩包并返回其中第一个文件的内容，调用方负责关闭
+//
+// 报告可能有数 GB，压缩包先写入临时文件再解压，不会整体读入内存，关闭时删除临时文件。
+// 报告没有数据时 PollGenerateReport 返回的下载地址为空，此时返回 INVALID_INPUT。
+func DownloadReport(ctx context.Context, client *http.Client, downloadURL string) (io.ReadCloser, error) {
+	if downloadURL == "" {
+		return nil, base.NewError(base.ErrInvalidInput, "报告下载地址为空，报告可能没有数据", nil)
+	}
+	return common.DownloadZip(ctx, client, downloadURL)
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/vancevox/bingads-go/reporting/models"
+)
+
+// 报告中日期列可能使用的格式，小时聚合时 TimePeriod 形如 2026-01-02|13
+var reportDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02|15",
+	"1/2/2006",
+	"1/2/2006 15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(time.Time{})
+)
+
+// ReportParseError 表示报告中某个单元格无法解析
+type ReportParseError struct {
+	// 行号，从 1 开始，包含报告头
+	Line int
+
+	// 列名
+	Column string
+
+	// 原始值
+	Value string
+
+	Err error
+}
+
+// Error 实现 error 接口
+func (e *ReportParseError) Error() string {
+	return fmt.Sprintf("报告第 %d 行 %s 列的值 %q 无法解析: %v", e.Line, e.Column, e.Value, e.Err)
+}
+
+// Unwrap 返回底层错误
+func (e *ReportParseError) Unwrap() error {
+	return e.Err
+}
+
+// ReportReader 以流的方式逐行读取报告 CSV，并按列名映射到 T 的字段
+//
+// 报告头（Report Name 等说明行）和版权页脚会被跳过，整个报告不会被读入内存。
+// 字段通过 `csv:"列名"` 标签或字段名与列名匹配（不区分大小写），`csv:"-"` 表示忽略该字段，
+// 没有对应字段的列会被忽略。支持的字段类型：
+//   - string 及以 string 为底层类型的枚举
+//   - 整数和浮点数，可以带千分位、货币符号和百分号，例如 "1,234.56"、"$3.20"、"4.56%"（解析为 4.56）
+//   - time.Time，支持 2006-01-02、2006-01-02|15（小时）和 1/2/2006 等格式
+//   - 以上类型的指针，空值或 "--" 时为 nil
+//   - 实现 encoding.TextUnmarshaler 的类型
+//
+// 用法：
+//
+//	reader, err := NewReportReader[Row](csvFile, models.ReportFormatCsv)
+//	for reader.Next() {
+//		row := reader.Row()
+//	}
+//	if err := reader.Err(); err != nil { ... }
+type ReportReader[T any] struct {
+	csv     *csv.Reader
+	columns []string
+	fields  [][]int
+	line    int
+	row     T
+	err     error
+	done    bool
+}
+
+// NewReportReader 创建报告读取器，读取并跳过报告头，format 为空时按 CSV 处理
+func NewReportReader[T any](r io.Reader, format models.ReportFormat) (*ReportReader[T], error) {
+	rowType := reflect.TypeOf((*T)(nil)).Elem()
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("报告行类型必须是结构体: %s", rowType)
+	}
+
+	reader := csv.NewReader(skipBOM(r))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+	if format == models.ReportFormatTsv {
+		reader.Comma = '\t'
+	}
+
+	rr := &ReportReader[T]{csv: reader}
+	if err := rr.readHeader(rowType); err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
+
+// Columns 返回报告的列名
+func (r *ReportReader[T]) Columns() []string {
+	return r.columns
+}
+
+// Next 读取下一行，没有更多数据或出错时返回 false
+func (r *ReportReader[T]) Next() bool {
+	if r.done {
+		return false
+	}
+
+	record, err := r.read()
+	if err != nil {
+		r.done = true
+		if err != io.EOF {
+			r.err = err
+		}
+		return false
+	}
+
+	// 版权页脚或空行表示数据结束
+	if isReportFooter(record) {
+		r.done = true
+		return false
+	}
+
+	var row T
+	value := reflect.ValueOf(&row).Elem()
+	for i, cell := range record {
+		if i >= len(r.fields) || r.fields[i] == nil {
+			continue
+		}
+		if err := setReportValue(value.FieldByIndex(r.fields[i]), cell); err != nil {
+			r.done = true
+			r.err = &ReportParseError{Line: r.line, Column: r.columns[i], Value: cell, Err: err}
+			return false
+		}
+	}
+	r.row = row
+	return true
+}
+
+// Row 返回当前行
+func (r *ReportReader[T]) Row() T {
+	return r.row
+}
+
+// Err 返回读取过程中遇到的错误
+func (r *ReportReader[T]) Err() error {
+	return r.err
+}
+
+// read 读取一条记录并更新行号
+func (r *ReportReader[T]) read() ([]string, error) {
+	record, err := r.csv.Read()
+	if err != nil {
+		return nil, err
+	}
+	r.line, _ = r.csv.FieldPos(0)
+	return record, nil
+}
+
+// readHeader 跳过报告头并读取列名
+func (r *ReportReader[T]) readHeader(rowType reflect.Type) error {
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			return fmt.Errorf("报告中没有列名")
+		}
+		if err != nil {
+			return err
+		}
+		if isReportPreamble(record) {
+			continue
+		}
+
+		r.columns = append([]string(nil), record...)
+		break
+	}
+
+	fieldsByName := reportFields(rowType)
+	r.fields = make([][]int, len(r.columns))
+	for i, column := range r.columns {
+		r.fields[i] = fieldsByName[strings.ToLower(strings.TrimSpace(column))]
+	}
+	return nil
+}
+
+// isReportPreamble 判断记录是否属于报告头，例如 "Report Name: xxx" 或空行
+func isReportPreamble(record []string) bool {
+	if len(record) != 1 {
+		return false
+	}
+	cell := strings.TrimSpace(record[0])
+	return cell == "" || strings.Contains(cell, ":")
+}
+
+// isReportFooter 判断记录是否属于报告页脚，例如 "©2026 Microsoft Corporation. All rights reserved."
+func isReportFooter(record []string) bool {
+	if len(record) != 1 {
+		return false
+	}
+	cell := strings.TrimSpace(record[0])
+	return cell == "" || strings.HasPrefix(cell, "©") || strings.Contains(cell, "Microsoft Corporation")
+}
+
+// reportFields 返回小写列名到字段索引的映射，包括嵌入结构体中的字段
+func reportFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int)
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		fields[strings.ToLower(name)] = field.Index
+	}
+	return fields
+}
+
+// setReportValue 将单元格的值解析到字段
+func setReportValue(field reflect.Value, cell string) error {
+	cell = strings.TrimSpace(cell)
+
+	if field.Kind() == reflect.Pointer {
+		if cell == "" || cell == "--" {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		value := reflect.New(field.Type().Elem())
+		if err := setReportValue(value.Elem(), cell); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	if field.Type() == timeType {
+		if cell == "" || cell == "--" {
+			return nil
+		}
+		t, err := parseReportDate(cell)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(cell))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := normalizeReportNumber(cell)
+		if !ok {
+			return nil
+		}
+		v, err := strconv.ParseInt(number, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := normalizeReportNumber(cell)
+		if !ok {
+			return nil
+		}
+		v, err := strconv.ParseUint(number, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		number, ok := normalizeReportNumber(cell)
+		if !ok {
+			return nil
+		}
+		v, err := strconv.ParseFloat(number, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(v)
+	case reflect.Bool:
+		if cell == "" {
+			return nil
+		}
+		v, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		field.SetBool(v)
+	default:
+		return fmt.Errorf("不支持的字段类型 %s", field.Type())
+	}
+	return nil
+}
+
+// normalizeReportNumber 去掉千分位、货币符号和百分号，空值或 "--" 时返回 false
+func normalizeReportNumber(cell string) (string, bool) {
+	if cell == "" || cell == "--" {
+		return "", false
+	}
+
+	cell = strings.TrimSuffix(cell, "%")
+	cell = strings.ReplaceAll(cell, ",", "")
+
+	// 去掉数字前面的货币符号或代码，保留负号
+	negative := strings.HasPrefix(cell, "-")
+	cell = strings.TrimLeftFunc(cell, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	cell = strings.TrimSpace(cell)
+	if negative {
+		cell = "-" + cell
+	}
+	return cell, true
+}
+
+// parseReportDate 按报告可能使用的格式解析日期
+func parseReportDate(cell string) (time.Time, error) {
+	for _, layout := range reportDateLayouts {
+		if t, err := time.Parse(layout, cell); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无法识别的日期格式")
+}
+
+// skipBOM 跳过 UTF-8 BOM
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && string(bom) == "\xef\xbb\xbf" {
+		br.Discard(3)
+	}
+	return br
+}
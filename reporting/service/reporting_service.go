@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/reporting/models"
+)
+
+// ReportingService 实现报告服务
+type ReportingService struct {
+	client *Client
+}
+
+// NewReportingService 创建一个新的报告服务
+func NewReportingService(client *Client) *ReportingService {
+	return &ReportingService{
+		client: client,
+	}
+}
+
+// SubmitGenerateReport 提交报告请求，返回报告请求 ID
+func (s *ReportingService) SubmitGenerateReport(reportRequest models.ReportRequest) (string, error) {
+	if reportRequest == nil {
+		return "", base.NewError(base.ErrInvalidInput, "报告请求不能为空", nil)
+	}
+
+	// 创建请求
+	request := models.SubmitGenerateReportRequest{
+		Namespace:     config.ReportingNamespace,
+		ReportRequest: reportRequest,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionSubmitGenerateReport, &models.ReportingBody{
+		SubmitGenerateReportRequest: &request,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if body.SubmitGenerateReportResponse == nil {
+		return "", missingResponse(models.SOAPActionSubmitGenerateReport)
+	}
+	return body.SubmitGenerateReportResponse.ReportRequestId, nil
+}
+
+// PollGenerateReport 查询报告的生成状态
+func (s *ReportingService) PollGenerateReport(reportRequestId string) (*models.ReportRequestStatus, error) {
+	// 创建请求
+	request := models.PollGenerateReportRequest{
+		Namespace:       config.ReportingNamespace,
+		ReportRequestId: reportRequestId,
+	}
+
+	// 发送请求并解析响应
+	body, err := s.client.call(models.SOAPActionPollGenerateReport, &models.ReportingBody{
+		PollGenerateReportRequest: &request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if body.PollGenerateReportResponse == nil {
+		return nil, missingResponse(models.SOAPActionPollGenerateReport)
+	}
+	return &body.PollGenerateReportResponse.ReportRequestStatus, nil
+}
+
+// DefaultPollInterval 为 WaitForReport 的 interval 为 0 时使用的轮询间隔
+const DefaultPollInterval = 5 * time.Second
+
+// WaitForReport 按 interval 轮询直到报告生成完成、失败或 ctx 结束
+//
+// interval 为 0 时使用 DefaultPollInterval，为负数时返回 INVALID_INPUT。报告生成失败时返回 API_ERROR。
+func (s *ReportingService) WaitForReport(ctx context.Context, reportRequestId string, interval time.Duration) (*models.ReportRequestStatus, error) {
+	if interval < 0 {
+		return nil, base.NewError(base.ErrInvalidInput, fmt.Sprintf("轮询间隔不能为负数: %v", interval), nil)
+	}
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := s.PollGenerateReport(reportRequestId)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status.Status {
+		case models.ReportRequestStatusSuccess:
+			return status, nil
+		case models.ReportRequestStatusError:
+			return status, base.NewError(base.ErrAPIError, fmt.Sprintf("报告生成失败: %s", reportRequestId), nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DownloadReport 下载并解压报告，返回 CSV 内容，调用方负责关闭
+func (s *ReportingService) DownloadReport(ctx context.Context, downloadURL string) (io.ReadCloser, error) {
+	return DownloadReport(ctx, s.client.DownloadClient, downloadURL)
+}
@@ -0,0 +1,107 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestAddAdExtensionsEncodesTypes(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddAdExtensionsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<AdExtensionIdentities>
+				<AdExtensionIdentity><Id>301</Id><Version>1</Version></AdExtensionIdentity>
+				<AdExtensionIdentity><Id>0</Id><Version>0</Version></AdExtensionIdentity>
+			</AdExtensionIdentities>
+			<NestedPartialErrors>
+				<BatchErrorCollection>
+					<BatchErrors><BatchError><Code>3800</Code><ErrorCode>CalloutTextTooLong</ErrorCode><FieldPath>Text</FieldPath><Index>0</Index></BatchError></BatchErrors>
+					<Code>0</Code><Index>1</Index>
+				</BatchErrorCollection>
+			</NestedPartialErrors>
+		</AddAdExtensionsResponse>`)
+	defer closeServer()
+
+	identities, nestedErrors, err := client.AdExtensionService().AddAdExtensions(1001, []models.AdExtension{
+		models.SitelinkAdExtension{
+			DisplayText: base.String("新品上市"),
+			FinalUrls:   []string{"https://example.com/new"},
+		},
+		models.CalloutAdExtension{Text: base.String("全场包邮")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<AccountId>1001</AccountId><AdExtensions><AdExtension i:type="SitelinkAdExtension"><DisplayText>新品上市</DisplayText><FinalUrls xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:string>https://example.com/new</a1:string></FinalUrls></AdExtension>`,
+		`<AdExtension i:type="CalloutAdExtension"><Text>全场包邮</Text></AdExtension>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+	if len(identities) != 2 || identities[0].Id != 301 || identities[0].Version != 1 {
+		t.Errorf("附加信息 ID 解析不正确: %+v", identities)
+	}
+	if len(nestedErrors) != 1 || nestedErrors[0].Index != 1 || len(nestedErrors[0].BatchErrors) != 1 || nestedErrors[0].BatchErrors[0].ErrorCode != "CalloutTextTooLong" {
+		t.Errorf("嵌套错误解析不正确: %+v", nestedErrors)
+	}
+}
+
+func TestGetAdExtensionsAssociationsDecodesExtensions(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetAdExtensionsAssociationsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<AdExtensionAssociationCollection>
+				<AdExtensionAssociationCollection><AdExtensionAssociations>
+					<AdExtensionAssociation>
+						<AdExtension i:type="StructuredSnippetAdExtension"><Id>302</Id><Header>Brands</Header><Values xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:string>A</a:string><a:string>B</a:string></Values></AdExtension>
+						<AssociationType>Campaign</AssociationType><EditorialStatus>Active</EditorialStatus><EntityId>5001</EntityId>
+					</AdExtensionAssociation>
+				</AdExtensionAssociations></AdExtensionAssociationCollection>
+				<AdExtensionAssociationCollection i:nil="true"/>
+			</AdExtensionAssociationCollection>
+		</GetAdExtensionsAssociationsResponse>`)
+	defer closeServer()
+
+	collections, _, err := client.AdExtensionService().GetAdExtensionsAssociations(1001, models.AssociationTypeCampaign, []int64{5001, 5002}, models.AdExtensionTypeStructuredSnippet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<AdExtensionType>StructuredSnippetAdExtension</AdExtensionType><AssociationType>Campaign</AssociationType><EntityIds`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(collections) != 2 || len(collections[0].AdExtensionAssociations) != 1 {
+		t.Fatalf("关联集合解析不正确: %+v", collections)
+	}
+	association := collections[0].AdExtensionAssociations[0]
+	snippet, ok := association.AdExtension.(models.StructuredSnippetAdExtension)
+	if !ok || snippet.Header.Value() != "Brands" || len(snippet.Values) != 2 || association.EntityId != 5001 || association.EditorialStatus != models.AdExtensionEditorialStatusActive {
+		t.Errorf("附加信息关联解析不正确: %+v", association)
+	}
+}
+
+func TestAddMediaEncodesBase64(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddMediaResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<MediaIds xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:long>401</a:long></MediaIds>
+		</AddMediaResponse>`)
+	defer closeServer()
+
+	ids, err := client.MediaService().AddMedia(1001, []models.Image{{MediaType: "Image191x100", Data: []byte("png")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<Media><Media i:type="Image"><MediaType>Image191x100</MediaType><Data>cG5n</Data></Media></Media>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(ids) != 1 || ids[0] != 401 {
+		t.Errorf("媒体 ID 解析不正确: %v", ids)
+	}
+}
@@ -0,0 +1,137 @@
+package unit
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/adInsight/models"
+	"github.com/vancevox/bingads-go/adInsight/service"
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// newAdInsightServer 创建返回固定响应的测试服务器，并记录收到的请求体
+func newAdInsightServer(t *testing.T, response string) (*service.Client, *string, func()) {
+	t.Helper()
+	url, request, closeServer := newSOAPServer(t, response)
+	return service.NewClient(newTestConfig(config.ServiceAdInsight, url)), &request.Body, closeServer
+}
+
+func TestGetKeywordIdeas(t *testing.T) {
+	client, body, closeServer := newAdInsightServer(t, `<GetKeywordIdeasResponse xmlns="https://bingads.microsoft.com/AdInsight/v13">
+		<KeywordIdeaCategories><KeywordIdeaCategory><CategoryId>10</CategoryId><CategoryName>Shoes</CategoryName></KeywordIdeaCategory></KeywordIdeaCategories>
+		<KeywordIdeas><KeywordIdea>
+			<AdGroupId i:nil="true" xmlns:i="http://www.w3.org/2001/XMLSchema-instance"/>
+			<AdGroupName>Seed</AdGroupName>
+			<Competition>High</Competition>
+			<Keyword>running shoes</Keyword>
+			<MonthlySearchCounts xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:long>100</a:long><a:long>120</a:long></MonthlySearchCounts>
+			<SuggestedBid>1.25</SuggestedBid>
+		</KeywordIdea></KeywordIdeas>
+	</GetKeywordIdeasResponse>`)
+	defer closeServer()
+
+	ideas, categories, err := client.AdInsightService().GetKeywordIdeas([]models.SearchParameter{
+		models.QuerySearchParameter{Queries: []string{"shoes"}},
+		models.LanguageSearchParameter{Languages: []models.LanguageCriterion{{Language: "English"}}},
+		models.DateRangeSearchParameter{StartDate: &models.DayMonthAndYear{Day: 1, Month: 1, Year: 2026}},
+	}, []models.KeywordIdeaAttribute{models.KeywordIdeaAttributeCompetition}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<SearchParameter i:type="QuerySearchParameter"><Queries xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:string>shoes</a1:string></Queries></SearchParameter>`,
+		`<SearchParameter i:type="LanguageSearchParameter"><Languages><LanguageCriterion><Language>English</Language></LanguageCriterion></Languages></SearchParameter>`,
+		`<SearchParameter i:type="DateRangeSearchParameter"><StartDate><Day>1</Day><Month>1</Month><Year>2026</Year></StartDate></SearchParameter>`,
+		`<IdeaAttributes><KeywordIdeaAttribute>Competition</KeywordIdeaAttribute></IdeaAttributes>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+
+	if len(categories) != 1 || categories[0].CategoryName != "Shoes" {
+		t.Errorf("分类解析不正确: %+v", categories)
+	}
+	if len(ideas) != 1 {
+		t.Fatalf("期望 1 条建议，实际 %d 条", len(ideas))
+	}
+	idea := ideas[0]
+	if idea.Keyword != "running shoes" || idea.Competition.Value() != models.CompetitionLevelHigh || idea.SuggestedBid.Value() != 1.25 {
+		t.Errorf("建议解析不正确: %+v", idea)
+	}
+	if !idea.AdGroupId.IsNull() {
+		t.Errorf("AdGroupId 应为 nil")
+	}
+	if len(idea.MonthlySearchCounts) != 2 || idea.MonthlySearchCounts[1] != 120 {
+		t.Errorf("MonthlySearchCounts 解析不正确: %v", idea.MonthlySearchCounts)
+	}
+}
+
+func TestGetKeywordTrafficEstimates(t *testing.T) {
+	client, body, closeServer := newAdInsightServer(t, `<GetKeywordTrafficEstimatesResponse xmlns="https://bingads.microsoft.com/AdInsight/v13">
+		<CampaignEstimates><CampaignEstimate><AdGroupEstimates><AdGroupEstimate><KeywordEstimates><KeywordEstimate>
+			<Keyword><MatchType>Exact</MatchType><Text>shoes</Text></Keyword>
+			<Maximum><Clicks>20.5</Clicks><Impressions>900</Impressions></Maximum>
+			<Minimum><Clicks>10</Clicks><Impressions>400</Impressions></Minimum>
+		</KeywordEstimate></KeywordEstimates></AdGroupEstimate></AdGroupEstimates></CampaignEstimate></CampaignEstimates>
+	</GetKeywordTrafficEstimatesResponse>`)
+	defer closeServer()
+
+	estimates, err := client.AdInsightService().GetKeywordTrafficEstimates([]models.CampaignEstimator{{
+		AdGroupEstimators: []models.AdGroupEstimator{{
+			KeywordEstimators: []models.KeywordEstimator{{
+				Keyword: models.Keyword{MatchType: models.MatchTypeExact, Text: "shoes"},
+				MaxCpc:  base.NewNillable(1.5),
+			}},
+		}},
+		Criteria: models.Criteria{models.LocationCriterion{LocationId: 190}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<Criteria><Criterion i:type="LocationCriterion"><LocationId>190</LocationId></Criterion></Criteria>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if strings.Contains(*body, "<NegativeKeywords>") {
+		t.Errorf("没有否定关键词时不应输出 NegativeKeywords:\n%s", *body)
+	}
+
+	keywordEstimate := estimates[0].AdGroupEstimates[0].KeywordEstimates[0]
+	if keywordEstimate.Maximum.Clicks != 20.5 || keywordEstimate.Minimum.Impressions != 400 {
+		t.Errorf("估算结果解析不正确: %+v", keywordEstimate)
+	}
+}
+
+func TestGetBidLandscapeByKeywordIdsRequiresIds(t *testing.T) {
+	client, _, closeServer := newAdInsightServer(t, "")
+	defer closeServer()
+
+	_, err := client.AdInsightService().GetBidLandscapeByKeywordIds(nil, true)
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
+
+func TestGetKeywordIdeasRejectsNilParameter(t *testing.T) {
+	client, _, closeServer := newAdInsightServer(t, "")
+	defer closeServer()
+
+	_, _, err := client.AdInsightService().GetKeywordIdeas([]models.SearchParameter{models.QuerySearchParameter{Queries: []string{"shoes"}}, nil}, nil, false)
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+
+	var nilQuery *models.QuerySearchParameter
+	data, err := xml.Marshal(models.SearchParameters{nil, nilQuery, models.QuerySearchParameter{Queries: []string{"shoes"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(data), "<SearchParameter ") != 1 {
+		t.Errorf("nil 条件应当被跳过:\n%s", data)
+	}
+}
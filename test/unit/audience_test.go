@@ -0,0 +1,117 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestAddAudiencesEncodesTypes(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddAudiencesResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<AudienceIds xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:long>201</a:long><a:long>202</a:long></AudienceIds>
+			<PartialErrors/>
+		</AddAudiencesResponse>`)
+	defer closeServer()
+
+	ids, _, err := client.AudienceService().AddAudiences([]models.Audience{
+		models.RemarketingList{
+			AudienceBase: models.AudienceBase{Name: base.String("购物车访客"), MembershipDuration: base.Int(30), Scope: base.NewNillable(models.EntityScopeAccount)},
+			TagId:        base.Int64(9),
+			Rule: models.PageVisitorsRule{RuleItemGroups: []models.RuleItemGroup{{Items: []models.StringRuleItem{
+				{Operand: "Url", Operator: models.StringOperatorContains, Value: "/cart"},
+			}}}},
+		},
+		models.CombinedList{
+			AudienceBase:     models.AudienceBase{Name: base.String("组合")},
+			CombinationRules: []models.CombinationRule{{AudienceIds: []int64{1, 2}, Operator: models.LogicalOperatorOr}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<Audience i:type="RemarketingList"><MembershipDuration>30</MembershipDuration><Name>购物车访客</Name><Scope>Account</Scope><Rule i:type="PageVisitorsRule"><RuleItemGroups><RuleItemGroup><Items><RuleItem i:type="StringRuleItem"><Operand>Url</Operand><Operator>Contains</Operator><Value>/cart</Value></RuleItem></Items></RuleItemGroup></RuleItemGroups></Rule><TagId>9</TagId></Audience>`,
+		`<Audience i:type="CombinedList"><Name>组合</Name><CombinationRules><CombinationRule><AudienceIds xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:long>1</a1:long><a1:long>2</a1:long></AudienceIds><Operator>Or</Operator></CombinationRule></CombinationRules></Audience>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+	if len(ids) != 2 || ids[1] != 202 {
+		t.Errorf("受众 ID 解析不正确: %v", ids)
+	}
+}
+
+func TestGetAudiencesByIdsDecodesTypes(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetAudiencesByIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<Audiences>
+				<Audience i:type="RemarketingList"><Id>201</Id><Name>购物车访客</Name><Type>RemarketingList</Type>
+					<Rule i:type="CustomEventsRule"><Action>purchase</Action><ActionOperator>Equals</ActionOperator><Value>100</Value><ValueOperator>GreaterThan</ValueOperator></Rule>
+					<TagId>9</TagId></Audience>
+				<Audience i:type="SimilarRemarketingList"><Id>202</Id><SourceId>201</SourceId></Audience>
+				<Audience i:nil="true"/>
+				<Audience i:type="ImpressionBasedRemarketingList"><Id>203</Id></Audience>
+			</Audiences>
+			<PartialErrors><BatchError><Code>3503</Code><ErrorCode>AudienceIdDoNotExist</ErrorCode><Index>2</Index></BatchError></PartialErrors>
+		</GetAudiencesByIdsResponse>`)
+	defer closeServer()
+
+	audiences, partialErrors, err := client.AudienceService().GetAudiencesByIds([]int64{201, 202, 999, 203}, models.AudienceTypeRemarketingList, models.AudienceTypeSimilarRemarketingList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<Type>RemarketingList SimilarRemarketingList</Type>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(audiences) != 4 {
+		t.Fatalf("期望 4 个受众，实际 %d 个", len(audiences))
+	}
+
+	list, ok := audiences[0].(models.RemarketingList)
+	if !ok || list.Id.Value() != 201 || list.TagId.Value() != 9 {
+		t.Fatalf("再营销列表解析不正确: %+v", audiences[0])
+	}
+	rule, ok := list.Rule.(models.CustomEventsRule)
+	if !ok || rule.Action.Value() != "purchase" || rule.Value.Value() != 100 || rule.ValueOperator.Value() != models.NumberOperatorGreaterThan {
+		t.Errorf("再营销规则解析不正确: %+v", list.Rule)
+	}
+	if similar, ok := audiences[1].(models.SimilarRemarketingList); !ok || similar.SourceId.Value() != 201 {
+		t.Errorf("相似受众解析不正确: %+v", audiences[1])
+	}
+	if audiences[2] != nil {
+		t.Errorf("i:nil 受众应解析为 nil: %+v", audiences[2])
+	}
+	if unknown, ok := audiences[3].(models.UnknownAudience); !ok || unknown.TypeName != "ImpressionBasedRemarketingList" {
+		t.Errorf("未知类型受众解析不正确: %+v", audiences[3])
+	}
+	if len(partialErrors) != 1 || partialErrors[0].Index != 2 {
+		t.Errorf("部分错误解析不正确: %+v", partialErrors)
+	}
+}
+
+func TestApplyCustomerListItemsHashesEmails(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<ApplyCustomerListItemsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13"><PartialErrors/></ApplyCustomerListItemsResponse>`)
+	defer closeServer()
+
+	hashed := "86e0b9e56c17cc4d12387e1949b85053fbe73bc3ce5a1188713a9d300cc6133d"
+	_, err := client.AudienceService().ApplyCustomerListItems(301, models.CustomerListActionTypeAdd, []string{" Jane.Doe@Example.com ", strings.ToUpper(hashed)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<CustomerListItems><ActionType>Add</ActionType><CustomerListId>301</CustomerListId><CustomerListItemSubType>Email</CustomerListItemSubType><CustomerListItems xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:string>` + hashed + `</a1:string><a1:string>` + hashed + `</a1:string></CustomerListItems></CustomerListItems>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if strings.Contains(strings.ToLower(*body), "jane.doe") {
+		t.Errorf("请求体不应包含明文邮箱:\n%s", *body)
+	}
+}
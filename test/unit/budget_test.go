@@ -0,0 +1,150 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/campaignManagement/service"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// newCampaignManagementServer 返回固定响应的测试服务器，并记录最后一次请求的请求体
+func newCampaignManagementServer(t *testing.T, response string) (*service.Client, *string, func()) {
+	t.Helper()
+	url, request, closeServer := newSOAPServer(t, response)
+	return service.NewClient(newTestConfig(config.ServiceCampaignManagement, url)), &request.Body, closeServer
+}
+
+func TestAddBudgets(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddBudgetsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<BudgetIds xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+				<a:long>101</a:long><a:long i:nil="true"/>
+			</BudgetIds>
+			<PartialErrors><BatchError><Code>1</Code><ErrorCode>DuplicateBudgetName</ErrorCode><Index>1</Index></BatchError></PartialErrors>
+		</AddBudgetsResponse>`)
+	defer closeServer()
+
+	ids, partialErrors, err := client.BudgetService().AddBudgets([]models.Budget{
+		{Amount: base.NewNillable(50.0), BudgetType: base.NewNillable(models.BudgetLimitTypeDailyBudgetStandard), Name: base.String("shared")},
+		{Amount: base.NewNillable(20.0), Name: base.String("shared")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<AddBudgetsRequest xmlns="https://bingads.microsoft.com/CampaignManagement/v13"><Budgets><Budget><Amount>50</Amount><BudgetType>DailyBudgetStandard</BudgetType><Name>shared</Name></Budget>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(ids) != 2 || ids[0] != 101 || ids[1] != 0 {
+		t.Errorf("预算 ID 解析不正确: %v", ids)
+	}
+	if len(partialErrors) != 1 || partialErrors[0].Index != 1 || partialErrors[0].ErrorCode != "DuplicateBudgetName" {
+		t.Errorf("部分错误解析不正确: %+v", partialErrors)
+	}
+}
+
+func TestGetCampaignIdsByBudgetIds(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetCampaignIdsByBudgetIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<CampaignIdCollection>
+				<IdCollection><Ids xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:long>1</a:long><a:long>2</a:long></Ids></IdCollection>
+				<IdCollection><Ids xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"/></IdCollection>
+			</CampaignIdCollection>
+		</GetCampaignIdsByBudgetIdsResponse>`)
+	defer closeServer()
+
+	collections, _, err := client.BudgetService().GetCampaignIdsByBudgetIds([]int64{101, 102})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<BudgetIds xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:long>101</a1:long><a1:long>102</a1:long></BudgetIds>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(collections) != 2 || len(collections[0].Ids) != 2 || len(collections[1].Ids) != 0 {
+		t.Errorf("活动 ID 解析不正确: %+v", collections)
+	}
+}
+
+func TestDeleteBudgetsChecksBatchSize(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, "")
+	defer closeServer()
+
+	_, err := client.BudgetService().DeleteBudgets(make([]int64, models.MaxBudgetsPerCall+1))
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("超过单次上限时应返回 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
+
+func TestAddBidStrategiesEncodesBiddingScheme(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddBidStrategiesResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<BidStrategyIds xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:long>7</a:long></BidStrategyIds>
+		</AddBidStrategiesResponse>`)
+	defer closeServer()
+
+	ids, _, err := client.BidStrategyService().AddBidStrategies([]models.BidStrategy{{
+		BiddingScheme: models.TargetCpaBiddingScheme{MaxCpc: models.NewBid(2.5), TargetCpa: base.NewNillable(30.0)},
+		Name:          base.String("portfolio"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<BidStrategy><BiddingScheme i:type="TargetCpaBiddingScheme"><MaxCpc><Amount>2.5</Amount></MaxCpc><TargetCpa>30</TargetCpa></BiddingScheme><Name>portfolio</Name></BidStrategy>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(ids) != 1 || ids[0] != 7 {
+		t.Errorf("出价策略 ID 解析不正确: %v", ids)
+	}
+}
+
+func TestGetBidStrategiesByIdsDecodesBiddingSchemes(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, `
+		<GetBidStrategiesByIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<BidStrategies>
+				<BidStrategy><AssociationCount>3</AssociationCount>
+					<BiddingScheme i:type="TargetImpressionShareBiddingScheme"><Type>TargetImpressionShare</Type>
+						<MaxCpc><Amount>4</Amount></MaxCpc><TargetAdPosition>AbsoluteTop</TargetAdPosition><TargetImpressionShare>80</TargetImpressionShare>
+					</BiddingScheme><Id>1</Id><Name>tis</Name></BidStrategy>
+				<BidStrategy><BiddingScheme i:type="MaxConversionValueBiddingScheme"><TargetRoas>3.5</TargetRoas></BiddingScheme><Id>2</Id></BidStrategy>
+				<BidStrategy><BiddingScheme i:type="FutureBiddingScheme"><Foo>1</Foo></BiddingScheme><Id>3</Id></BidStrategy>
+			</BidStrategies>
+		</GetBidStrategiesByIdsResponse>`)
+	defer closeServer()
+
+	strategies, _, err := client.BidStrategyService().GetBidStrategiesByIds([]int64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(strategies) != 3 {
+		t.Fatalf("期望 3 个出价策略，实际 %d 个", len(strategies))
+	}
+
+	tis, ok := strategies[0].BiddingScheme.(models.TargetImpressionShareBiddingScheme)
+	if !ok {
+		t.Fatalf("第一个出价策略类型不正确: %T", strategies[0].BiddingScheme)
+	}
+	if tis.MaxCpc.Amount.Value() != 4 || tis.TargetAdPosition != models.TargetAdPositionAbsoluteTop || tis.TargetImpressionShare.Value() != 80 {
+		t.Errorf("目标展示份额出价解析不正确: %+v", tis)
+	}
+	if strategies[0].AssociationCount.Value() != 3 || strategies[0].Name.Value() != "tis" {
+		t.Errorf("出价策略字段解析不正确: %+v", strategies[0])
+	}
+
+	roas, ok := strategies[1].BiddingScheme.(models.MaxConversionValueBiddingScheme)
+	if !ok || roas.TargetRoas.Value() != 3.5 {
+		t.Errorf("最大化转化价值出价解析不正确: %#v", strategies[1].BiddingScheme)
+	}
+
+	unknown, ok := strategies[2].BiddingScheme.(models.UnknownBiddingScheme)
+	if !ok || unknown.TypeName != "FutureBiddingScheme" || !strings.Contains(unknown.InnerXML, "<Foo>1</Foo>") {
+		t.Errorf("未知出价策略应当保留原始 XML: %#v", strategies[2].BiddingScheme)
+	}
+}
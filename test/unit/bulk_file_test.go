@@ -0,0 +1,98 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/bulk/models"
+	"github.com/vancevox/bingads-go/bulk/service"
+	cm "github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestBulkFileRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	writer := service.NewBulkFileWriter(&buf, models.DownloadFileTypeCsv)
+	records := []models.BulkRecord{
+		models.NegativeKeywordList{RowBase: models.RowBase{Status: "Active", Id: -1, ParentId: 5}, Name: "Brand"},
+		models.SharedNegativeKeywordFromListItem(-1, cm.SharedListItem{Text: "free, cheap", MatchType: "Phrase"}),
+		models.CampaignNegativeKeywordListAssociation{RowBase: models.RowBase{Status: "Active", Id: -1, ParentId: 77}},
+		models.ResponsiveSearchAd{
+			RowBase:   models.RowBase{Status: "Active", ParentId: 9},
+			Headlines: []models.AssetLink{{Text: "Contoso", PinnedField: "Headline1"}, {Text: "Shoes"}},
+			FinalUrl:  "https://contoso.com",
+		},
+	}
+	for _, record := range records {
+		if err := writer.Write(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := service.NewBulkFileReader(&buf, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var read []models.BulkRecord
+	for reader.Next() {
+		read = append(read, reader.Record())
+	}
+	if err := reader.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(read) != 5 {
+		t.Fatalf("应当读取 5 条记录（包括自动写入的 Format Version），实际为 %d", len(read))
+	}
+	if v, ok := read[0].(*models.FormatVersion); !ok || v.Version != models.DefaultFormatVersion {
+		t.Errorf("第一条记录应当是 Format Version: %#v", read[0])
+	}
+	if list, ok := read[1].(*models.NegativeKeywordList); !ok || list.Name != "Brand" || list.Id != -1 || list.ParentId != 5 {
+		t.Errorf("否定关键词列表解析不正确: %#v", read[1])
+	}
+
+	item := read[2].(*models.SharedNegativeKeyword).ToSharedListItem()
+	if item.Type != cm.SharedListItemTypeNegativeKeyword || item.Text != "free, cheap" || item.MatchType != "Phrase" || item.ID.IsSet() {
+		t.Errorf("共享否定关键词转换不正确: %+v", item)
+	}
+
+	association := read[3].(*models.CampaignNegativeKeywordListAssociation).ToSharedEntityAssociation()
+	if association.EntityId != 77 || association.SharedEntityId != -1 || association.EntityType != cm.EntityTypeCampaign {
+		t.Errorf("关联转换不正确: %+v", association)
+	}
+
+	ad := read[4].(*models.ResponsiveSearchAd)
+	if len(ad.Headlines) != 2 || ad.Headlines[0].PinnedField != "Headline1" || ad.FinalUrl != "https://contoso.com" {
+		t.Errorf("响应式搜索广告解析不正确: %+v", ad)
+	}
+}
+
+func TestReadBulkErrors(t *testing.T) {
+	result := "\xef\xbb\xbfType,Status,Id,Parent Id,Campaign,Ad Group,Keyword,Match Type,Error,Error Number\r\n" +
+		"Format Version,,,,,,,,,\r\n" +
+		"Keyword,Active,101,9,C,G,shoes,Exact,,\r\n" +
+		"Keyword Error,,,9,C,G,boots,Exact,InvalidKeywordText,1234\r\n" +
+		"Campaign,Active,9,5,C,,,,CampaignServiceCannotChangeStatus,1109\r\n" +
+		"Shared Negative Keyword,Active,abc,3,,,free,Exact,,\r\n"
+
+	errs, err := service.ReadBulkErrors(strings.NewReader(result), models.DownloadFileTypeCsv)
+	if len(errs) != 2 {
+		t.Fatalf("应当提取 2 个错误，实际为 %d: %+v", len(errs), errs)
+	}
+	if errs[0].EntityType != "Keyword" || errs[0].ErrorNumber != 1234 || errs[0].Line != 4 {
+		t.Errorf("错误行解析不正确: %+v", errs[0])
+	}
+	if errs[1].EntityType != "Campaign" || errs[1].Id != 9 || errs[1].Error != "CampaignServiceCannotChangeStatus" {
+		t.Errorf("实体行上的错误解析不正确: %+v", errs[1])
+	}
+
+	var fileErr *service.BulkFileError
+	var rowErr *models.BulkRowError
+	if !errors.As(err, &fileErr) || fileErr.Line != 6 || !errors.As(err, &rowErr) || rowErr.Column != "Id" {
+		t.Errorf("无法解析的行应当返回行号和列名，实际为 %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package unit
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/bulk/models"
+	"github.com/vancevox/bingads-go/bulk/service"
+	"github.com/vancevox/bingads-go/config"
+)
+
+// newBulkClient 创建指向测试服务器的 Bulk 客户端
+func newBulkClient(url string) *service.Client {
+	return service.NewClient(newTestConfig(config.ServiceBulk, url))
+}
+
+// bulkResponse 包装 SOAP 响应
+func bulkResponse(body string) string {
+	return `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>` + body + `</s:Body></s:Envelope>`
+}
+
+func TestDownloadCampaignsByAccountIds(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(bulkResponse(`<DownloadCampaignsByAccountIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<DownloadRequestId>d-1</DownloadRequestId></DownloadCampaignsByAccountIdsResponse>`)))
+	}))
+	defer server.Close()
+
+	id, err := newBulkClient(server.URL).BulkService().DownloadCampaignsByAccountIds(models.DownloadCampaignsByAccountIdsRequest{
+		AccountIds:       []int64{5},
+		DownloadEntities: []models.DownloadEntity{models.DownloadEntityCampaigns, models.DownloadEntityKeywords},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "d-1" {
+		t.Errorf("DownloadRequestId 解析不正确: %q", id)
+	}
+
+	want := `<AccountIds xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:long>5</a1:long></AccountIds>` +
+		`<DataScope>EntityData</DataScope><DownloadEntities><DownloadEntity>Campaigns</DownloadEntity><DownloadEntity>Keywords</DownloadEntity></DownloadEntities>` +
+		`<DownloadFileType>Csv</DownloadFileType><FormatVersion>6.0</FormatVersion></DownloadCampaignsByAccountIdsRequest>`
+	if !strings.Contains(body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, body)
+	}
+}
+
+func TestBulkUploadRoundTrip(t *testing.T) {
+	const csv = "Type,Status,Id,Parent Id\r\nFormat Version,,,\r\n"
+
+	var polls int32
+	var uploaded []byte
+	var uploadHeader http.Header
+	mux := http.NewServeMux()
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		uploadHeader = r.Header
+		file, header, err := r.FormFile("uploadFile")
+		if err != nil || header.Filename != "keywords.zip" {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		uploaded, _ = io.ReadAll(file)
+	})
+	mux.HandleFunc("/result", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(uploaded)
+	})
+	var server *httptest.Server
+	mux.HandleFunc("/soap", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("SOAPAction") {
+		case string(models.SOAPActionGetBulkUploadUrl):
+			w.Write([]byte(bulkResponse(`<GetBulkUploadUrlResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+				<RequestId>u-1</RequestId><UploadUrl>` + server.URL + `/upload</UploadUrl></GetBulkUploadUrlResponse>`)))
+		case string(models.SOAPActionGetBulkUploadStatus):
+			status := "InProgress"
+			if atomic.AddInt32(&polls, 1) > 1 {
+				status = "CompletedWithErrors"
+			}
+			w.Write([]byte(bulkResponse(`<GetBulkUploadStatusResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+				<Errors i:nil="true" xmlns:i="http://www.w3.org/2001/XMLSchema-instance"/><PercentComplete>100</PercentComplete>
+				<RequestStatus>` + status + `</RequestStatus><ResultFileUrl>` + server.URL + `/result</ResultFileUrl></GetBulkUploadStatusResponse>`)))
+		}
+	})
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	bulk := newBulkClient(server.URL + "/soap").BulkService()
+	upload, err := bulk.GetBulkUploadUrl(5, models.ResponseModeErrorsAndResults)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bulk.UploadFile(context.Background(), upload.UploadUrl, "keywords.csv", strings.NewReader(csv)); err != nil {
+		t.Fatal(err)
+	}
+	if uploadHeader.Get("DeveloperToken") != "dev" || uploadHeader.Get("AuthenticationToken") != "auth" || uploadHeader.Get("CustomerAccountId") != "2" {
+		t.Errorf("上传请求缺少认证头: %v", uploadHeader)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(uploaded), int64(len(uploaded)))
+	if err != nil || len(archive.File) != 1 || archive.File[0].Name != "keywords.csv" {
+		t.Fatalf("上传的文件不是包含 keywords.csv 的压缩包: %v", err)
+	}
+
+	status, err := bulk.WaitForUpload(context.Background(), upload.RequestId, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polls != 2 || status.RequestStatus != models.UploadStatusCompletedWithErrors {
+		t.Errorf("轮询结果不正确: polls=%d status=%+v", polls, status)
+	}
+
+	result, err := bulk.DownloadResultFile(context.Background(), status.ResultFileUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer result.Close()
+	if data, _ := io.ReadAll(result); string(data) != csv {
+		t.Errorf("结果文件内容不正确: %q", data)
+	}
+}
+
+func TestWaitForUploadInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(bulkResponse(`<GetBulkUploadStatusResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<PercentComplete>100</PercentComplete><RequestStatus>Completed</RequestStatus></GetBulkUploadStatusResponse>`)))
+	}))
+	defer server.Close()
+
+	bulk := newBulkClient(server.URL).BulkService()
+	if _, err := bulk.WaitForUpload(context.Background(), "u-1", 0); err != nil {
+		t.Errorf("interval 为 0 时应当使用默认间隔: %v", err)
+	}
+	_, err := bulk.WaitForDownload(context.Background(), "d-1", -time.Second)
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("interval 为负数时应当返回 INVALID_INPUT，实际为 %v", err)
+	}
+}
+
+func TestWaitForDownloadFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(bulkResponse(`<GetBulkDownloadStatusResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<Errors><OperationError><Code>3224</Code><ErrorCode>BulkServiceNoEntitiesToDownload</ErrorCode><Message>Nothing to download.</Message></OperationError></Errors>
+			<PercentComplete>0</PercentComplete><RequestStatus>Failed</RequestStatus></GetBulkDownloadStatusResponse>`)))
+	}))
+	defer server.Close()
+
+	status, err := newBulkClient(server.URL).BulkService().WaitForDownload(context.Background(), "d-1", time.Millisecond)
+	if !base.IsAPIError(err) || !strings.Contains(err.Error(), "BulkServiceNoEntitiesToDownload") {
+		t.Fatalf("下载失败应当返回包含错误码的 API_ERROR，实际为 %v", err)
+	}
+	if status == nil || len(status.Errors) != 1 {
+		t.Errorf("应当返回最后一次查询到的状态: %+v", status)
+	}
+}
@@ -0,0 +1,118 @@
+package unit
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+var changeSetList = models.ChangeSharedList{Id: 123, Type: models.SharedEntityTypeNegativeKeywordList}
+
+func TestChangeSetDiffAndJSON(t *testing.T) {
+	var cs models.ChangeSet
+	cs.AddListItems(changeSetList, models.EntityScopeAccount, negativeKeywordItem(0, "cheap", "Exact"))
+	cs.DeleteListItems(changeSetList, models.EntityScopeAccount, negativeKeywordItem(7, "free", "Phrase"))
+	cs.SetAssociations("", models.SharedEntityAssociation{EntityId: 9, EntityType: "Campaign", SharedEntityId: 123, SharedEntityType: models.SharedEntityTypeNegativeKeywordList})
+
+	diff := cs.Diff()
+	for _, want := range []string{
+		"# 共享列表 NegativeKeywordList 123 (Account)",
+		"+ NegativeKeyword [Exact] cheap",
+		"- NegativeKeyword [Phrase] free (Id 7)",
+		"+ Campaign 9 <-> NegativeKeywordList 123",
+		"计划：添加 2 项，删除 1 项。",
+	} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("计划缺少 %q:\n%s", want, diff)
+		}
+	}
+
+	data, err := json.Marshal(&cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := models.ParseChangeSet(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Diff() != diff {
+		t.Errorf("JSON 往返后计划不一致:\n%s", parsed.Diff())
+	}
+
+	if !strings.Contains(string(data), `"association":{"entity_id":9,"entity_type":"Campaign","shared_entity_id":123,"shared_entity_type":"NegativeKeywordList"}`) {
+		t.Errorf("关联应当使用 snake_case 字段名:\n%s", data)
+	}
+
+	for _, invalid := range []string{
+		`{"changes":[{"action":"delete","resource":"shared_list_item","shared_list":{"id":1,"type":"NegativeKeywordList"},"list_item":{"type":"NegativeKeyword","text":"a","match_type":"Exact"}}]}`,
+		`{"changes":[{"action":"create","resource":"shared_list_item","shared_list":{"id":1},"list_item":{"type":"NegativeKeyword","text":"a","match_type":"Exact"}}]}`,
+		`{"changes":[{"action":"create","resource":"shared_list_item","shared_list":{"id":1,"type":"KeywordList"},"list_item":{"type":"NegativeKeyword","text":"a","match_type":"Exact"}}]}`,
+		`{"changes":[{"action":"create","resource":"shared_entity_association","association":{"entity_id":9,"entity_type":"Campaign","shared_entity_id":1}}]}`,
+	} {
+		if _, err := models.ParseChangeSet([]byte(invalid)); err == nil {
+			t.Errorf("无效的变更集应返回错误: %s", invalid)
+		}
+	}
+}
+
+func TestChangeSetDiffWithoutValidate(t *testing.T) {
+	cs := models.ChangeSet{Changes: []models.Change{
+		{Action: models.ChangeActionCreate, Resource: models.ChangeResourceSharedListItem},
+		{Action: models.ChangeActionDelete, Resource: models.ChangeResourceSharedEntityAssociation},
+	}}
+	diff := cs.Diff()
+	for _, want := range []string{"+ shared_list_item", "- shared_entity_association", "计划：添加 1 项，删除 1 项。"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("计划缺少 %q:\n%s", want, diff)
+		}
+	}
+}
+
+func TestApplyChangeSetMapsErrors(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, `
+		<AddListItemsToSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+			<ListItemIds><a:long>0</a:long><a:long>42</a:long></ListItemIds>
+			<PartialErrors><BatchError><Code>1</Code><ErrorCode>DuplicateNegativeKeyword</ErrorCode><Index>0</Index></BatchError></PartialErrors>
+		</AddListItemsToSharedListResponse>`)
+	defer closeServer()
+
+	var cs models.ChangeSet
+	cs.AddListItems(changeSetList, models.EntityScopeAccount,
+		negativeKeywordItem(0, "a", "Exact"), negativeKeywordItem(0, "b", "Exact"), negativeKeywordItem(0, "c", "Exact"))
+
+	result, err := client.ChangeSetService().ApplyChangeSet(&cs, models.BatchOptions{BatchSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Errors) != 2 || result.Errors[0].Index != 0 || result.Errors[1].Index != 2 {
+		t.Errorf("部分错误的索引应换算为变更集中的位置: %+v", result.Errors)
+	}
+	if result.Ids[1] != 42 || len(result.ChangeErrors(2)) != 1 {
+		t.Errorf("结果不正确: %+v", result)
+	}
+}
+
+func TestApplyChangeSetStopsOnRequestError(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddListItemsToSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13"/>`)
+	defer closeServer()
+
+	var cs models.ChangeSet
+	cs.AddListItems(changeSetList, models.EntityScopeAccount, negativeKeywordItem(0, "a", "Exact"))
+	cs.DeleteListItems(changeSetList, models.EntityScopeAccount, negativeKeywordItem(7, "b", "Exact"))
+
+	result, err := client.ChangeSetService().ApplyChangeSet(&cs, models.BatchOptions{})
+	if err == nil {
+		t.Fatal("删除请求缺少响应时应返回错误")
+	}
+
+	if !strings.Contains(*body, "DeleteListItemsFromSharedListRequest") {
+		t.Errorf("应先执行删除:\n%s", *body)
+	}
+	if len(result.NotApplied) != 2 || result.NotApplied[0] != 0 || result.NotApplied[1] != 1 {
+		t.Errorf("未执行的修改不正确: %v", result.NotApplied)
+	}
+}
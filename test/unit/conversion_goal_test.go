@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestAddUetTagsReturnsTrackingScript(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddUetTagsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<UetTags><UetTag><Description i:nil="true" xmlns:i="http://www.w3.org/2001/XMLSchema-instance"/><Id>26000001</Id><Name>官网</Name><TrackingNoScript>&lt;img src="..."/&gt;</TrackingNoScript><TrackingScript>&lt;script&gt;uetq&lt;/script&gt;</TrackingScript><TrackingStatus>Unverified</TrackingStatus></UetTag></UetTags>
+			<PartialErrors/>
+		</AddUetTagsResponse>`)
+	defer closeServer()
+
+	tags, _, err := client.UetTagService().AddUetTags([]models.UetTag{{Name: base.String("官网")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<AddUetTagsRequest xmlns="https://bingads.microsoft.com/CampaignManagement/v13"><UetTags><UetTag><Name>官网</Name></UetTag></UetTags></AddUetTagsRequest>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(tags) != 1 || tags[0].Id.Value() != 26000001 || tags[0].TrackingScript.Value() != "<script>uetq</script>" || tags[0].TrackingStatus.Value() != models.UetTagTrackingStatusUnverified {
+		t.Errorf("UET 标签解析不正确: %+v", tags)
+	}
+}
+
+func TestAddConversionGoalsEncodesTypes(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddConversionGoalsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<ConversionGoalIds xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a:long>1</a:long><a:long>2</a:long></ConversionGoalIds>
+		</AddConversionGoalsResponse>`)
+	defer closeServer()
+
+	_, _, err := client.ConversionGoalService().AddConversionGoals([]models.ConversionGoal{
+		models.UrlGoal{
+			ConversionGoalBase: models.ConversionGoalBase{
+				Name:    base.String("下单"),
+				TagId:   base.Int64(26000001),
+				Revenue: &models.ConversionGoalRevenue{Type: base.NewNillable(models.ConversionGoalRevenueTypeFixedValue), Value: base.NewNillable(5.0)},
+			},
+			UrlExpression: base.String("/thanks"),
+			UrlOperator:   base.NewNillable(models.ExpressionOperatorContains),
+		},
+		models.EventGoal{
+			ConversionGoalBase: models.ConversionGoalBase{Name: base.String("注册")},
+			ActionExpression:   base.String("signup"),
+			ActionOperator:     base.NewNillable(models.ExpressionOperatorEquals),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<ConversionGoal i:type="UrlGoal"><Name>下单</Name><Revenue><Type>FixedValue</Type><Value>5</Value></Revenue><TagId>26000001</TagId><UrlExpression>/thanks</UrlExpression><UrlOperator>Contains</UrlOperator></ConversionGoal>`,
+		`<ConversionGoal i:type="EventGoal"><Name>注册</Name><ActionExpression>signup</ActionExpression><ActionOperator>Equals</ActionOperator></ConversionGoal>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+}
+
+func TestGetConversionGoalsByTagIdsDecodesTypes(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetConversionGoalsByTagIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<ConversionGoals>
+				<ConversionGoal i:type="DurationGoal"><Id>1</Id><Type>Duration</Type><MinimumDurationInSeconds>120</MinimumDurationInSeconds></ConversionGoal>
+				<ConversionGoal i:type="OfflineConversionGoal"><Id>2</Id><Name>CRM 成交</Name></ConversionGoal>
+				<ConversionGoal i:type="InStoreTransactionGoal"><Id>3</Id></ConversionGoal>
+			</ConversionGoals>
+		</GetConversionGoalsByTagIdsResponse>`)
+	defer closeServer()
+
+	goals, _, err := client.ConversionGoalService().GetConversionGoalsByTagIds([]int64{26000001})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<ConversionGoalTypes>Url Duration PagesViewedPerVisit Event AppInstall OfflineConversion</ConversionGoalTypes>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(goals) != 3 {
+		t.Fatalf("期望 3 个转化目标，实际 %d 个", len(goals))
+	}
+	if goal, ok := goals[0].(models.DurationGoal); !ok || goal.MinimumDurationInSeconds.Value() != 120 || goal.Type.Value() != models.ConversionGoalTypeDuration {
+		t.Errorf("时长目标解析不正确: %+v", goals[0])
+	}
+	if goal, ok := goals[1].(models.OfflineConversionGoal); !ok || goal.Name.Value() != "CRM 成交" {
+		t.Errorf("离线转化目标解析不正确: %+v", goals[1])
+	}
+	if goal, ok := goals[2].(models.UnknownConversionGoal); !ok || goal.TypeName != "InStoreTransactionGoal" {
+		t.Errorf("未知类型转化目标解析不正确: %+v", goals[2])
+	}
+}
+
+func TestApplyOfflineConversionsSendsUTC(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<ApplyOfflineConversionsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<PartialErrors><BatchError><Code>5605</Code><ErrorCode>OfflineConversionMicrosoftClickIdInvalid</ErrorCode><Index>0</Index></BatchError></PartialErrors>
+		</ApplyOfflineConversionsResponse>`)
+	defer closeServer()
+
+	shanghai := time.FixedZone("CST", 8*3600)
+	partialErrors, err := client.ConversionGoalService().ApplyOfflineConversions([]models.OfflineConversion{{
+		ConversionName:   "CRM 成交",
+		ConversionTime:   time.Date(2024, 5, 1, 8, 30, 0, 0, shanghai),
+		ConversionValue:  base.NewNillable(199.0),
+		MicrosoftClickId: "abc123",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<OfflineConversion><ConversionName>CRM 成交</ConversionName><ConversionTime>2024-05-01T00:30:00Z</ConversionTime><ConversionValue>199</ConversionValue><MicrosoftClickId>abc123</MicrosoftClickId></OfflineConversion>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(partialErrors) != 1 || partialErrors[0].ErrorCode != "OfflineConversionMicrosoftClickIdInvalid" {
+		t.Errorf("部分错误解析不正确: %+v", partialErrors)
+	}
+}
@@ -0,0 +1,122 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerBilling/models"
+	"github.com/vancevox/bingads-go/customerBilling/service"
+)
+
+// newCustomerBillingServer 返回固定响应的测试服务器，并记录最后一次请求的请求体
+func newCustomerBillingServer(t *testing.T, response string) (*service.Client, *string, func()) {
+	t.Helper()
+	url, request, closeServer := newSOAPServer(t, response)
+	return service.NewClient(newTestConfig(config.ServiceCustomerBilling, url)), &request.Body, closeServer
+}
+
+func TestSearchInsertionOrders(t *testing.T) {
+	client, body, closeServer := newCustomerBillingServer(t, `
+		<SearchInsertionOrdersResponse xmlns="https://bingads.microsoft.com/Billing/v13">
+			<InsertionOrders xmlns:a="https://bingads.microsoft.com/Customer/v13/Entities" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+				<a:InsertionOrder><a:AccountId>7</a:AccountId><a:EndDate i:nil="true"/><a:Id>99</a:Id>
+					<a:SpendCapAmount>5000</a:SpendCapAmount><a:StartDate>2026-01-01T00:00:00</a:StartDate>
+					<a:Name>Q1</a:Name><a:Status>Active</a:Status><a:BudgetSpent>1250.5</a:BudgetSpent></a:InsertionOrder>
+			</InsertionOrders>
+		</SearchInsertionOrdersResponse>`)
+	defer closeServer()
+
+	orders, err := client.InsertionOrderService().SearchInsertionOrders([]models.Predicate{{
+		Field: models.InsertionOrderSearchFieldAccountId, Operator: "Equals", Value: "7",
+	}}, nil, models.Paging{Index: 0, Size: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<SearchInsertionOrdersRequest xmlns="https://bingads.microsoft.com/Billing/v13"><Predicates><e1:Predicate xmlns:e1="https://bingads.microsoft.com/Customer/v13/Entities"><e1:Field>AccountId</e1:Field>`,
+		`<PageInfo xmlns:e1="https://bingads.microsoft.com/Customer/v13/Entities"><e1:Index>0</e1:Index><e1:Size>100</e1:Size></PageInfo>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+
+	if len(orders) != 1 {
+		t.Fatalf("期望 1 个订单，实际 %d 个", len(orders))
+	}
+	order := orders[0]
+	if order.Id.Value() != 99 || order.Status.Value() != models.InsertionOrderStatusActive || order.BudgetSpent.Value() != 1250.5 {
+		t.Errorf("订单解析不正确: %+v", order)
+	}
+	if !order.EndDate.IsNull() {
+		t.Errorf("EndDate 应为 nil")
+	}
+}
+
+func TestAddInsertionOrderEncodesEntityNamespace(t *testing.T) {
+	client, body, closeServer := newCustomerBillingServer(t, `
+		<AddInsertionOrderResponse xmlns="https://bingads.microsoft.com/Billing/v13">
+			<InsertionOrderId>123</InsertionOrderId><CreateTime>2026-01-01T08:00:00</CreateTime>
+		</AddInsertionOrderResponse>`)
+	defer closeServer()
+
+	id, createTime, err := client.InsertionOrderService().AddInsertionOrder(models.InsertionOrder{
+		AccountId:      base.Int64(7),
+		SpendCapAmount: base.NewNillable(5000.0),
+		StartDate:      base.String("2026-01-01T00:00:00"),
+		Name:           base.String("Q1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 123 || createTime != "2026-01-01T08:00:00" {
+		t.Errorf("响应解析不正确: %d %s", id, createTime)
+	}
+
+	want := `<InsertionOrder xmlns:e1="https://bingads.microsoft.com/Customer/v13/Entities"><e1:AccountId>7</e1:AccountId><e1:SpendCapAmount>5000</e1:SpendCapAmount><e1:StartDate>2026-01-01T00:00:00</e1:StartDate><e1:Name>Q1</e1:Name></InsertionOrder>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+}
+
+func TestGetBillingDocuments(t *testing.T) {
+	client, body, closeServer := newCustomerBillingServer(t, `
+		<GetBillingDocumentsResponse xmlns="https://bingads.microsoft.com/Billing/v13">
+			<BillingDocuments xmlns:a="https://bingads.microsoft.com/Customer/v13/Entities">
+				<a:BillingDocument><a:Data>JVBERi0xLjQ=</a:Data><a:Id>5</a:Id><a:Type>Pdf</a:Type></a:BillingDocument>
+			</BillingDocuments>
+		</GetBillingDocumentsResponse>`)
+	defer closeServer()
+
+	documents, err := client.BillingService().GetBillingDocuments([]int64{5}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(*body, `<Type>Pdf</Type>`) {
+		t.Errorf("未指定格式时应请求 PDF:\n%s", *body)
+	}
+	if len(documents) != 1 || string(documents[0].Data) != "%PDF-1.4" {
+		t.Errorf("账单文件解析不正确: %+v", documents)
+	}
+}
+
+func TestGetAccountMonthlySpendUsesFirstDayOfMonth(t *testing.T) {
+	client, body, closeServer := newCustomerBillingServer(t, `
+		<GetAccountMonthlySpendResponse xmlns="https://bingads.microsoft.com/Billing/v13"><Amount>321.5</Amount></GetAccountMonthlySpendResponse>`)
+	defer closeServer()
+
+	amount, err := client.BillingService().GetAccountMonthlySpend(7, time.Date(2026, 3, 17, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 321.5 {
+		t.Errorf("消费金额解析不正确: %v", amount)
+	}
+	if !strings.Contains(*body, `<MonthYear>2026-03-01T00:00:00Z</MonthYear>`) {
+		t.Errorf("MonthYear 应为当月第一天:\n%s", *body)
+	}
+}
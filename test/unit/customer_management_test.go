@@ -0,0 +1,122 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/customerManagement/models"
+	"github.com/vancevox/bingads-go/customerManagement/service"
+)
+
+// newCustomerManagementServer 返回固定响应的测试服务器，并记录最后一次请求的 SOAPAction 和请求体
+func newCustomerManagementServer(t *testing.T, response string) (*service.Client, *string, *string, func()) {
+	t.Helper()
+	url, request, closeServer := newSOAPServer(t, response)
+	return service.NewClient(newTestConfig(config.ServiceCustomerManagement, url)), &request.Action, &request.Body, closeServer
+}
+
+func TestGetAccountsInfo(t *testing.T) {
+	client, action, body, closeServer := newCustomerManagementServer(t, `
+		<GetAccountsInfoResponse xmlns="https://bingads.microsoft.com/Customer/v13">
+			<AccountsInfo xmlns:a="https://bingads.microsoft.com/Customer/v13/Entities" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+				<a:AccountInfo><a:Id>11</a:Id><a:Name>A</a:Name><a:Number>X1</a:Number>
+					<a:AccountLifeCycleStatus>Active</a:AccountLifeCycleStatus><a:PauseReason i:nil="true"/></a:AccountInfo>
+				<a:AccountInfo><a:Id>12</a:Id><a:Name>B</a:Name><a:Number>X2</a:Number>
+					<a:AccountLifeCycleStatus>Pause</a:AccountLifeCycleStatus><a:PauseReason>2</a:PauseReason></a:AccountInfo>
+			</AccountsInfo>
+		</GetAccountsInfoResponse>`)
+	defer closeServer()
+
+	accounts, err := client.AccountService().GetAccountsInfo(0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *action != string(models.SOAPActionGetAccountsInfo) {
+		t.Errorf("SOAPAction 应当为 GetAccountsInfo，实际为 %q", *action)
+	}
+	if !strings.Contains(*body, `<GetAccountsInfoRequest xmlns="https://bingads.microsoft.com/Customer/v13"><OnlyParentAccounts>true</OnlyParentAccounts></GetAccountsInfoRequest>`) {
+		t.Errorf("customerId 为 0 时不应发送 CustomerId: %s", *body)
+	}
+	if len(accounts) != 2 || accounts[1].Id != 12 || accounts[1].AccountLifeCycleStatus != models.AccountLifeCycleStatusPause {
+		t.Fatalf("账户解析结果不正确: %+v", accounts)
+	}
+	if !accounts[0].PauseReason.IsNull() || accounts[1].PauseReason.Value() != 2 {
+		t.Errorf("PauseReason 解析不正确: %+v", accounts)
+	}
+}
+
+func TestUpdateAccountEncodesEntityNamespace(t *testing.T) {
+	client, _, body, closeServer := newCustomerManagementServer(t, `
+		<UpdateAccountResponse xmlns="https://bingads.microsoft.com/Customer/v13">
+			<LastModifiedTime>2026-01-02T03:04:05</LastModifiedTime>
+		</UpdateAccountResponse>`)
+	defer closeServer()
+
+	modified, err := client.AccountService().UpdateAccount(models.AdvertiserAccount{
+		Id:        base.Int64(42),
+		Name:      base.String("Renamed"),
+		TimeStamp: base.String("AAAA"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modified != "2026-01-02T03:04:05" {
+		t.Errorf("LastModifiedTime 解析不正确: %q", modified)
+	}
+
+	for _, want := range []string{
+		`<Account i:type="e1:AdvertiserAccount" xmlns:e1="https://bingads.microsoft.com/Customer/v13/Entities">`,
+		`<e1:Id>42</e1:Id><e1:Name>Renamed</e1:Name><e1:TimeStamp>AAAA</e1:TimeStamp></Account>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+}
+
+func TestSearchAccountsEncodesPredicates(t *testing.T) {
+	client, _, body, closeServer := newCustomerManagementServer(t, `
+		<SearchAccountsResponse xmlns="https://bingads.microsoft.com/Customer/v13">
+			<Accounts xmlns:a="https://bingads.microsoft.com/Customer/v13/Entities">
+				<a:AdvertiserAccount><a:Id>7</a:Id><a:Name>Seven</a:Name></a:AdvertiserAccount>
+			</Accounts>
+		</SearchAccountsResponse>`)
+	defer closeServer()
+
+	accounts, err := client.AccountService().SearchAccounts(
+		[]models.Predicate{{Field: models.AccountSearchFieldUserId, Operator: models.PredicateOperatorEquals, Value: "5"}},
+		nil,
+		models.Paging{Index: 0, Size: 100},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0].Id.Value() != 7 || accounts[0].Name.Value() != "Seven" {
+		t.Fatalf("账户解析结果不正确: %+v", accounts)
+	}
+
+	want := `<Predicates><e1:Predicate xmlns:e1="https://bingads.microsoft.com/Customer/v13/Entities"><e1:Field>UserId</e1:Field><e1:Operator>Equals</e1:Operator><e1:Value>5</e1:Value></e1:Predicate></Predicates>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+}
+
+func TestCustomerManagementFault(t *testing.T) {
+	client, _, _, closeServer := newCustomerManagementServer(t, `<s:Fault>
+		<faultcode>s:Server</faultcode><faultstring>Invalid client data.</faultstring>
+		<detail><ApiFault xmlns="https://bingads.microsoft.com/Customer/v13/Exception"><TrackingId>t1</TrackingId>
+			<OperationErrors><OperationError><Code>105</Code><ErrorCode>InvalidCredentials</ErrorCode><Message>Bad token.</Message></OperationError></OperationErrors>
+		</ApiFault></detail></s:Fault>`)
+	defer closeServer()
+
+	_, _, err := client.UserService().GetUser(0)
+	if !base.IsAuthError(err) {
+		t.Fatalf("认证故障应当返回 AUTH_ERROR，实际为 %v", err)
+	}
+	if !strings.Contains(err.Error(), "InvalidCredentials") {
+		t.Errorf("错误信息应当包含 ErrorCode: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestAddExperiments(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddExperimentsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+			<ExperimentIds><a:long>501</a:long></ExperimentIds>
+			<PartialErrors/>
+		</AddExperimentsResponse>`)
+	defer closeServer()
+
+	ids, _, err := client.ExperimentService().AddExperiments([]models.Experiment{{
+		BaseCampaignId:      base.Int64(1001),
+		Name:                base.String("出价实验"),
+		StartDate:           &models.Date{Day: 1, Month: 11, Year: 2026},
+		EndDate:             &models.Date{Day: 30, Month: 11, Year: 2026},
+		TrafficSplitPercent: base.NewNillable(50),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<BaseCampaignId>1001</BaseCampaignId>`,
+		`<EndDate><Day>30</Day><Month>11</Month><Year>2026</Year></EndDate>`,
+		`<TrafficSplitPercent>50</TrafficSplitPercent>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+	if strings.Contains(*body, "<ExperimentStatus>") {
+		t.Errorf("未设置的状态不应输出:\n%s", *body)
+	}
+	if len(ids) != 1 || ids[0] != 501 {
+		t.Errorf("实验 ID 解析不正确: %v", ids)
+	}
+}
+
+func TestGetExperimentsByIds(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetExperimentsByIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<Experiments>
+				<Experiment>
+					<BaseCampaignId>1001</BaseCampaignId>
+					<EndDate><Day>30</Day><Month>11</Month><Year>2026</Year></EndDate>
+					<ExperimentCampaignId>1002</ExperimentCampaignId>
+					<ExperimentStatus>Active</ExperimentStatus>
+					<ExperimentType i:nil="true"/>
+					<Id>501</Id>
+					<Name>出价实验</Name>
+					<StartDate><Day>1</Day><Month>11</Month><Year>2026</Year></StartDate>
+					<TrafficSplitPercent>50</TrafficSplitPercent>
+				</Experiment>
+			</Experiments>
+			<PartialErrors/>
+		</GetExperimentsByIdsResponse>`)
+	defer closeServer()
+
+	experiments, _, err := client.ExperimentService().GetExperimentsByIds(nil, models.Paging{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<PageInfo><Index>0</Index><Size>1000</Size></PageInfo>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(experiments) != 1 {
+		t.Fatalf("实验解析不正确: %+v", experiments)
+	}
+	e := experiments[0]
+	if e.ExperimentCampaignId.Value() != 1002 || e.ExperimentStatus != models.ExperimentStatusActive ||
+		e.TrafficSplitPercent.Value() != 50 || e.StartDate == nil || e.StartDate.Day != 1 || !e.ExperimentType.IsNull() {
+		t.Errorf("实验解析不正确: %+v", e)
+	}
+}
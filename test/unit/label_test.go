@@ -0,0 +1,71 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestGetLabelsByIdsEncodesPaging(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetLabelsByIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<Labels>
+				<Label><ColorCode>#0078D4</ColorCode><Description i:nil="true"/><Id>11</Id><Name>品牌</Name></Label>
+				<Label i:nil="true"/>
+			</Labels>
+			<PartialErrors><BatchError><Code>4905</Code><ErrorCode>LabelIdsInvalid</ErrorCode><Index>1</Index></BatchError></PartialErrors>
+		</GetLabelsByIdsResponse>`)
+	defer closeServer()
+
+	labels, partialErrors, err := client.LabelService().GetLabelsByIds([]int64{11, 12}, models.Paging{Size: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<PageInfo><Index>0</Index><Size>100</Size></PageInfo>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(labels) != 2 || labels[0].Name.Value() != "品牌" || labels[0].Id.Value() != 11 || !labels[0].Description.IsNull() {
+		t.Errorf("标签解析不正确: %+v", labels)
+	}
+	if len(partialErrors) != 1 || partialErrors[0].Index != 1 || partialErrors[0].ErrorCode != "LabelIdsInvalid" {
+		t.Errorf("部分错误解析不正确: %+v", partialErrors)
+	}
+}
+
+func TestGetLabelsByIdsChecksPaging(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, "")
+	defer closeServer()
+
+	_, _, err := client.LabelService().GetLabelsByIds(nil, models.Paging{Size: models.MaxPageSize + 1})
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
+
+func TestSetLabelAssociations(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<SetLabelAssociationsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<PartialErrors/>
+		</SetLabelAssociationsResponse>`)
+	defer closeServer()
+
+	partialErrors, err := client.LabelService().SetLabelAssociations(models.EntityTypeCampaign, []models.LabelAssociation{
+		{EntityId: 1001, LabelId: 11},
+		{EntityId: 1002, LabelId: 11},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<EntityType>Campaign</EntityType><LabelAssociations><LabelAssociation><EntityId>1001</EntityId><LabelId>11</LabelId></LabelAssociation><LabelAssociation><EntityId>1002</EntityId><LabelId>11</LabelId></LabelAssociation></LabelAssociations>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(partialErrors) != 0 {
+		t.Errorf("期望没有部分错误，实际 %+v", partialErrors)
+	}
+}
@@ -0,0 +1,121 @@
+package unit
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestExportSharedListsRoundTrip(t *testing.T) {
+	// 同一个响应同时包含共享实体和列表项，分别用于两次请求
+	client, _, closeServer := newCampaignManagementServer(t, `
+		<GetSharedEntitiesResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<SharedEntities>
+				<SharedEntity i:type="NegativeKeywordList"><Id>123</Id><Name>通用, 否定词</Name><Type>NegativeKeywordList</Type></SharedEntity>
+			</SharedEntities>
+		</GetSharedEntitiesResponse>
+		<GetListItemsBySharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<ListItems>
+				<SharedListItem i:type="NegativeKeyword"><Type>NegativeKeyword</Type><Id>1</Id><MatchType>Exact</MatchType><Text>free</Text></SharedListItem>
+				<SharedListItem i:type="NegativeKeyword"><Type>NegativeKeyword</Type><Id>2</Id><MatchType>Phrase</MatchType><Text>cheap "deals"</Text></SharedListItem>
+			</ListItems>
+		</GetListItemsBySharedListResponse>`)
+	defer closeServer()
+
+	lists, err := client.SharedListService().ExportSharedLists([]models.SharedEntityType{models.SharedEntityTypeNegativeKeywordList}, models.EntityScopeCustomer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 1 || lists[0].Id != 123 || len(lists[0].Items) != 2 {
+		t.Fatalf("导出结果不正确: %+v", lists)
+	}
+
+	var csvBuf, jsonBuf bytes.Buffer
+	if err := models.WriteSharedListsCSV(&csvBuf, lists); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(csvBuf.String(), "shared_list_id,shared_list_name,shared_list_type,type,id,text,match_type,url,brand_id\n123,\"通用, 否定词\",NegativeKeywordList,NegativeKeyword,1,free,Exact,,\n") {
+		t.Errorf("CSV 不正确:\n%s", csvBuf.String())
+	}
+	if err := models.WriteSharedListsJSON(&jsonBuf, lists); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, read := range map[string]func() ([]models.SharedListItem, error){
+		"CSV":  func() ([]models.SharedListItem, error) { return models.ReadListItemsCSV(&csvBuf) },
+		"JSON": func() ([]models.SharedListItem, error) { return models.ReadListItemsJSON(&jsonBuf) },
+	} {
+		items, err := read()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(items) != 2 || items[1].ID.Value() != 2 || items[1].Text != `cheap "deals"` || items[1].MatchType != "Phrase" {
+			t.Errorf("%s 读回的项目不正确: %+v", name, items)
+		}
+	}
+}
+
+func TestExportSharedListsMultipleTypes(t *testing.T) {
+	// 共享实体没有 Type 时使用请求的类型
+	client, _, closeServer := newCampaignManagementServer(t, `
+		<GetSharedEntitiesResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<SharedEntities><SharedEntity><Id>5</Id><Name>list</Name></SharedEntity></SharedEntities>
+		</GetSharedEntitiesResponse>
+		<GetListItemsBySharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13"><ListItems/></GetListItemsBySharedListResponse>`)
+	defer closeServer()
+
+	lists, err := client.SharedListService().ExportSharedLists([]models.SharedEntityType{
+		models.SharedEntityTypeNegativeKeywordList,
+		models.SharedEntityTypePlacementExclusionList,
+		models.SharedEntityTypeNegativeKeywordList,
+	}, models.EntityScopeAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 2 || lists[0].Type != models.SharedEntityTypeNegativeKeywordList || lists[1].Type != models.SharedEntityTypePlacementExclusionList {
+		t.Errorf("应当按顺序导出每种类型一次: %+v", lists)
+	}
+
+	_, err = client.SharedListService().ExportSharedLists(nil, models.EntityScopeAccount)
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
+
+func TestReadListItemsCSVReportsRowAndColumn(t *testing.T) {
+	input := "Type,Text,Match Type,Url,Brand Id\n" +
+		"NegativeKeyword,free,exact,,\n" +
+		"NegativeKeyword,,Broad,,\n" +
+		"NegativeSite,,,,\n" +
+		"BrandItem,,,,abc\n" +
+		"Keyword,x,Exact,,\n"
+
+	items, err := models.ReadListItemsCSV(strings.NewReader(input))
+	if len(items) != 1 || items[0].MatchType != "Exact" {
+		t.Errorf("有效的项目应被返回并规范化匹配方式: %+v", items)
+	}
+
+	var fileErr *models.ListItemFileError
+	if !errors.As(err, &fileErr) {
+		t.Fatalf("期望 ListItemFileError，实际 %v", err)
+	}
+	want := []models.ListItemFieldError{
+		{Row: 3, Column: models.ListItemColumnText},
+		{Row: 3, Column: models.ListItemColumnMatchType},
+		{Row: 4, Column: models.ListItemColumnUrl},
+		{Row: 5, Column: models.ListItemColumnBrandId},
+		{Row: 6, Column: models.ListItemColumnType},
+	}
+	if len(fileErr.Errors) != len(want) {
+		t.Fatalf("错误数量不正确: %v", fileErr)
+	}
+	for i, w := range want {
+		if got := fileErr.Errors[i]; got.Row != w.Row || got.Column != w.Column {
+			t.Errorf("第 %d 个错误为 %v，期望第 %d 行 %s 列", i, got, w.Row, w.Column)
+		}
+	}
+}
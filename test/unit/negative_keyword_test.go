@@ -0,0 +1,101 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestGetNegativeKeywordsByEntityIds(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetNegativeKeywordsByEntityIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<EntityNegativeKeywords>
+				<EntityNegativeKeyword>
+					<EntityId>2001</EntityId>
+					<EntityType>AdGroup</EntityType>
+					<NegativeKeywords>
+						<NegativeKeyword><Type>NegativeKeyword</Type><Id>31</Id><MatchType>Phrase</MatchType><Text>免费</Text></NegativeKeyword>
+					</NegativeKeywords>
+				</EntityNegativeKeyword>
+			</EntityNegativeKeywords>
+			<PartialErrors/>
+		</GetNegativeKeywordsByEntityIdsResponse>`)
+	defer closeServer()
+
+	entities, _, err := client.NegativeKeywordService().GetNegativeKeywordsByEntityIds([]int64{2001}, models.EntityTypeAdGroup, 1001)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<a1:long>2001</a1:long></EntityIds>`,
+		`<EntityType>AdGroup</EntityType>`,
+		`<ParentEntityId>1001</ParentEntityId>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+	if len(entities) != 1 || entities[0].EntityId != 2001 || len(entities[0].NegativeKeywords) != 1 {
+		t.Fatalf("负面关键词解析不正确: %+v", entities)
+	}
+	keyword := entities[0].NegativeKeywords[0]
+	if keyword.Id.Value() != 31 || keyword.Text != "免费" || keyword.MatchType != "Phrase" {
+		t.Errorf("负面关键词解析不正确: %+v", keyword)
+	}
+}
+
+func TestAddNegativeKeywordsToEntities(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<AddNegativeKeywordsToEntitiesResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+			<NegativeKeywordIds>
+				<IdCollection><Ids><a:long>31</a:long><a:long>32</a:long></Ids></IdCollection>
+			</NegativeKeywordIds>
+			<NestedPartialErrors/>
+		</AddNegativeKeywordsToEntitiesResponse>`)
+	defer closeServer()
+
+	ids, nestedErrors, err := client.NegativeKeywordService().AddNegativeKeywordsToEntities([]models.EntityNegativeKeyword{{
+		EntityId:   1001,
+		EntityType: models.EntityTypeCampaign,
+		NegativeKeywords: []models.NegativeKeyword{
+			{Text: "免费", MatchType: "Phrase"},
+			{Text: "二手", MatchType: "Exact"},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<EntityId>1001</EntityId><EntityType>Campaign</EntityType>`,
+		`<NegativeKeyword i:type="NegativeKeyword">`,
+		`<Text>二手</Text>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+	if len(ids) != 1 || len(ids[0].Ids) != 2 || ids[0].Ids[1] != 32 {
+		t.Errorf("ID 解析不正确: %+v", ids)
+	}
+	if len(nestedErrors) != 0 {
+		t.Errorf("不应有部分错误: %+v", nestedErrors)
+	}
+}
+
+func TestNegativeKeywordsRejectUnsupportedEntityType(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, "")
+	defer closeServer()
+
+	_, err := client.NegativeKeywordService().DeleteNegativeKeywordsFromEntities([]models.EntityNegativeKeyword{{
+		EntityId:         1,
+		EntityType:       models.EntityTypeAccount,
+		NegativeKeywords: []models.NegativeKeyword{{Id: base.Int64(31)}},
+	}})
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
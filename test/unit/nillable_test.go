@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+func TestNillableMarshalXML(t *testing.T) {
+	item := models.SharedListItem{
+		Type:    models.SharedListItemTypeBrandItem,
+		ID:      base.Int64(0),
+		BrandId: base.Null[int64](),
+	}
+
+	data, err := xml.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(data)
+	if !strings.Contains(got, "<Id>0</Id>") {
+		t.Errorf("零值 Id 应当被序列化: %s", got)
+	}
+	if !strings.Contains(got, `<BrandId i:nil="true"></BrandId>`) {
+		t.Errorf("置空的 BrandId 应当输出 i:nil: %s", got)
+	}
+
+	item = models.SharedListItem{Type: models.SharedListItemTypeNegativeSite, Url: "example.com"}
+	data, err = xml.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "<Id") || strings.Contains(string(data), "<BrandId") {
+		t.Errorf("未设置的字段应当被省略: %s", data)
+	}
+}
+
+func TestNillableUnmarshalXML(t *testing.T) {
+	data := `<SharedEntity xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+		<AssociationCount i:nil="true"/>
+		<Id>0</Id>
+		<Name>list</Name>
+	</SharedEntity>`
+
+	var entity models.SharedEntity
+	if err := xml.Unmarshal([]byte(data), &entity); err != nil {
+		t.Fatal(err)
+	}
+
+	if !entity.AssociationCount.IsNull() {
+		t.Errorf("AssociationCount 应当为显式置空")
+	}
+	if id, ok := entity.Id.Get(); !ok || id != 0 {
+		t.Errorf("Id 应当为 0，实际为 %v (%v)", id, ok)
+	}
+	if entity.ItemCount.IsSet() {
+		t.Errorf("ItemCount 应当为未设置")
+	}
+}
+
+func TestBatchErrorNillableFields(t *testing.T) {
+	data := `<BatchError xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+		<Code>1001</Code>
+		<Details i:nil="true"/>
+		<ErrorCode>InvalidUrl</ErrorCode>
+		<FieldPath>ListItems[0].Url</FieldPath>
+		<Index>3</Index>
+	</BatchError>`
+
+	var batchErr models.BatchError
+	if err := xml.Unmarshal([]byte(data), &batchErr); err != nil {
+		t.Fatal(err)
+	}
+
+	if !batchErr.Details.IsNull() {
+		t.Errorf("Details 应当为显式置空")
+	}
+	if batchErr.FieldPath.Value() != "ListItems[0].Url" {
+		t.Errorf("FieldPath 解析错误: %q", batchErr.FieldPath.Value())
+	}
+	if batchErr.Index != 3 {
+		t.Errorf("Index 解析错误: %d", batchErr.Index)
+	}
+}
+
+func TestNillableJSONRoundTrip(t *testing.T) {
+	items := []models.SharedListItem{
+		{Type: models.SharedListItemTypeNegativeSite, Url: "example.com"},
+		{Type: models.SharedListItemTypeBrandItem, ID: base.Null[int64](), BrandId: base.Int64(0)},
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []models.SharedListItem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	unset, err := xml.Marshal(decoded[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(unset), "<Id") || strings.Contains(string(unset), "<BrandId") {
+		t.Errorf("未设置的字段经过 JSON 往返后应当仍被省略: %s\n%s", unset, data)
+	}
+
+	set, err := xml.Marshal(decoded[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(set), `<Id i:nil="true"></Id>`) || !strings.Contains(string(set), "<BrandId>0</BrandId>") {
+		t.Errorf("置空和有值的字段经过 JSON 往返后应当保持不变: %s\n%s", set, data)
+	}
+
+	// 缺少的字段和 null 都视为未设置
+	var item models.SharedListItem
+	if err := json.Unmarshal([]byte(`{"Type":"BrandItem","ID":null}`), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.ID.IsSet() || item.BrandId.IsSet() {
+		t.Errorf("null 和缺少的字段应当为未设置: %+v", item)
+	}
+}
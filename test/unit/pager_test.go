@@ -0,0 +1,113 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+)
+
+func TestPagerFetchesLazily(t *testing.T) {
+	var requested []int
+	pager := common.NewPager(context.Background(), 2, func(index, size int) ([]int, error) {
+		requested = append(requested, index)
+		items := [][]int{{1, 2}, {3, 4}, {5}}
+		return items[index], nil
+	})
+
+	if !pager.Next() || pager.Item() != 1 {
+		t.Fatalf("第一个结果不正确")
+	}
+	if len(requested) != 1 {
+		t.Errorf("遍历第一页时不应请求后续页: %v", requested)
+	}
+
+	items, err := pager.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 4 || items[3] != 5 {
+		t.Errorf("剩余结果不正确: %v", items)
+	}
+	if len(requested) != 3 || pager.PageIndex() != 2 {
+		t.Errorf("结果数小于每页大小时应停止请求: %v", requested)
+	}
+}
+
+func TestPagerStopsOnErrorAndCancel(t *testing.T) {
+	fetchErr := errors.New("失败")
+	pager := common.NewPager(context.Background(), 1, func(index, size int) ([]string, error) {
+		if index == 1 {
+			return nil, fetchErr
+		}
+		return []string{"a"}, nil
+	})
+	items, err := pager.All()
+	if len(items) != 1 || !errors.Is(err, fetchErr) {
+		t.Errorf("期望返回已获取的结果和错误，实际 %v %v", items, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	pager = common.NewPager(ctx, 1, func(index, size int) ([]string, error) {
+		calls++
+		cancel()
+		return []string{"a"}, nil
+	})
+	items, err = pager.All()
+	if len(items) != 1 || calls != 1 || !errors.Is(err, context.Canceled) {
+		t.Errorf("取消后不应继续请求: %v %v calls=%d", items, err, calls)
+	}
+}
+
+func TestPagerRejectsInvalidSize(t *testing.T) {
+	calls := 0
+	pager := common.NewPager(context.Background(), 0, func(index, size int) ([]int, error) {
+		calls++
+		return nil, nil
+	})
+	if pager.Next() || calls != 0 {
+		t.Errorf("每页结果数无效时不应发送请求，calls=%d", calls)
+	}
+	if bingErr, ok := pager.Err().(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", pager.Err())
+	}
+
+	if common.PageSizeOrMax(0, 100) != 100 || common.PageSizeOrMax(10, 100) != 10 || common.PageSizeOrMax(-1, 100) != -1 {
+		t.Error("PageSizeOrMax 只应在 size 为 0 时使用最大值")
+	}
+
+	client, _, _, closeServer := newCustomerManagementServer(t, "")
+	defer closeServer()
+	_, err := client.AccountService().AccountsPager(context.Background(), nil, nil, -1).All()
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
+
+func TestLabelsPager(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetLabelsByIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+			<Labels>
+				<Label><Id>11</Id><Name>品牌</Name></Label>
+			</Labels>
+			<PartialErrors/>
+		</GetLabelsByIdsResponse>`)
+	defer closeServer()
+
+	labels, err := client.LabelService().LabelsPager(context.Background(), nil, 10).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<PageInfo><Index>0</Index><Size>10</Size></PageInfo>`
+	if !strings.Contains(*body, want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, *body)
+	}
+	if len(labels) != 1 || labels[0].Id.Value() != 11 {
+		t.Errorf("标签解析不正确: %+v", labels)
+	}
+}
@@ -0,0 +1,208 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+// productPartitionsResponse 为一棵按品牌细分的产品分组树
+const productPartitionsResponse = `
+	<GetAdGroupCriterionsByIdsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+		<AdGroupCriterions>
+			<AdGroupCriterion i:type="BiddableAdGroupCriterion">
+				<AdGroupId>77</AdGroupId>
+				<Criterion i:type="ProductPartition"><Condition><Attribute i:nil="true"/><Operand>All</Operand></Condition><ParentCriterionId i:nil="true"/><PartitionType>Subdivision</PartitionType></Criterion>
+				<Id>1</Id><Status>Active</Status>
+				<CriterionBid i:nil="true"/>
+			</AdGroupCriterion>
+			<AdGroupCriterion i:type="BiddableAdGroupCriterion">
+				<AdGroupId>77</AdGroupId>
+				<Criterion i:type="ProductPartition"><Condition><Attribute i:nil="true"/><Operand>Brand</Operand></Condition><ParentCriterionId>1</ParentCriterionId><PartitionType>Unit</PartitionType></Criterion>
+				<Id>2</Id><Status>Active</Status>
+				<CriterionBid i:type="FixedBid"><Amount>0.5</Amount></CriterionBid>
+			</AdGroupCriterion>
+			<AdGroupCriterion i:type="BiddableAdGroupCriterion">
+				<AdGroupId>77</AdGroupId>
+				<Criterion i:type="ProductPartition"><Condition><Attribute>Contoso</Attribute><Operand>Brand</Operand></Condition><ParentCriterionId>1</ParentCriterionId><PartitionType>Unit</PartitionType></Criterion>
+				<Id>3</Id><Status>Active</Status>
+				<CriterionBid i:type="FixedBid"><Amount>1</Amount></CriterionBid>
+			</AdGroupCriterion>
+			<AdGroupCriterion i:type="NegativeAdGroupCriterion">
+				<AdGroupId>77</AdGroupId>
+				<Criterion i:type="ProductPartition"><Condition><Attribute>Fabrikam</Attribute><Operand>Brand</Operand></Condition><ParentCriterionId>1</ParentCriterionId><PartitionType>Unit</PartitionType></Criterion>
+				<Id>4</Id><Status>Active</Status>
+			</AdGroupCriterion>
+		</AdGroupCriterions>
+		<PartialErrors/>
+	</GetAdGroupCriterionsByIdsResponse>`
+
+func TestGetProductPartitionTree(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, productPartitionsResponse)
+	defer closeServer()
+
+	tree, err := client.AdGroupCriterionService().GetProductPartitionTree(77)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(*body, `<AdGroupId>77</AdGroupId><CriterionType>ProductPartition</CriterionType>`) {
+		t.Errorf("请求体不正确:\n%s", *body)
+	}
+	if tree.Root == nil || tree.Root.Id != 1 || !tree.Root.IsSubdivision() || len(tree.Root.Children()) != 3 {
+		t.Fatalf("根节点解析不正确: %+v", tree.Root)
+	}
+	if other := tree.Root.EverythingElse(); other == nil || other.Id != 2 || other.Bid() != 0.5 {
+		t.Errorf("\"其他所有\"节点解析不正确: %+v", other)
+	}
+	if fabrikam := tree.Root.Child("Fabrikam"); fabrikam == nil || !fabrikam.IsExcluded() {
+		t.Errorf("排除节点解析不正确: %+v", fabrikam)
+	}
+}
+
+func TestProductPartitionTreePlan(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, productPartitionsResponse)
+	defer closeServer()
+
+	tree, err := client.AdGroupCriterionService().GetProductPartitionTree(77)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := tree.Plan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("未修改的树不应生成操作: %+v", plan.Actions)
+	}
+
+	// 修改出价、细分"其他所有"节点并删除一个排除节点
+	if err := tree.Root.Child("Contoso").SetBid(1.5); err != nil {
+		t.Fatal(err)
+	}
+	other := tree.Root.EverythingElse()
+	if err := other.Split("CategoryL1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.AddChild("Shoes", 0.8); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.Root.Child("Fabrikam").Remove(); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err = tree.Plan()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		action   models.ItemAction
+		id       int64
+		parentId int64
+	}{
+		{models.ItemActionDelete, 2, 0},
+		{models.ItemActionDelete, 4, 0},
+		{models.ItemActionAdd, -1, 1},
+		{models.ItemActionAdd, 0, -1},
+		{models.ItemActionAdd, 0, -1},
+		{models.ItemActionUpdate, 3, 0},
+	}
+	if len(plan.Actions) != len(want) {
+		t.Fatalf("期望 %d 个操作，实际 %d 个: %+v", len(want), len(plan.Actions), plan.Actions)
+	}
+	for i, w := range want {
+		action := plan.Actions[i]
+		var criterionBase models.AdGroupCriterionBase
+		switch c := action.AdGroupCriterion.(type) {
+		case models.BiddableAdGroupCriterion:
+			criterionBase = c.AdGroupCriterionBase
+		case models.NegativeAdGroupCriterion:
+			criterionBase = c.AdGroupCriterionBase
+		}
+		var parentId int64
+		if partition, ok := criterionBase.Criterion.(models.ProductPartition); ok {
+			parentId = partition.ParentCriterionId.Value()
+		}
+		if action.Action != w.action || criterionBase.Id.Value() != w.id || parentId != w.parentId {
+			t.Errorf("第 %d 个操作不正确: %s Id=%d Parent=%d", i, action.Action, criterionBase.Id.Value(), parentId)
+		}
+	}
+	if _, ok := plan.Actions[1].AdGroupCriterion.(models.NegativeAdGroupCriterion); !ok {
+		t.Errorf("删除排除节点应使用 NegativeAdGroupCriterion: %+v", plan.Actions[1])
+	}
+}
+
+func TestApplyProductPartitionTree(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<ApplyProductPartitionActionsResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+			<AdGroupCriterionIds><a:long>10</a:long><a:long>11</a:long><a:long>12</a:long></AdGroupCriterionIds>
+			<PartialErrors/>
+		</ApplyProductPartitionActionsResponse>`)
+	defer closeServer()
+
+	tree, err := models.NewProductPartitionTree(77, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.Root.Split("Brand"); err != nil {
+		t.Fatal(err)
+	}
+	contoso, err := tree.Root.AddChild("Contoso", 1.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partialErrors, err := client.AdGroupCriterionService().ApplyProductPartitionTree(tree)
+	if err != nil || len(partialErrors) != 0 {
+		t.Fatalf("提交失败: %v %+v", err, partialErrors)
+	}
+
+	for _, want := range []string{
+		`<AdGroupCriterion i:type="BiddableAdGroupCriterion"><AdGroupId>77</AdGroupId><Criterion i:type="ProductPartition"><Condition><Operand>All</Operand></Condition><PartitionType>Subdivision</PartitionType></Criterion><Id>-1</Id></AdGroupCriterion>`,
+		`<ParentCriterionId>-1</ParentCriterionId><PartitionType>Unit</PartitionType></Criterion><CriterionBid i:type="FixedBid"><Amount>0.5</Amount></CriterionBid>`,
+		`<CriterionBid i:type="FixedBid"><Amount>1.2</Amount></CriterionBid>`,
+	} {
+		if !strings.Contains(*body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, *body)
+		}
+	}
+	if tree.Root.Id != 10 || contoso.Id != 12 {
+		t.Errorf("新节点 ID 未写回: root=%d contoso=%d", tree.Root.Id, contoso.Id)
+	}
+
+	plan, err := tree.Plan()
+	if err != nil || !plan.Empty() {
+		t.Errorf("提交后不应再有操作: %v %+v", err, plan)
+	}
+}
+
+func TestProductPartitionNodeRules(t *testing.T) {
+	tree, err := models.NewProductPartitionTree(77, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tree.Root.Exclude(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.Root.Split("Brand"); err != nil {
+		t.Fatal(err)
+	}
+	if !tree.Root.EverythingElse().IsExcluded() {
+		t.Error("\"其他所有\"节点应继承排除状态")
+	}
+
+	for name, err := range map[string]error{
+		"细分节点设置出价": tree.Root.SetBid(1),
+		"删除其他所有节点": tree.Root.EverythingElse().Remove(),
+		"出价为 0":    tree.Root.EverythingElse().SetBid(0),
+	} {
+		if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+			t.Errorf("%s: 期望 INVALID_INPUT 错误，实际 %v", name, err)
+		}
+	}
+}
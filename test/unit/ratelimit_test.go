@@ -0,0 +1,182 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+const throttleFault = `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><s:Fault>
+	<faultcode>s:Server</faultcode><faultstring>Invalid client data.</faultstring>
+	<detail><AdApiFaultDetail xmlns="https://adapi.microsoft.com"><Errors><AdApiError>
+		<Code>117</Code><ErrorCode>CallRateExceeded</ErrorCode><Message>You have exceeded the number of calls.</Message>
+	</AdApiError></Errors></AdApiFaultDetail></detail>
+</s:Fault></s:Body></s:Envelope>`
+
+func TestRateLimiterTokenBucket(t *testing.T) {
+	limiter := common.NewRateLimiter(20, 2, 0, time.Second)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 前 2 个请求使用突发容量，后 2 个请求每个至少等待 50ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("限流器没有限速，4 个请求只用了 %v", elapsed)
+	}
+}
+
+func TestRateLimiterMaxInFlight(t *testing.T) {
+	limiter := common.NewRateLimiter(0, 1, 2, time.Second)
+
+	var inFlight, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.Acquire(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("最大并发数应当为 2，实际为 %d", peak)
+	}
+}
+
+func TestHTTPClientThrottlingBackoff(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(throttleFault))
+			return
+		}
+		w.Write([]byte("<ok/>"))
+	}))
+	defer server.Close()
+
+	client := common.NewHTTPClient(config.NewConfig(config.NewAuthConfig("dev", "auth", "throttle", ""), nil))
+	client.Limiter = common.NewRateLimiter(100, 1, 0, 100*time.Millisecond)
+
+	_, err := client.Post(server.URL, "Action", []byte("<req/>"))
+	if !base.IsRateLimitError(err) {
+		t.Fatalf("限流故障应当返回 RATE_LIMIT_ERROR，实际为 %v", err)
+	}
+	if rate := client.Limiter.Rate(); rate != 50 {
+		t.Errorf("触发限流后速率应当减半为 50，实际为 %v", rate)
+	}
+
+	start := time.Now()
+	if _, err := client.Post(server.URL, "Action", []byte("<req/>")); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("触发限流后的请求应当等待退避时间，实际只等待了 %v", elapsed)
+	}
+}
+
+func TestIsThrottlingFaultOperationErrors(t *testing.T) {
+	for name, detail := range map[string]string{
+		"ApiFaultDetail": `<ApiFaultDetail xmlns="https://bingads.microsoft.com/CampaignManagement/v13"><OperationErrors><OperationError>
+			<Code>117</Code><ErrorCode>CallRateExceeded</ErrorCode></OperationError></OperationErrors></ApiFaultDetail>`,
+		"ApiFault": `<ApiFault xmlns="https://bingads.microsoft.com/Customer/v13/Exception"><OperationErrors><OperationError>
+			<Code>117</Code><Message>You have exceeded the number of calls.</Message></OperationError></OperationErrors></ApiFault>`,
+	} {
+		body := `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><s:Fault>
+			<faultcode>s:Server</faultcode><faultstring>Invalid client data.</faultstring><detail>` + detail + `</detail>
+		</s:Fault></s:Body></s:Envelope>`
+		if !common.IsThrottlingFault([]byte(body)) {
+			t.Errorf("%s 中的 117 错误应当视为限流", name)
+		}
+	}
+
+	if !common.IsThrottlingFault([]byte(throttleFault)) {
+		t.Error("AdApiFaultDetail 中的 117 错误应当视为限流")
+	}
+}
+
+func TestRateLimiterForReconfigures(t *testing.T) {
+	auth := config.NewAuthConfig("dev", "auth", "reconfigure", "")
+	api := config.DefaultConfig()
+	api.RequestsPerSecond = 10
+	first := common.RateLimiterFor(auth, api)
+
+	api2 := config.DefaultConfig()
+	api2.RequestsPerSecond = 4
+	second := common.RateLimiterFor(auth, api2)
+
+	if first != second {
+		t.Fatal("相同开发者令牌和 CustomerId 应当共享限流器")
+	}
+	if rate := second.Rate(); rate != 4 {
+		t.Errorf("限流器应当使用最后的配置，速率为 4，实际为 %v", rate)
+	}
+}
+
+func TestRateLimiterThrottledHalvesOncePerPause(t *testing.T) {
+	limiter := common.NewRateLimiter(100, 1, 0, time.Second)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limiter.Throttled(20 * time.Millisecond)
+		}()
+	}
+	wg.Wait()
+	if rate := limiter.Rate(); rate != 50 {
+		t.Errorf("同一暂停期间的并发限流只应减半一次，实际速率为 %v", rate)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	limiter.Throttled(20 * time.Millisecond)
+	if rate := limiter.Rate(); rate != 25 {
+		t.Errorf("暂停结束后再次限流应当继续减半，实际速率为 %v", rate)
+	}
+}
+
+func TestHTTPClientPostBoundsLimiterWait(t *testing.T) {
+	api := config.DefaultConfig()
+	api.RateLimitWait = 1
+	client := common.NewHTTPClient(config.NewConfig(config.NewAuthConfig("dev", "auth", "wait", ""), api))
+	client.Limiter = common.NewRateLimiter(0, 1, 0, time.Second)
+	client.Limiter.Throttled(time.Hour)
+
+	start := time.Now()
+	_, err := client.Post("http://127.0.0.1:0", "Action", []byte("<req/>"))
+	if !base.IsRateLimitError(err) {
+		t.Fatalf("等待限流器超时应当返回 RATE_LIMIT_ERROR，实际为 %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("等待限流器的时间应当受 RateLimitWait 限制，实际等待了 %v", elapsed)
+	}
+}
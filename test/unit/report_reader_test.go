@@ -0,0 +1,98 @@
+package unit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vancevox/bingads-go/reporting/models"
+	"github.com/vancevox/bingads-go/reporting/service"
+)
+
+const keywordReport = "\xef\xbb\xbf\"Report Name: weekly\"\r\n" +
+	"\"Report Time: 1/1/2026,1/7/2026\"\r\n" +
+	"\"Time Zone: (GMT-08:00) Pacific Time (US & Canada); Tijuana\"\r\n" +
+	"\"Rows: 3\"\r\n" +
+	"\"\"\r\n" +
+	"\"TimePeriod\",\"KeywordId\",\"Keyword\",\"BidMatchType\",\"Impressions\",\"Ctr\",\"Spend\",\"CurrentMaxCpc\",\"QualityScore\"\r\n" +
+	"\"2026-01-01\",\"101\",\"running shoes\",\"Exact\",\"1,234\",\"4.56%\",\"1,020.50\",\"$0.75\",\"7\"\r\n" +
+	"\"2026-01-02|13\",\"102\",\"trail, shoes\",\"Phrase\",\"0\",\"0.00%\",\"0.00\",\"0.40\",\"--\"\r\n" +
+	"\"1/3/2026\",\"103\",\"boots\",\"Broad\",\"12\",\"--\",\"3.10\",\"0.20\",\"\"\r\n" +
+	"\"\"\r\n" +
+	"\"©2026 Microsoft Corporation. All rights reserved. \"\r\n"
+
+type keywordRow struct {
+	Day         time.Time `csv:"TimePeriod"`
+	KeywordId   int64
+	Keyword     string
+	MatchType   string `csv:"BidMatchType"`
+	Impressions int
+	Ctr         float64
+	Spend       float64
+	MaxCpc      float64 `csv:"CurrentMaxCpc"`
+	Quality     *int    `csv:"QualityScore"`
+	Ignored     string  `csv:"-"`
+}
+
+func TestReportReader(t *testing.T) {
+	reader, err := service.NewReportReader[keywordRow](strings.NewReader(keywordReport), models.ReportFormatCsv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columns := reader.Columns(); len(columns) != 9 || columns[0] != "TimePeriod" {
+		t.Fatalf("列名解析不正确: %v", columns)
+	}
+
+	var rows []keywordRow
+	for reader.Next() {
+		rows = append(rows, reader.Row())
+	}
+	if err := reader.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("应当读取 3 行数据，实际为 %d: %+v", len(rows), rows)
+	}
+
+	first := rows[0]
+	if !first.Day.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || first.KeywordId != 101 || first.MatchType != "Exact" {
+		t.Errorf("第一行解析不正确: %+v", first)
+	}
+	if first.Impressions != 1234 || first.Ctr != 4.56 || first.Spend != 1020.5 || first.MaxCpc != 0.75 {
+		t.Errorf("数值解析不正确: %+v", first)
+	}
+	if first.Quality == nil || *first.Quality != 7 {
+		t.Errorf("QualityScore 应当为 7: %v", first.Quality)
+	}
+
+	if rows[1].Day.Hour() != 13 || rows[1].Keyword != "trail, shoes" || rows[1].Quality != nil {
+		t.Errorf("第二行解析不正确: %+v", rows[1])
+	}
+	if !rows[2].Day.Equal(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)) || rows[2].Ctr != 0 || rows[2].Quality != nil {
+		t.Errorf("第三行解析不正确: %+v", rows[2])
+	}
+}
+
+func TestReportReaderParseError(t *testing.T) {
+	report := "\"Spend\",\"Clicks\"\n\"1.00\",\"2\"\n\"2.00\",\"many\"\n"
+	reader, err := service.NewReportReader[struct {
+		Spend  float64
+		Clicks int
+	}](strings.NewReader(report), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	for reader.Next() {
+		count++
+	}
+	var parseErr *service.ReportParseError
+	if !errors.As(reader.Err(), &parseErr) {
+		t.Fatalf("应当返回 ReportParseError，实际为 %v", reader.Err())
+	}
+	if count != 1 || parseErr.Line != 3 || parseErr.Column != "Clicks" || parseErr.Value != "many" {
+		t.Errorf("解析错误信息不正确: count=%d err=%v", count, parseErr)
+	}
+}
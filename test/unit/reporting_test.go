@@ -0,0 +1,184 @@
+package unit
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/config"
+	"github.com/vancevox/bingads-go/reporting/models"
+	"github.com/vancevox/bingads-go/reporting/service"
+)
+
+// newReportingClient 创建指向测试服务器的 Reporting 客户端
+func newReportingClient(url string) *service.Client {
+	return service.NewClient(newTestConfig(config.ServiceReporting, url))
+}
+
+func TestSubmitGenerateReport(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+			<SubmitGenerateReportResponse xmlns="https://bingads.microsoft.com/Reporting/v13">
+				<ReportRequestId>30000000001</ReportRequestId>
+			</SubmitGenerateReportResponse></s:Body></s:Envelope>`))
+	}))
+	defer server.Close()
+
+	id, err := newReportingClient(server.URL).ReportingService().SubmitGenerateReport(models.CampaignPerformanceReportRequest{
+		ReportRequestBase: models.ReportRequestBase{Format: models.ReportFormatCsv, ReportName: "daily"},
+		Aggregation:       models.ReportAggregationDaily,
+		Columns: []models.CampaignPerformanceReportColumn{
+			models.CampaignPerformanceReportColumnTimePeriod,
+			models.CampaignPerformanceReportColumnCampaignId,
+			models.CampaignPerformanceReportColumnSpend,
+		},
+		Scope: models.AccountThroughCampaignReportScope{AccountIds: []int64{7}},
+		Time:  models.ReportTime{PredefinedTime: models.ReportTimePeriodYesterday},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "30000000001" {
+		t.Errorf("ReportRequestId 解析不正确: %q", id)
+	}
+
+	for _, want := range []string{
+		`<ReportRequest i:type="CampaignPerformanceReportRequest"><ExcludeColumnHeaders>false</ExcludeColumnHeaders>`,
+		`<Format>Csv</Format><ReportName>daily</ReportName><ReturnOnlyCompleteData>false</ReturnOnlyCompleteData><Aggregation>Daily</Aggregation>`,
+		`<Columns><CampaignPerformanceReportColumn>TimePeriod</CampaignPerformanceReportColumn><CampaignPerformanceReportColumn>CampaignId</CampaignPerformanceReportColumn>`,
+		`<Scope><AccountIds xmlns:a1="http://schemas.microsoft.com/2003/10/Serialization/Arrays"><a1:long>7</a1:long></AccountIds></Scope>`,
+		`<Time><PredefinedTime>Yesterday</PredefinedTime></Time></ReportRequest>`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("请求体缺少 %s:\n%s", want, body)
+		}
+	}
+}
+
+func TestAdGroupReportScopeXML(t *testing.T) {
+	data, err := xml.Marshal(models.AccountThroughAdGroupReportScope{
+		AccountIds: []int64{7},
+		AdGroups:   models.AdGroupReportScopes{{AccountId: 7, AdGroupId: 3, CampaignId: 5}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `<AdGroups><AdGroupReportScope><AccountId>7</AccountId><AdGroupId>3</AdGroupId><CampaignId>5</CampaignId></AdGroupReportScope></AdGroups>`; !strings.Contains(string(data), want) {
+		t.Errorf("请求体缺少 %s:\n%s", want, data)
+	}
+
+	data, err = xml.Marshal(models.AccountThroughAdGroupReportScope{AccountIds: []int64{7}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "AdGroups") || strings.Contains(string(data), "Campaigns") {
+		t.Errorf("空的范围列表不应输出元素:\n%s", data)
+	}
+}
+
+func TestWaitForReport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, url := "Pending", ""
+		if atomic.AddInt32(&calls, 1) == 3 {
+			status, url = "Success", "https://example.com/report.zip"
+		}
+		w.Write([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+			<PollGenerateReportResponse xmlns="https://bingads.microsoft.com/Reporting/v13">
+				<ReportRequestStatus><ReportDownloadUrl>` + url + `</ReportDownloadUrl><Status>` + status + `</Status></ReportRequestStatus>
+			</PollGenerateReportResponse></s:Body></s:Envelope>`))
+	}))
+	defer server.Close()
+
+	status, err := newReportingClient(server.URL).ReportingService().WaitForReport(context.Background(), "1", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 || status.Status != models.ReportRequestStatusSuccess || status.ReportDownloadUrl != "https://example.com/report.zip" {
+		t.Errorf("轮询结果不正确: calls=%d status=%+v", calls, status)
+	}
+}
+
+func TestWaitForReportInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+			<PollGenerateReportResponse xmlns="https://bingads.microsoft.com/Reporting/v13">
+				<ReportRequestStatus><Status>Success</Status></ReportRequestStatus>
+			</PollGenerateReportResponse></s:Body></s:Envelope>`))
+	}))
+	defer server.Close()
+
+	reporting := newReportingClient(server.URL).ReportingService()
+	if _, err := reporting.WaitForReport(context.Background(), "1", 0); err != nil {
+		t.Errorf("interval 为 0 时应当使用默认间隔: %v", err)
+	}
+	_, err := reporting.WaitForReport(context.Background(), "1", -time.Second)
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("interval 为负数时应当返回 INVALID_INPUT，实际为 %v", err)
+	}
+}
+
+func TestDownloadReport(t *testing.T) {
+	const csv = "\"Report Name: daily\"\r\n\"CampaignId\",\"Spend\"\r\n\"1\",\"2.50\"\r\n"
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	f, _ := zw.Create("report.csv")
+	f.Write([]byte(csv))
+	zw.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	reports := newReportingClient(server.URL).ReportingService()
+	rc, err := reports.DownloadReport(context.Background(), server.URL+"/report.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != csv {
+		t.Errorf("解压后的内容不正确: %q", data)
+	}
+
+	if _, err := reports.DownloadReport(context.Background(), server.URL+"/missing"); err == nil {
+		t.Error("404 应当返回错误")
+	}
+	if _, err := reports.DownloadReport(context.Background(), ""); err == nil || err.(*base.BingAdsError).Code != base.ErrInvalidInput {
+		t.Errorf("空下载地址应当返回 INVALID_INPUT，实际为 %v", err)
+	}
+}
+
+func TestDownloadReportTempFileError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("PK"))
+	}))
+	defer server.Close()
+
+	t.Setenv("TMPDIR", t.TempDir()+"/missing")
+	_, err := newReportingClient(server.URL).ReportingService().DownloadReport(context.Background(), server.URL+"/report.zip")
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrFileIOFail {
+		t.Errorf("无法创建临时文件时应当返回 FILE_IO_FAIL，实际为 %v", err)
+	}
+}
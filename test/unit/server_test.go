@@ -0,0 +1,37 @@
+package unit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vancevox/bingads-go/config"
+)
+
+// soapRequest 记录测试服务器最后一次收到的请求
+type soapRequest struct {
+	Action string
+	Body   string
+}
+
+// newSOAPServer 创建返回固定响应的测试服务器，返回服务器地址、最后一次请求的记录和关闭函数
+//
+// response 为 SOAP Body 中的内容，可以包含多个响应元素，以便同一个服务器响应不同的操作。
+func newSOAPServer(t *testing.T, response string) (string, *soapRequest, func()) {
+	t.Helper()
+	request := &soapRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		request.Action, request.Body = r.Header.Get("SOAPAction"), string(data)
+		w.Write([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>` + response + `</s:Body></s:Envelope>`))
+	}))
+	return server.URL, request, server.Close
+}
+
+// newTestConfig 创建将 svc 的地址指向 url 的测试配置
+func newTestConfig(svc config.Service, url string) *config.Config {
+	api := config.DefaultConfig()
+	api.Endpoints = map[config.Service]string{svc: url}
+	return config.NewConfig(config.NewAuthConfig("dev", "auth", "1", "2"), api)
+}
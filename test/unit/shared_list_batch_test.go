@@ -0,0 +1,76 @@
+package unit
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/campaignManagement/service"
+	"github.com/vancevox/bingads-go/config"
+)
+
+var siteURLPattern = regexp.MustCompile(`<Url>site(\d+)</Url>`)
+
+func TestAddListItemsToSharedListInBatches(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := io.ReadAll(r.Body)
+
+		// 以 Url 中的序号作为 ID 返回，并把每批的第一个列表项标记为失败
+		var ids strings.Builder
+		for _, m := range siteURLPattern.FindAllStringSubmatch(string(body), -1) {
+			ids.WriteString("<a:long>" + m[1] + "</a:long>")
+		}
+		fmt.Fprintf(w, `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+			<AddListItemsToSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+				<ListItemIds xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">%s</ListItemIds>
+				<PartialErrors><BatchError><Code>1</Code><Index>0</Index></BatchError></PartialErrors>
+			</AddListItemsToSharedListResponse></s:Body></s:Envelope>`, ids.String())
+	}))
+	defer server.Close()
+
+	api := config.DefaultConfig()
+	api.CampaignEndpoint = server.URL
+	client := service.NewClient(config.NewConfig(config.NewAuthConfig("dev", "auth", "1", "2"), api))
+
+	items := make([]models.SharedListItem, 10)
+	for i := range items {
+		items[i] = models.SharedListItem{Type: models.SharedListItemTypeNegativeSite, Url: fmt.Sprintf("site%d", i)}
+	}
+
+	list := models.PlacementExclusionList{SharedList: models.SharedList{SharedEntity: models.SharedEntity{Id: base.Int64(1)}}}
+	ids, partialErrors, err := client.SharedListService().AddListItemsToSharedListInBatches(list, items, models.EntityScopeCustomer, models.BatchOptions{
+		BatchSize:   3,
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 4 {
+		t.Errorf("10 个列表项按每批 3 个应当发送 4 次请求，实际为 %d", calls)
+	}
+	for i, id := range ids {
+		if id != int64(i) {
+			t.Errorf("ids[%d] 应当为 %d，实际为 %d", i, i, id)
+		}
+	}
+
+	wantIndexes := []int{0, 3, 6, 9}
+	if len(partialErrors) != len(wantIndexes) {
+		t.Fatalf("应当有 %d 个部分错误，实际为 %d", len(wantIndexes), len(partialErrors))
+	}
+	for i, e := range partialErrors {
+		if e.Index != wantIndexes[i] {
+			t.Errorf("第 %d 个部分错误的索引应当为 %d，实际为 %d", i, wantIndexes[i], e.Index)
+		}
+	}
+}
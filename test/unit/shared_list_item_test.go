@@ -0,0 +1,133 @@
+package unit
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+)
+
+const listItemsResponse = `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Header>
+    <TrackingId xmlns="https://bingads.microsoft.com/CampaignManagement/v13">tracking</TrackingId>
+  </s:Header>
+  <s:Body>
+    <GetListItemsBySharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13">
+      <ListItems xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+        <SharedListItem i:type="NegativeKeyword">
+          <Type>NegativeKeyword</Type>
+          <Id>1</Id>
+          <MatchType>Exact</MatchType>
+          <Text>free</Text>
+        </SharedListItem>
+        <SharedListItem i:type="NegativeSite">
+          <Type>NegativeSite</Type>
+          <Id>2</Id>
+          <Url>example.com</Url>
+        </SharedListItem>
+        <SharedListItem i:type="BrandItem">
+          <Type>BrandItem</Type>
+          <BrandId>3</BrandId>
+          <Id i:nil="true"/>
+        </SharedListItem>
+        <SharedListItem i:type="FutureItem">
+          <Type>FutureItem</Type>
+          <Id>9</Id>
+          <Foo>bar</Foo>
+        </SharedListItem>
+      </ListItems>
+    </GetListItemsBySharedListResponse>
+  </s:Body>
+</s:Envelope>`
+
+func TestListItemsPolymorphicDecoding(t *testing.T) {
+	var response models.CampaignManagementResponseEnvelope
+	if err := xml.Unmarshal([]byte(listItemsResponse), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	items := response.Body.GetListItemsBySharedListResponse.ListItems
+	if len(items) != 4 {
+		t.Fatalf("应当解码出 4 个列表项，实际为 %d", len(items))
+	}
+
+	keyword, ok := items[0].(models.NegativeKeyword)
+	if !ok || keyword.Text != "free" || keyword.MatchType != "Exact" || keyword.Id.Value() != 1 {
+		t.Errorf("NegativeKeyword 解码错误: %#v", items[0])
+	}
+
+	site, ok := items[1].(models.NegativeSite)
+	if !ok || site.Url != "example.com" {
+		t.Errorf("NegativeSite 解码错误: %#v", items[1])
+	}
+
+	brand, ok := items[2].(models.BrandItem)
+	if !ok || brand.BrandId.Value() != 3 || !brand.Id.IsNull() {
+		t.Errorf("BrandItem 解码错误: %#v", items[2])
+	}
+
+	var typeErr *models.UnknownListItemTypeError
+	if err := items.Validate(); !errors.As(err, &typeErr) || typeErr.TypeName != "FutureItem" {
+		t.Errorf("未知类型应当返回 UnknownListItemTypeError，实际为 %v", err)
+	}
+
+	unknown := items.Unknown()
+	if len(unknown) != 1 || !strings.Contains(unknown[0].InnerXML, "<Foo>bar</Foo>") {
+		t.Fatalf("未知类型应当保留原始 XML: %#v", unknown)
+	}
+
+	if _, err := items.SharedListItems(); !errors.As(err, &typeErr) {
+		t.Errorf("包含未知类型时转换为 SharedListItem 应当返回错误，实际为 %v", err)
+	}
+	if flat := unknown[0].ToSharedListItem(); flat.ItemType != "FutureItem" || flat.ID.Value() != 9 {
+		t.Errorf("未知类型应当保留已知字段: %#v", flat)
+	}
+
+	data, err := xml.Marshal(struct {
+		XMLName xml.Name               `xml:"ListItems"`
+		Item    models.UnknownListItem `xml:"SharedListItem"`
+	}{Item: unknown[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `<SharedListItem i:type="FutureItem">`) || !strings.Contains(string(data), "<Foo>bar</Foo>") {
+		t.Errorf("未知类型应当原样序列化: %s", data)
+	}
+}
+
+func TestListItemDecoderStrict(t *testing.T) {
+	data := `<ListItems xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+		<SharedListItem i:type="NegativeSite"><Url>example.com</Url></SharedListItem>
+		<SharedListItem i:type="FutureItem"><Foo>bar</Foo></SharedListItem>
+	</ListItems>`
+
+	d := xml.NewDecoder(strings.NewReader(data))
+	tok, err := d.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = models.ListItemDecoder{}.Decode(d, tok.(xml.StartElement))
+	var typeErr *models.UnknownListItemTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("严格模式下未知类型应当返回错误，实际为 %v", err)
+	}
+}
+
+func TestSharedListItemTyped(t *testing.T) {
+	item := models.SharedListItem{Type: models.SharedListItemTypeNegativeSite, Url: "example.com"}
+
+	typed, err := item.Typed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site, ok := typed.(models.NegativeSite); !ok || site.Url != "example.com" {
+		t.Errorf("转换结果错误: %#v", typed)
+	}
+
+	if _, err := (models.SharedListItem{Type: "FutureItem"}).Typed(); err == nil {
+		t.Errorf("未知类型应当返回错误")
+	}
+}
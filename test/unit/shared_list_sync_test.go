@@ -0,0 +1,189 @@
+package unit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vancevox/bingads-go/base"
+	"github.com/vancevox/bingads-go/campaignManagement/models"
+	"github.com/vancevox/bingads-go/campaignManagement/service"
+	"github.com/vancevox/bingads-go/common"
+	"github.com/vancevox/bingads-go/config"
+)
+
+func negativeKeywordItem(id int64, text, matchType string) models.SharedListItem {
+	item := models.SharedListItem{Type: models.SharedListItemTypeNegativeKeyword, Text: text, MatchType: matchType}
+	if id != 0 {
+		item.ID = base.Int64(id)
+	}
+	return item
+}
+
+func negativeSiteItem(id int64, url string) models.SharedListItem {
+	item := models.SharedListItem{Type: models.SharedListItemTypeNegativeSite, Url: url}
+	if id != 0 {
+		item.ID = base.Int64(id)
+	}
+	return item
+}
+
+func TestPlanSharedListSync(t *testing.T) {
+	current := []models.SharedListItem{
+		negativeKeywordItem(1, "Free  Download", "Phrase"),
+		negativeKeywordItem(2, "cheap", "Exact"),
+		negativeKeywordItem(3, "free download", "Phrase"),
+		negativeSiteItem(4, "https://www.Example.com/"),
+		negativeSiteItem(5, "spam.example.net"),
+	}
+	desired := []models.SharedListItem{
+		negativeKeywordItem(0, "free download", "Phrase"),
+		negativeKeywordItem(0, "cheap", "Phrase"),
+		negativeKeywordItem(0, "cheap", "Phrase"),
+		negativeSiteItem(0, "www.example.com"),
+	}
+
+	plan, err := models.PlanSharedListSync(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Unchanged != 2 {
+		t.Errorf("期望保留 2 个项目，实际 %d 个", plan.Unchanged)
+	}
+	if len(plan.Add) != 1 || plan.Add[0].Text != "cheap" || plan.Add[0].MatchType != "Phrase" {
+		t.Errorf("添加的项目不正确: %+v", plan.Add)
+	}
+	ids := plan.DeleteIds()
+	if len(ids) != 3 || ids[0] != 2 || ids[1] != 3 || ids[2] != 5 {
+		t.Errorf("删除的项目不正确: %v", ids)
+	}
+
+	out := plan.String()
+	for _, want := range []string{"- NegativeKeyword [Exact] cheap (Id 2)", "+ NegativeKeyword [Phrase] cheap", "添加 1 个，删除 3 个，保留 2 个"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("计划描述缺少 %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestNormalizeSiteURL(t *testing.T) {
+	for in, want := range map[string]string{
+		"https://WWW.Example.com/Path/": "www.example.com/Path",
+		" example.com ":                 "example.com",
+		"http://sub.example.com":        "sub.example.com",
+	} {
+		if got := models.NormalizeSiteURL(in); got != want {
+			t.Errorf("NormalizeSiteURL(%q) = %q，期望 %q", in, got, want)
+		}
+	}
+
+	withWWW, _ := models.SharedListItemKey(negativeSiteItem(0, "www.example.com"))
+	withoutWWW, _ := models.SharedListItemKey(negativeSiteItem(0, "example.com"))
+	if withWWW == withoutWWW {
+		t.Errorf("www.example.com 和 example.com 是不同的站点: %q", withWWW)
+	}
+}
+
+func TestSyncSharedListDryRun(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<GetListItemsBySharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:i="http://www.w3.org/2001/XMLSchema-instance">
+			<ListItems>
+				<SharedListItem i:type="NegativeKeyword"><Type>NegativeKeyword</Type><Id>1</Id><MatchType>Exact</MatchType><Text>free</Text></SharedListItem>
+			</ListItems>
+		</GetListItemsBySharedListResponse>`)
+	defer closeServer()
+
+	list := models.NegativeKeywordList{SharedList: models.SharedList{SharedEntity: models.SharedEntity{Id: base.Int64(123)}}}
+	plan, result, err := client.SharedListService().SyncSharedList(list, []models.SharedListItem{negativeKeywordItem(0, "cheap", "Exact")}, models.EntityScopeAccount, models.SyncOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(*body, "GetListItemsBySharedListRequest") {
+		t.Errorf("dry-run 只应读取共享列表:\n%s", *body)
+	}
+	if result != nil || len(plan.Add) != 1 || len(plan.Delete) != 1 {
+		t.Errorf("dry-run 结果不正确: %+v %+v", plan, result)
+	}
+}
+
+func TestApplySharedListSyncMapsErrors(t *testing.T) {
+	client, _, closeServer := newCampaignManagementServer(t, `
+		<AddListItemsToSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+			<ListItemIds><a:long>0</a:long></ListItemIds>
+			<PartialErrors><BatchError><Code>1</Code><ErrorCode>DuplicateNegativeKeyword</ErrorCode><Index>0</Index></BatchError></PartialErrors>
+		</AddListItemsToSharedListResponse>`)
+	defer closeServer()
+
+	list := models.NegativeKeywordList{SharedList: models.SharedList{SharedEntity: models.SharedEntity{Id: base.Int64(123)}}}
+	plan := &models.SharedListSyncPlan{Add: []models.SharedListItem{negativeKeywordItem(0, "a", "Exact"), negativeKeywordItem(0, "b", "Exact")}}
+	result, err := client.SharedListService().ApplySharedListSync(list, plan, models.EntityScopeAccount, models.BatchOptions{BatchSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.HasErrors() || len(result.AddErrors) != 2 || result.AddErrors[1].Index != 1 {
+		t.Errorf("部分错误的索引应换算为计划中的位置: %+v", result.AddErrors)
+	}
+}
+
+func TestApplySharedListSyncDeletesBeforeAdding(t *testing.T) {
+	client, body, closeServer := newCampaignManagementServer(t, `
+		<DeleteListItemsFromSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13"><PartialErrors/></DeleteListItemsFromSharedListResponse>
+		<AddListItemsToSharedListResponse xmlns="https://bingads.microsoft.com/CampaignManagement/v13" xmlns:a="http://schemas.microsoft.com/2003/10/Serialization/Arrays">
+			<ListItemIds><a:long>7</a:long><a:long>0</a:long></ListItemIds>
+			<PartialErrors><BatchError><Code>1</Code><ErrorCode>SharedListItemLimitExceeded</ErrorCode><Index>1</Index></BatchError></PartialErrors>
+		</AddListItemsToSharedListResponse>`)
+	defer closeServer()
+
+	list := models.NegativeKeywordList{SharedList: models.SharedList{SharedEntity: models.SharedEntity{Id: base.Int64(123)}}}
+	plan := &models.SharedListSyncPlan{
+		Add:    []models.SharedListItem{negativeKeywordItem(0, "a", "Exact"), negativeKeywordItem(0, "c", "Exact")},
+		Delete: []models.SharedListItem{negativeKeywordItem(5, "b", "Exact")},
+	}
+	result, err := client.SharedListService().ApplySharedListSync(list, plan, models.EntityScopeAccount, models.BatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(*body, "<AddListItemsToSharedListRequest") {
+		t.Errorf("应当先删除再添加，最后一个请求为:\n%s", *body)
+	}
+	if len(result.DeleteErrors) != 0 || len(result.AddErrors) != 1 || result.AddErrors[0].Index != 1 || result.AddErrors[0].ErrorCode != "SharedListItemLimitExceeded" {
+		t.Errorf("部分添加失败的结果不正确: %+v", result)
+	}
+	if len(result.AddedIds) != 2 || result.AddedIds[0] != 7 {
+		t.Errorf("添加的 ID 不正确: %+v", result.AddedIds)
+	}
+
+	_, err = client.SharedListService().ApplySharedListSync(list, nil, models.EntityScopeAccount, models.BatchOptions{})
+	if bingErr, ok := err.(*base.BingAdsError); !ok || bingErr.Code != base.ErrInvalidInput {
+		t.Errorf("期望 INVALID_INPUT 错误，实际 %v", err)
+	}
+}
+
+func TestApplySharedListSyncStopsWhenDeleteFails(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Get("SOAPAction"))
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := service.NewClient(newTestConfig(config.ServiceCampaignManagement, server.URL))
+	list := models.NegativeKeywordList{SharedList: models.SharedList{SharedEntity: models.SharedEntity{Id: base.Int64(123)}}}
+	plan := &models.SharedListSyncPlan{
+		Add:    []models.SharedListItem{negativeKeywordItem(0, "a", "Exact")},
+		Delete: []models.SharedListItem{negativeKeywordItem(5, "b", "Exact")},
+	}
+	_, err := client.SharedListService().ApplySharedListSync(list, plan, models.EntityScopeAccount, models.BatchOptions{})
+	var chunkErr *common.ChunkError
+	if !errors.As(err, &chunkErr) {
+		t.Fatalf("期望 ChunkError，实际 %v", err)
+	}
+	if len(requests) != 1 || requests[0] != "DeleteListItemsFromSharedList" {
+		t.Errorf("删除失败后不应再添加，实际请求: %v", requests)
+	}
+}
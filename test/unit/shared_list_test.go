@@ -3,6 +3,7 @@ package unit
 import (
 	"testing"
 
+	"github.com/vancevox/bingads-go/base"
 	"github.com/vancevox/bingads-go/campaignManagement/models"
 	"github.com/vancevox/bingads-go/campaignManagement/service"
 	"github.com/vancevox/bingads-go/config"
@@ -23,7 +24,7 @@ func TestGetListItemsBySharedList(t *testing.T) {
 	list, err := sharedListService.GetListItemsBySharedList(models.PlacementExclusionList{
 		SharedList: models.SharedList{
 			SharedEntity: models.SharedEntity{
-				Id: 123,
+				Id: base.Int64(123),
 			},
 		},
 	}, models.EntityScopeCustomer)
@@ -92,7 +93,7 @@ func TestAddListItemsToSharedList(t *testing.T) {
 	list, partialErrors, err := sharedListService.AddListItemsToSharedList(models.PlacementExclusionList{
 		SharedList: models.SharedList{
 			SharedEntity: models.SharedEntity{
-				Id: 123,
+				Id: base.Int64(123),
 			},
 		},
 	}, []models.SharedListItem{
@@ -130,7 +131,7 @@ func TestDeleteListItemsFromSharedList(t *testing.T) {
 	_, err := sharedListService.DeleteListItemsFromSharedList(models.PlacementExclusionList{
 		SharedList: models.SharedList{
 			SharedEntity: models.SharedEntity{
-				Id: 123456789,
+				Id: base.Int64(123456789),
 			},
 		},
 	}, []int64{123456, 123457}, models.EntityScopeCustomer)
@@ -141,3 +142,24 @@ func TestDeleteListItemsFromSharedList(t *testing.T) {
 		t.Logf("成功删除共享列表项")
 	}
 }
+
+func TestSharedListLike(t *testing.T) {
+	lists := map[models.SharedEntityType]models.SharedListLike{
+		models.SharedEntityTypeNegativeKeywordList:           models.NegativeKeywordList{},
+		models.SharedEntityTypePlacementExclusionList:        models.PlacementExclusionList{},
+		models.SharedEntityTypeAccountNegativeKeywordList:    models.AccountNegativeKeywordList{},
+		models.SharedEntityTypeBrandList:                     models.BrandList{},
+		models.SharedEntityTypeAccountPlacementExclusionList: models.AccountPlacementExclusionList{},
+		models.SharedEntityTypeAccountPlacementInclusionList: models.AccountPlacementInclusionList{},
+	}
+
+	for entityType, list := range lists {
+		if got := list.AsSharedList().ItemType; got != string(entityType) {
+			t.Errorf("%T 的 i:type 应当为 %s，实际为 %s", list, entityType, got)
+		}
+	}
+
+	if _, err := models.SharedListFromAny("not a list"); err == nil {
+		t.Errorf("不支持的类型应当返回错误")
+	}
+}